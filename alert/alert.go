@@ -0,0 +1,255 @@
+package alert
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/sirupsen/logrus"
+)
+
+// notification statuses
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
+
+// AnyTaskName make the rule evaluated for every task name separately
+const AnyTaskName = "*"
+
+// Rule fire when the FAILURE count of the task name reaches Threshold,
+// or when the failures grow by at least Rate per minute. Zero disables the condition
+type Rule struct {
+	TaskName  string
+	Threshold int64
+	Rate      float64
+}
+
+// Validate :nodoc:
+func (r *Rule) Validate() error {
+	if r.Threshold <= 0 && r.Rate <= 0 {
+		return errors.New("either threshold or rate is required")
+	}
+	if r.Threshold < 0 || r.Rate < 0 {
+		return errors.New("threshold & rate must not be negative")
+	}
+	return nil
+}
+
+func (r *Rule) matchTaskName(taskName string) bool {
+	return r.TaskName == "" || r.TaskName == AnyTaskName || r.TaskName == taskName
+}
+
+// Notification is sent when an alert starts firing and when it is resolved
+type Notification struct {
+	Status   string
+	TaskName string
+	Count    int64
+	// Rate failures per minute since the previous evaluation, zero on the first evaluation
+	Rate float64
+	Rule *Rule
+	At   time.Time
+}
+
+type notifier interface {
+	Notify(n *Notification) error
+}
+
+// state of an alert, keyed by the rule & the task name
+type state struct {
+	firing bool
+	// notified is false when the firing notification is held back by the cooldown
+	notified       bool
+	lastNotifiedAt time.Time
+}
+
+type alertKey struct {
+	rule     int
+	taskName string
+}
+
+// Evaluator periodically compare the FAILURE counts against the rules and notify the firing & resolved alerts.
+// A firing alert is only notified once, and again only after cooldown since its last notification,
+// so a flapping alert doesn't flood the webhooks
+type Evaluator struct {
+	dashboard dashboard.Dashboard
+	rules     []*Rule
+	notifier  notifier
+	interval  time.Duration
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu         sync.Mutex
+	alerts     map[alertKey]*state
+	prevCounts map[string]int64
+	prevAt     time.Time
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewEvaluator the dashboard should cache the counts, see dashboard.NewCountCache,
+// so interval should be longer than the cache ttl
+func NewEvaluator(d dashboard.Dashboard, rules []*Rule, n notifier, interval, cooldown time.Duration) (*Evaluator, error) {
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid rule %d: %w", i, err)
+		}
+	}
+
+	return &Evaluator{
+		dashboard: d,
+		rules:     rules,
+		notifier:  n,
+		interval:  interval,
+		cooldown:  cooldown,
+		now:       time.Now,
+		alerts:    map[alertKey]*state{},
+		stop:      make(chan struct{}),
+	}, nil
+}
+
+// Start evaluate the rules every interval until Stop is called
+func (e *Evaluator) Start() {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := e.Evaluate(); err != nil {
+				logrus.Error(err)
+			}
+
+			select {
+			case <-e.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop :nodoc:
+func (e *Evaluator) Stop() {
+	close(e.stop)
+	e.wg.Wait()
+}
+
+// Evaluate count the failures once and notify the alerts changing status
+func (e *Evaluator) Evaluate() error {
+	counts, err := e.dashboard.CountTasks([]string{tasks.StateFailure})
+	if err != nil {
+		return fmt.Errorf("failed to count failures: %w", err)
+	}
+
+	current := map[string]int64{}
+	for _, count := range counts {
+		for taskName, n := range count.ByTaskName {
+			current[taskName] += n
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	var elapsed time.Duration
+	if e.prevCounts != nil {
+		elapsed = now.Sub(e.prevAt)
+	}
+
+	for i, rule := range e.rules {
+		for _, taskName := range e.taskNamesOf(i, rule, current) {
+			rate := 0.0
+			if elapsed > 0 {
+				// the failures decrease when they are rerun successfully or expired, it is not a spike
+				if grown := current[taskName] - e.prevCounts[taskName]; grown > 0 {
+					rate = float64(grown) / elapsed.Minutes()
+				}
+			}
+
+			firing := (rule.Threshold > 0 && current[taskName] >= rule.Threshold) || (rule.Rate > 0 && rate >= rule.Rate)
+			e.transition(alertKey{rule: i, taskName: taskName}, firing, &Notification{
+				TaskName: taskName,
+				Count:    current[taskName],
+				Rate:     rate,
+				Rule:     rule,
+				At:       now,
+			})
+		}
+	}
+
+	e.prevCounts = current
+	e.prevAt = now
+	return nil
+}
+
+// taskNamesOf list the task names to evaluate sorted, those with failures and those still firing
+func (e *Evaluator) taskNamesOf(ruleIdx int, rule *Rule, current map[string]int64) []string {
+	if rule.TaskName != "" && rule.TaskName != AnyTaskName {
+		return []string{rule.TaskName}
+	}
+
+	seen := map[string]bool{}
+	for taskName := range current {
+		seen[taskName] = true
+	}
+	for key, st := range e.alerts {
+		if key.rule == ruleIdx && st.firing {
+			seen[key.taskName] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for taskName := range seen {
+		if rule.matchTaskName(taskName) {
+			names = append(names, taskName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (e *Evaluator) transition(key alertKey, firing bool, n *Notification) {
+	st, ok := e.alerts[key]
+	if !ok {
+		st = &state{}
+		e.alerts[key] = st
+	}
+
+	switch {
+	case firing && (!st.firing || !st.notified):
+		st.firing = true
+		st.notified = false
+		if !st.lastNotifiedAt.IsZero() && n.At.Sub(st.lastNotifiedAt) < e.cooldown {
+			return
+		}
+
+		n.Status = StatusFiring
+		e.notify(n)
+		st.notified = true
+		st.lastNotifiedAt = n.At
+	case !firing && st.firing:
+		st.firing = false
+		if !st.notified {
+			return
+		}
+
+		n.Status = StatusResolved
+		e.notify(n)
+	}
+}
+
+// notify only log the failure, the alert is not notified again to avoid duplicates on the webhooks which succeeded
+func (e *Evaluator) notify(n *Notification) {
+	if err := e.notifier.Notify(n); err != nil {
+		logrus.WithField("task_name", n.TaskName).Error(err)
+	}
+}
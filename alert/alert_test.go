@@ -0,0 +1,160 @@
+package alert
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardStub only implement CountTasks
+type dashboardStub struct {
+	dashboard.Dashboard
+	failures map[string]int64
+	err      error
+}
+
+func (d *dashboardStub) CountTasks(states []string) ([]*dashboard.TaskCount, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	count := &dashboard.TaskCount{State: states[0], ByTaskName: map[string]int64{}}
+	for taskName, n := range d.failures {
+		count.Total += n
+		count.ByTaskName[taskName] = n
+	}
+	return []*dashboard.TaskCount{count}, nil
+}
+
+type notifierStub struct {
+	notifications []*Notification
+	err           error
+}
+
+func (n *notifierStub) Notify(notification *Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return n.err
+}
+
+func (n *notifierStub) statuses() []string {
+	var res []string
+	for _, notification := range n.notifications {
+		res = append(res, notification.Status+" "+notification.TaskName)
+	}
+	return res
+}
+
+func newEvaluatorMock(t *testing.T, d *dashboardStub, n *notifierStub, rules ...*Rule) (*Evaluator, *time.Time) {
+	evaluator, err := NewEvaluator(d, rules, n, time.Minute, 10*time.Minute)
+	require.NoError(t, err)
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	evaluator.now = func() time.Time { return now }
+	return evaluator, &now
+}
+
+func Test_Evaluator(t *testing.T) {
+	t.Run("threshold fire once & resolve", func(t *testing.T) {
+		d := &dashboardStub{failures: map[string]int64{"send_email": 5, "resize": 1}}
+		n := &notifierStub{}
+		e, now := newEvaluatorMock(t, d, n, &Rule{TaskName: AnyTaskName, Threshold: 5})
+
+		require.NoError(t, e.Evaluate())
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Equal(t, []string{"firing send_email"}, n.statuses())
+		assert.Equal(t, int64(5), n.notifications[0].Count)
+
+		// the task name is gone from the counts when all its failures are expired
+		d.failures = map[string]int64{"resize": 1}
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Equal(t, []string{"firing send_email", "resolved send_email"}, n.statuses())
+		assert.Equal(t, int64(0), n.notifications[1].Count)
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		d := &dashboardStub{failures: map[string]int64{"send_email": 100}}
+		n := &notifierStub{}
+		e, now := newEvaluatorMock(t, d, n, &Rule{TaskName: "send_email", Rate: 10})
+
+		require.NoError(t, e.Evaluate())
+		assert.Empty(t, n.notifications, "no rate on the first evaluation")
+
+		d.failures["send_email"] = 140
+		*now = now.Add(2 * time.Minute)
+		require.NoError(t, e.Evaluate())
+		require.Equal(t, []string{"firing send_email"}, n.statuses())
+		assert.Equal(t, 20.0, n.notifications[0].Rate)
+
+		*now = now.Add(2 * time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Equal(t, []string{"firing send_email", "resolved send_email"}, n.statuses())
+	})
+
+	t.Run("cooldown hold back the refiring", func(t *testing.T) {
+		d := &dashboardStub{failures: map[string]int64{"send_email": 5}}
+		n := &notifierStub{}
+		e, now := newEvaluatorMock(t, d, n, &Rule{Threshold: 5})
+
+		require.NoError(t, e.Evaluate())
+
+		d.failures["send_email"] = 0
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+
+		d.failures["send_email"] = 5
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Equal(t, []string{"firing send_email", "resolved send_email"}, n.statuses())
+
+		*now = now.Add(10 * time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Equal(t, []string{"firing send_email", "resolved send_email", "firing send_email"}, n.statuses())
+	})
+
+	t.Run("resolved within cooldown is not notified", func(t *testing.T) {
+		d := &dashboardStub{failures: map[string]int64{"send_email": 5}}
+		n := &notifierStub{}
+		e, now := newEvaluatorMock(t, d, n, &Rule{Threshold: 5})
+
+		require.NoError(t, e.Evaluate())
+		d.failures["send_email"] = 0
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		d.failures["send_email"] = 5
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		d.failures["send_email"] = 0
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+
+		assert.Equal(t, []string{"firing send_email", "resolved send_email"}, n.statuses())
+	})
+
+	t.Run("notifier error doesn't repeat the notification", func(t *testing.T) {
+		d := &dashboardStub{failures: map[string]int64{"send_email": 5}}
+		n := &notifierStub{err: errors.New("gotcha")}
+		e, now := newEvaluatorMock(t, d, n, &Rule{Threshold: 5})
+
+		require.NoError(t, e.Evaluate())
+		*now = now.Add(time.Minute)
+		require.NoError(t, e.Evaluate())
+		assert.Len(t, n.notifications, 1)
+	})
+
+	t.Run("handle CountTasks error", func(t *testing.T) {
+		d := &dashboardStub{err: errors.New("gotcha")}
+		e, _ := newEvaluatorMock(t, d, &notifierStub{}, &Rule{Threshold: 5})
+		assert.Error(t, e.Evaluate())
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		_, err := NewEvaluator(&dashboardStub{}, []*Rule{{TaskName: "send_email"}}, &notifierStub{}, time.Minute, time.Minute)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,119 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// webhookPayload Slack compatible, text is shown by Slack, the other fields are for generic receivers
+type webhookPayload struct {
+	Text      string    `json:"text"`
+	Status    string    `json:"status"`
+	TaskName  string    `json:"task_name"`
+	Count     int64     `json:"count"`
+	Rate      float64   `json:"rate"`
+	Threshold int64     `json:"threshold,omitempty"`
+	RateLimit float64   `json:"rate_threshold,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// WebhookNotifier post the notifications to all the webhook urls
+type WebhookNotifier struct {
+	urls         []string
+	dashboardURL string
+	client       *http.Client
+}
+
+// NewWebhookNotifier dashboardURL is optional, used to link the failed tasks on the message
+func NewWebhookNotifier(urls []string, dashboardURL string) *WebhookNotifier {
+	return &WebhookNotifier{
+		urls:         urls,
+		dashboardURL: strings.TrimSuffix(dashboardURL, "/"),
+		client:       &http.Client{Timeout: defaultWebhookTimeout},
+	}
+}
+
+// Notify post to every url even when some of them failed, the last error is returned
+func (w *WebhookNotifier) Notify(n *Notification) error {
+	body, err := json.Marshal(w.payloadOf(n))
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	var lastErr error
+	for _, u := range w.urls {
+		if err := w.post(u, body); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (w *WebhookNotifier) post(u string, body []byte) error {
+	resp, err := w.client.Post(u, "application/json", bytes.NewReader(body))
+	if err != nil {
+		// the url may contain a secret token, e.g. Slack incoming webhooks, so it is not logged
+		return fmt.Errorf("failed to post webhook: %w", redactURLError(err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to post webhook: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *WebhookNotifier) payloadOf(n *Notification) *webhookPayload {
+	payload := &webhookPayload{
+		Status:    n.Status,
+		TaskName:  n.TaskName,
+		Count:     n.Count,
+		Rate:      n.Rate,
+		Threshold: n.Rule.Threshold,
+		RateLimit: n.Rule.Rate,
+		At:        n.At,
+	}
+
+	if w.dashboardURL != "" {
+		payload.URL = fmt.Sprintf("%s/?state=FAILURE&task_name=%s", w.dashboardURL, url.QueryEscape(n.TaskName))
+	}
+
+	switch n.Status {
+	case StatusFiring:
+		payload.Text = fmt.Sprintf(":rotating_light: [FIRING] %s has %d FAILURE tasks (%.1f/min)", n.TaskName, n.Count, n.Rate)
+	default:
+		payload.Text = fmt.Sprintf(":white_check_mark: [RESOLVED] %s has %d FAILURE tasks (%.1f/min)", n.TaskName, n.Count, n.Rate)
+	}
+	payload.Text += " " + conditionOf(n.Rule)
+
+	if payload.URL != "" {
+		payload.Text += fmt.Sprintf(" <%s|see the tasks>", payload.URL)
+	}
+	return payload
+}
+
+func conditionOf(rule *Rule) string {
+	var conditions []string
+	if rule.Threshold > 0 {
+		conditions = append(conditions, fmt.Sprintf("count >= %d", rule.Threshold))
+	}
+	if rule.Rate > 0 {
+		conditions = append(conditions, fmt.Sprintf("rate >= %.1f/min", rule.Rate))
+	}
+	return "[" + strings.Join(conditions, " or ") + "]"
+}
+
+func redactURLError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+package alert
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WebhookNotifier(t *testing.T) {
+	var payloads []*webhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload := &webhookPayload{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		payloads = append(payloads, payload)
+
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	notification := &Notification{
+		Status:   StatusFiring,
+		TaskName: "send email",
+		Count:    7,
+		Rate:     1.5,
+		Rule:     &Rule{Threshold: 5},
+		At:       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		payloads = nil
+		notifier := NewWebhookNotifier([]string{srv.URL + "/ok"}, "http://dash.local/")
+		require.NoError(t, notifier.Notify(notification))
+
+		require.Len(t, payloads, 1)
+		assert.Equal(t, "firing", payloads[0].Status)
+		assert.Equal(t, int64(7), payloads[0].Count)
+		assert.Equal(t, "http://dash.local/?state=FAILURE&task_name=send+email", payloads[0].URL)
+		assert.Equal(t, ":rotating_light: [FIRING] send email has 7 FAILURE tasks (1.5/min) [count >= 5] <http://dash.local/?state=FAILURE&task_name=send+email|see the tasks>", payloads[0].Text)
+	})
+
+	t.Run("post to the other webhooks when one failed", func(t *testing.T) {
+		payloads = nil
+		notifier := NewWebhookNotifier([]string{srv.URL + "/fail", srv.URL + "/ok"}, "")
+		assert.Error(t, notifier.Notify(notification))
+
+		require.Len(t, payloads, 2)
+		assert.Empty(t, payloads[1].URL)
+	})
+}
@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidCursor returned when the pagination cursor can't be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// list of audited actions
+const (
+	ActionRerun      = "rerun"
+	ActionEditRerun  = "edit_rerun"
+	ActionBulkRerun  = "bulk_rerun"
+	ActionGroupRerun = "group_rerun"
+)
+
+// ActorUnknown is recorded when the rerun isn't attributed to anyone
+const ActorUnknown = "unknown"
+
+// Entry a single rerun
+type Entry struct {
+	ID           string `json:"id"`
+	Actor        string `json:"actor"`
+	Action       string `json:"action"`
+	OriginalUUID string `json:"original_uuid"`
+	// NewUUID of the resent task, the same as OriginalUUID when the rerun keeps the UUID
+	NewUUID   string `json:"new_uuid"`
+	GroupUUID string `json:"group_uuid,omitempty"`
+	// Edit the edited fields, see dashboard.RerunEdit.Fields
+	Edit      map[string]interface{} `json:"edit,omitempty"`
+	Success   bool                   `json:"success"`
+	Error     string                 `json:"error,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// Filter select the entries to list, zero values are ignored
+type Filter struct {
+	Actor  string
+	Action string
+	// UUID match either the original or the new task UUID
+	UUID string
+}
+
+// Match :nodoc:
+func (f *Filter) Match(e *Entry) bool {
+	return (f.Actor == "" || e.Actor == f.Actor) &&
+		(f.Action == "" || e.Action == f.Action) &&
+		(f.UUID == "" || e.OriginalUUID == f.UUID || e.NewUUID == f.UUID)
+}
+
+type store interface {
+	Record(entries []*Entry) error
+}
+
+// auditedDashboard record the reruns of the wrapped Dashboard into the store
+type auditedDashboard struct {
+	dashboard.Dashboard
+	store store
+	actor string
+	now   func() time.Time
+}
+
+// NewDashboard wrap the Dashboard recording every rerun, the other methods are passed through.
+// The reruns are attributed to ActorUnknown unless the actor is set with dashboard.WithActor
+func NewDashboard(d dashboard.Dashboard, s store) dashboard.Dashboard {
+	return &auditedDashboard{Dashboard: d, store: s, actor: ActorUnknown, now: time.Now}
+}
+
+// WithActor :nodoc:
+func (d *auditedDashboard) WithActor(actor string) dashboard.Dashboard {
+	if actor == "" {
+		actor = ActorUnknown
+	}
+
+	ad := *d
+	ad.actor = actor
+	return &ad
+}
+
+// RerunTask :nodoc:
+func (d *auditedDashboard) RerunTask(uuid string) error {
+	err := d.Dashboard.RerunTask(uuid)
+	d.record(d.newEntry(ActionRerun, uuid, err))
+	return err
+}
+
+// EditAndRerunTask :nodoc:
+func (d *auditedDashboard) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	err := d.Dashboard.EditAndRerunTask(uuid, edit)
+	entry := d.newEntry(ActionEditRerun, uuid, err)
+	if edit != nil {
+		entry.Edit = edit.Fields()
+	}
+	if edit != nil && edit.NewUUID != "" {
+		entry.NewUUID = edit.NewUUID
+		// a rerun as a new task without any edit is still a plain rerun
+		if len(entry.Edit) == 0 {
+			entry.Action = ActionRerun
+			entry.Edit = nil
+		}
+	}
+	d.record(entry)
+	return err
+}
+
+// BulkRerunTasks :nodoc:
+func (d *auditedDashboard) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	report, err := d.Dashboard.BulkRerunTasks(req)
+	if report == nil {
+		return report, err
+	}
+
+	var edit map[string]interface{}
+	if req.ETA != nil {
+		edit = (&dashboard.RerunEdit{ETA: req.ETA}).Fields()
+	}
+
+	entries := d.newEntries(ActionBulkRerun, report.Results)
+	for _, entry := range entries {
+		entry.Edit = edit
+	}
+	d.record(entries...)
+	return report, err
+}
+
+// RerunGroup :nodoc:
+func (d *auditedDashboard) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	report, err := d.Dashboard.RerunGroup(groupUUID, all)
+	if report == nil {
+		return report, err
+	}
+
+	entries := d.newEntries(ActionGroupRerun, report.Results)
+	for _, entry := range entries {
+		entry.GroupUUID = groupUUID
+	}
+	d.record(entries...)
+	return report, err
+}
+
+func (d *auditedDashboard) newEntry(action, uuid string, err error) *Entry {
+	entry := &Entry{
+		ID:           newID(),
+		Actor:        d.actor,
+		Action:       action,
+		OriginalUUID: uuid,
+		NewUUID:      uuid,
+		Success:      err == nil,
+		CreatedAt:    d.now().UTC(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+func (d *auditedDashboard) newEntries(action string, results []*dashboard.BulkRerunResult) []*Entry {
+	entries := make([]*Entry, 0, len(results))
+	for _, res := range results {
+		entry := d.newEntry(action, res.UUID, nil)
+		if res.NewUUID != "" {
+			entry.NewUUID = res.NewUUID
+		}
+		entry.Success = res.Success
+		entry.Error = res.Error
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// record only log the failure, since the tasks are already rerun
+func (d *auditedDashboard) record(entries ...*Entry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := d.store.Record(entries); err != nil {
+		logrus.WithField("actor", d.actor).Error(err)
+	}
+}
+
+func newID() string {
+	return uuid.New().String()
+}
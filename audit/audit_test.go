@@ -0,0 +1,157 @@
+package audit
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardStub only implement the rerun methods
+type dashboardStub struct {
+	dashboard.Dashboard
+	err error
+}
+
+func (d *dashboardStub) RerunTask(uuid string) error {
+	return d.err
+}
+
+func (d *dashboardStub) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	return d.err
+}
+
+func (d *dashboardStub) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	return &dashboard.BulkRerunReport{
+		Results: []*dashboard.BulkRerunResult{{UUID: "1", Success: true}, {UUID: "2", Error: "gotcha"}},
+	}, nil
+}
+
+func (d *dashboardStub) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	return &dashboard.GroupRerunReport{GroupUUID: groupUUID, Results: []*dashboard.BulkRerunResult{{UUID: "3", Success: true}}}, nil
+}
+
+func newFileStoreMock(t *testing.T) *FileStore {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	store, err := NewFileStore(filepath.Join(dir, "audit.log"))
+	require.NoError(t, err)
+	return store
+}
+
+func findAll(t *testing.T, store *FileStore, filter *Filter) []*Entry {
+	entries, _, err := store.Find(filter, "", 100)
+	require.NoError(t, err)
+	return entries
+}
+
+func Test_AuditedDashboard(t *testing.T) {
+	store := newFileStoreMock(t)
+	stub := &dashboardStub{}
+	d := NewDashboard(stub, store)
+
+	require.NoError(t, d.RerunTask("0"))
+	alice := dashboard.WithActor(d, "alice")
+
+	eta := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, alice.EditAndRerunTask("1", &dashboard.RerunEdit{ETA: &eta}))
+
+	_, err := alice.BulkRerunTasks(&dashboard.BulkRerunRequest{UUIDs: []string{"1", "2"}})
+	require.NoError(t, err)
+
+	_, err = alice.RerunGroup("group", false)
+	require.NoError(t, err)
+
+	stub.err = errors.New("gotcha")
+	assert.Error(t, alice.RerunTask("4"))
+
+	entries := findAll(t, store, &Filter{})
+	require.Len(t, entries, 6)
+
+	latest := entries[0]
+	assert.Equal(t, "alice", latest.Actor)
+	assert.Equal(t, ActionRerun, latest.Action)
+	assert.Equal(t, "4", latest.OriginalUUID)
+	assert.Equal(t, "4", latest.NewUUID)
+	assert.False(t, latest.Success)
+	assert.Equal(t, "gotcha", latest.Error)
+
+	assert.Equal(t, "group", entries[1].GroupUUID)
+	assert.Equal(t, ActionGroupRerun, entries[1].Action)
+
+	assert.Equal(t, ActionBulkRerun, entries[2].Action)
+	assert.Equal(t, "2", entries[2].OriginalUUID)
+	assert.False(t, entries[2].Success)
+
+	assert.Equal(t, ActionEditRerun, entries[4].Action)
+	assert.Equal(t, map[string]interface{}{"eta": "2020-01-01T00:00:00Z"}, entries[4].Edit)
+
+	assert.Equal(t, ActorUnknown, entries[5].Actor)
+
+	// the decorator actor is untouched
+	assert.Len(t, findAll(t, store, &Filter{Actor: ActorUnknown}), 1)
+	assert.Len(t, findAll(t, store, &Filter{UUID: "1"}), 2)
+	assert.Len(t, findAll(t, store, &Filter{Action: ActionBulkRerun}), 2)
+}
+
+func Test_AuditedDashboard_NewUUID(t *testing.T) {
+	store := newFileStoreMock(t)
+	d := NewDashboard(&dashboardStub{}, store)
+
+	require.NoError(t, d.EditAndRerunTask("1", &dashboard.RerunEdit{NewUUID: "task_2"}))
+	priority := uint8(3)
+	require.NoError(t, d.EditAndRerunTask("3", &dashboard.RerunEdit{Priority: &priority, NewUUID: "task_4"}))
+
+	entries := findAll(t, store, &Filter{})
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, ActionEditRerun, entries[0].Action)
+	assert.Equal(t, "task_4", entries[0].NewUUID)
+	assert.Equal(t, map[string]interface{}{"priority": float64(3)}, entries[0].Edit)
+
+	assert.Equal(t, ActionRerun, entries[1].Action, "no edit besides the new uuid")
+	assert.Equal(t, "1", entries[1].OriginalUUID)
+	assert.Equal(t, "task_2", entries[1].NewUUID)
+	assert.Nil(t, entries[1].Edit)
+
+	assert.Len(t, findAll(t, store, &Filter{UUID: "task_2"}), 1)
+}
+
+func Test_FileStore_Find(t *testing.T) {
+	store := newFileStoreMock(t)
+	require.NoError(t, store.Record([]*Entry{{ID: "a", Actor: "alice"}, {ID: "b", Actor: "bob"}, {ID: "c", Actor: "alice"}}))
+
+	t.Run("pagination", func(t *testing.T) {
+		entries, next, err := store.Find(&Filter{}, "", 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "c", entries[0].ID)
+		assert.Equal(t, "b", next)
+
+		entries, next, err = store.Find(&Filter{}, next, 2)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "a", entries[0].ID)
+		assert.Empty(t, next)
+	})
+
+	t.Run("filter fill the page", func(t *testing.T) {
+		entries, next, err := store.Find(&Filter{Actor: "alice"}, "", 2)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+		assert.Empty(t, next)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, _, err := store.Find(&Filter{}, "unknown", 2)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+}
@@ -0,0 +1,263 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+const (
+	// dynamoDBBatchWriteSize is the maximum items of a BatchWriteItem
+	dynamoDBBatchWriteSize = 25
+	maxWriteAttempts       = 5
+	// dynamoDBPartition all the entries share the same partition, sorted by SortKey,
+	// so they can be queried newest first. The rerun volume is far below a partition throughput
+	dynamoDBPartition = "rerun"
+	// sortKeyTimeFormat fixed width, so the sort keys are sorted by time
+	sortKeyTimeFormat = "2006-01-02T15:04:05.000000000Z"
+	// maxFindQueries bound the partition read by a filtered Find, since the filter isn't part of the key
+	maxFindQueries = 10
+)
+
+type dynamoDBClient interface {
+	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+}
+
+// dynamoDBEntry the table has Log hash key & SortKey range key, created by the migrate subcommand
+type dynamoDBEntry struct {
+	Log          string
+	SortKey      string
+	ID           string
+	Actor        string
+	Action       string
+	OriginalUUID string
+	NewUUID      string
+	GroupUUID    string `dynamodbav:",omitempty"`
+	// Edit is a JSON string, since the edited args & headers can be of any type
+	Edit      string `dynamodbav:",omitempty"`
+	Success   bool
+	Error     string `dynamodbav:",omitempty"`
+	CreatedAt string
+}
+
+// DynamoDBStore :nodoc:
+type DynamoDBStore struct {
+	client dynamoDBClient
+	table  string
+}
+
+// NewDynamoDBStore :nodoc:
+func NewDynamoDBStore(client dynamoDBClient, table string) *DynamoDBStore {
+	return &DynamoDBStore{client: client, table: table}
+}
+
+// Record :nodoc:
+func (s *DynamoDBStore) Record(entries []*Entry) error {
+	for start := 0; start < len(entries); start += dynamoDBBatchWriteSize {
+		end := start + dynamoDBBatchWriteSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		var requests []*dynamodb.WriteRequest
+		for _, entry := range entries[start:end] {
+			item, err := newDynamoDBEntry(entry)
+			if err != nil {
+				return err
+			}
+
+			av, err := dynamodbattribute.MarshalMap(item)
+			if err != nil {
+				return fmt.Errorf("failed to marshal audit entry: %w", err)
+			}
+			requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: av}})
+		}
+
+		if err := s.writeRequests(requests); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRequests retry the unprocessed items, they are returned when the table is throttled
+func (s *DynamoDBStore) writeRequests(requests []*dynamodb.WriteRequest) error {
+	for attempt := 1; len(requests) > 0; attempt++ {
+		if attempt > maxWriteAttempts {
+			return fmt.Errorf("failed to write %d audit entries: too many unprocessed items", len(requests))
+		}
+
+		out, err := s.client.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{s.table: requests},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write audit entries: %w", err)
+		}
+
+		requests = out.UnprocessedItems[s.table]
+		if len(requests) > 0 {
+			time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// Find the entries matching the filter, the latest first. The filter is applied after the query reads the items,
+// so the query is repeated until the page is full, up to maxFindQueries. A page may then be partial or even empty
+// while next is set, the rest of the partition is read by the following pages
+func (s *DynamoDBStore) Find(filter *Filter, cursor string, size int64) (entries []*Entry, next string, err error) {
+	var startKey map[string]*dynamodb.AttributeValue
+	if cursor != "" {
+		startKey, err = decodeCursor(cursor)
+		if err != nil {
+			return nil, "", ErrInvalidCursor
+		}
+	}
+
+	names := map[string]*string{"#log": aws.String("Log")}
+	values := map[string]*dynamodb.AttributeValue{":log": {S: aws.String(dynamoDBPartition)}}
+	filterExpression := buildFilterExpression(filter, names, values)
+
+	for queries := 1; ; queries++ {
+		out, err := s.client.Query(&dynamodb.QueryInput{
+			TableName:                 aws.String(s.table),
+			KeyConditionExpression:    aws.String("#log = :log"),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+			FilterExpression:          filterExpression,
+			ScanIndexForward:          aws.Bool(false),
+			ExclusiveStartKey:         startKey,
+			// the evaluated items never exceed the remaining size, so LastEvaluatedKey is a valid cursor
+			Limit: aws.Int64(size - int64(len(entries))),
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to query audit entries: %w", err)
+		}
+
+		for _, item := range out.Items {
+			dynamoEntry := &dynamoDBEntry{}
+			if err := dynamodbattribute.UnmarshalMap(item, dynamoEntry); err != nil {
+				return nil, "", fmt.Errorf("failed to unmarshal audit entry: %w", err)
+			}
+
+			entry, err := dynamoEntry.toEntry()
+			if err != nil {
+				return nil, "", err
+			}
+			entries = append(entries, entry)
+		}
+
+		startKey = out.LastEvaluatedKey
+		if len(startKey) == 0 {
+			return entries, "", nil
+		}
+
+		if int64(len(entries)) >= size || queries >= maxFindQueries {
+			next, err = encodeCursor(startKey)
+			return entries, next, err
+		}
+	}
+}
+
+// buildFilterExpression Action is a reserved word, so it is referred by name
+func buildFilterExpression(filter *Filter, names map[string]*string, values map[string]*dynamodb.AttributeValue) *string {
+	var conditions []string
+	if filter.Actor != "" {
+		values[":actor"] = &dynamodb.AttributeValue{S: aws.String(filter.Actor)}
+		conditions = append(conditions, "Actor = :actor")
+	}
+	if filter.Action != "" {
+		names["#action"] = aws.String("Action")
+		values[":action"] = &dynamodb.AttributeValue{S: aws.String(filter.Action)}
+		conditions = append(conditions, "#action = :action")
+	}
+	if filter.UUID != "" {
+		values[":uuid"] = &dynamodb.AttributeValue{S: aws.String(filter.UUID)}
+		conditions = append(conditions, "(OriginalUUID = :uuid OR NewUUID = :uuid)")
+	}
+
+	if len(conditions) == 0 {
+		return nil
+	}
+	return aws.String(strings.Join(conditions, " AND "))
+}
+
+func newDynamoDBEntry(entry *Entry) (*dynamoDBEntry, error) {
+	createdAt := entry.CreatedAt.UTC()
+	item := &dynamoDBEntry{
+		Log:          dynamoDBPartition,
+		SortKey:      createdAt.Format(sortKeyTimeFormat) + "#" + entry.ID,
+		ID:           entry.ID,
+		Actor:        entry.Actor,
+		Action:       entry.Action,
+		OriginalUUID: entry.OriginalUUID,
+		NewUUID:      entry.NewUUID,
+		GroupUUID:    entry.GroupUUID,
+		Success:      entry.Success,
+		Error:        entry.Error,
+		CreatedAt:    createdAt.Format(time.RFC3339Nano),
+	}
+
+	if len(entry.Edit) > 0 {
+		bt, err := json.Marshal(entry.Edit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal audit edit: %w", err)
+		}
+		item.Edit = string(bt)
+	}
+	return item, nil
+}
+
+func (e *dynamoDBEntry) toEntry() (*Entry, error) {
+	entry := &Entry{
+		ID:           e.ID,
+		Actor:        e.Actor,
+		Action:       e.Action,
+		OriginalUUID: e.OriginalUUID,
+		NewUUID:      e.NewUUID,
+		GroupUUID:    e.GroupUUID,
+		Success:      e.Success,
+		Error:        e.Error,
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, e.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse audit created at: %w", err)
+	}
+	entry.CreatedAt = createdAt
+
+	if e.Edit != "" {
+		if err := json.Unmarshal([]byte(e.Edit), &entry.Edit); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit edit: %w", err)
+		}
+	}
+	return entry, nil
+}
+
+func decodeCursor(cursor string) (map[string]*dynamodb.AttributeValue, error) {
+	bt, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	key := map[string]*dynamodb.AttributeValue{}
+	if err := json.Unmarshal(bt, &key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func encodeCursor(key map[string]*dynamodb.AttributeValue) (string, error) {
+	bt, err := json.Marshal(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(bt), nil
+}
@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDynamoDBClient keep the items sorted by SortKey, the query ignores the filter expression
+type fakeDynamoDBClient struct {
+	items      []map[string]*dynamodb.AttributeValue
+	batches    int
+	unprocess  bool
+	queryErr   error
+	lastFilter *string
+	queries    int
+}
+
+func (f *fakeDynamoDBClient) BatchWriteItem(in *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	f.batches++
+	out := &dynamodb.BatchWriteItemOutput{UnprocessedItems: map[string][]*dynamodb.WriteRequest{}}
+	for table, requests := range in.RequestItems {
+		// the first item of the first batch is unprocessed, so it is retried
+		if f.unprocess {
+			f.unprocess = false
+			out.UnprocessedItems[table] = requests[:1]
+			requests = requests[1:]
+		}
+
+		for _, req := range requests {
+			f.items = append(f.items, req.PutRequest.Item)
+		}
+	}
+
+	sort.Slice(f.items, func(i, j int) bool {
+		return aws.StringValue(f.items[i]["SortKey"].S) < aws.StringValue(f.items[j]["SortKey"].S)
+	})
+	return out, nil
+}
+
+func (f *fakeDynamoDBClient) Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+	f.lastFilter = in.FilterExpression
+	f.queries++
+
+	// newest first
+	i := len(f.items) - 1
+	if in.ExclusiveStartKey != nil {
+		for i >= 0 && aws.StringValue(f.items[i]["SortKey"].S) != aws.StringValue(in.ExclusiveStartKey["SortKey"].S) {
+			i--
+		}
+		i--
+	}
+
+	// Limit bound the evaluated items, only the uuid filter is applied after
+	out := &dynamodb.QueryOutput{}
+	var last map[string]*dynamodb.AttributeValue
+	for evaluated := int64(0); i >= 0 && evaluated < aws.Int64Value(in.Limit); i-- {
+		evaluated++
+		last = f.items[i]
+		if uuid, ok := in.ExpressionAttributeValues[":uuid"]; ok &&
+			aws.StringValue(last["OriginalUUID"].S) != aws.StringValue(uuid.S) &&
+			aws.StringValue(last["NewUUID"].S) != aws.StringValue(uuid.S) {
+			continue
+		}
+		out.Items = append(out.Items, last)
+	}
+	if i >= 0 && last != nil {
+		out.LastEvaluatedKey = map[string]*dynamodb.AttributeValue{"Log": last["Log"], "SortKey": last["SortKey"]}
+	}
+	return out, nil
+}
+
+func Test_DynamoDBStore(t *testing.T) {
+	client := &fakeDynamoDBClient{unprocess: true}
+	store := NewDynamoDBStore(client, "audit_table")
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var entries []*Entry
+	for i := 0; i < 30; i++ {
+		entries = append(entries, &Entry{
+			ID:           string(rune('a' + i)),
+			Actor:        "alice",
+			Action:       ActionBulkRerun,
+			OriginalUUID: "uuid",
+			NewUUID:      "uuid",
+			Success:      true,
+			CreatedAt:    createdAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+	entries[29].Edit = map[string]interface{}{"priority": float64(3)}
+
+	require.NoError(t, store.Record(entries))
+	assert.Len(t, client.items, 30)
+	assert.Equal(t, 3, client.batches, "2 batches and a retry of the unprocessed item")
+
+	found, next, err := store.Find(&Filter{Action: ActionBulkRerun}, "", 20)
+	require.NoError(t, err)
+	require.Len(t, found, 20)
+	assert.Equal(t, entries[29].ID, found[0].ID)
+	assert.Equal(t, entries[29].CreatedAt, found[0].CreatedAt)
+	assert.Equal(t, entries[29].Edit, found[0].Edit)
+	assert.Equal(t, "#action = :action", aws.StringValue(client.lastFilter))
+	require.NotEmpty(t, next)
+
+	found, next, err = store.Find(&Filter{Action: ActionBulkRerun}, next, 20)
+	require.NoError(t, err)
+	require.Len(t, found, 10)
+	assert.Equal(t, entries[9].ID, found[0].ID)
+	assert.Empty(t, next)
+
+	t.Run("bound the filtered queries", func(t *testing.T) {
+		require.NoError(t, store.Record([]*Entry{{
+			ID:           "old",
+			Action:       ActionRerun,
+			OriginalUUID: "other",
+			NewUUID:      "other",
+			Success:      true,
+			CreatedAt:    createdAt.Add(-time.Second),
+		}}))
+
+		client.queries = 0
+		found, next, err := store.Find(&Filter{UUID: "other"}, "", 1)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+		assert.NotEmpty(t, next, "the rest of the partition is read by the next page")
+		assert.Equal(t, maxFindQueries, client.queries)
+
+		for i := 0; len(found) == 0 && next != "" && i < 5; i++ {
+			found, next, err = store.Find(&Filter{UUID: "other"}, next, 1)
+			require.NoError(t, err)
+		}
+		require.Len(t, found, 1)
+		assert.Equal(t, "old", found[0].ID)
+	})
+
+	_, _, err = store.Find(&Filter{}, "not base64", 20)
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+
+	client.queryErr = errors.New("gotcha")
+	_, _, err = store.Find(&Filter{}, "", 20)
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileStore append the entries as JSON lines into a local file, meant for a single instance
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileStore create the file when it doesn't exist
+func NewFileStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close audit file: %w", err)
+	}
+	return &FileStore{path: path}, nil
+}
+
+// Record :nodoc:
+func (s *FileStore) Record(entries []*Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return fmt.Errorf("failed to write audit entry: %w", err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// Find the entries matching the filter, the latest first. The cursor is the ID of the last entry of the previous page
+func (s *FileStore) Find(filter *Filter, cursor string, size int64) (entries []*Entry, next string, err error) {
+	all, err := s.readAll()
+	if err != nil {
+		return nil, "", err
+	}
+
+	i := len(all) - 1
+	if cursor != "" {
+		for i >= 0 && all[i].ID != cursor {
+			i--
+		}
+		if i < 0 {
+			return nil, "", ErrInvalidCursor
+		}
+		i--
+	}
+
+	for ; i >= 0; i-- {
+		if !filter.Match(all[i]) {
+			continue
+		}
+
+		if int64(len(entries)) == size {
+			return entries, entries[len(entries)-1].ID, nil
+		}
+		entries = append(entries, all[i])
+	}
+	return entries, "", nil
+}
+
+func (s *FileStore) readAll() ([]*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	var entries []*Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		entry := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, fmt.Errorf("failed to read audit entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit file: %w", err)
+	}
+	return entries, nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -50,6 +51,14 @@ func Port() string {
 	return viper.GetString("port")
 }
 
+// Timezone IANA timezone used to show the times & read the time filters, e.g. Asia/Jakarta, default to UTC
+func Timezone() string {
+	if viper.GetString("timezone") == "" {
+		return "UTC"
+	}
+	return viper.GetString("timezone")
+}
+
 // DynamoDBHost :nodoc:
 func DynamoDBHost() string {
 	return viper.GetString("dynamodb.host")
@@ -80,6 +89,23 @@ func MachineryBrokerHost() string {
 	return viper.GetString("machinery.broker_host")
 }
 
+// MachineryResultBackend result backend url used by machinery, e.g. redis://localhost:6379/4 or mongodb://localhost:27017,
+// default to dynamodb
+func MachineryResultBackend() string {
+	if viper.GetString("machinery.result_backend") == "" {
+		return "https://dynamodb"
+	}
+	return viper.GetString("machinery.result_backend")
+}
+
+// MongoDBDatabase database used by machinery mongodb result backend
+func MongoDBDatabase() string {
+	if viper.GetString("mongodb.database") == "" {
+		return "machinery"
+	}
+	return viper.GetString("mongodb.database")
+}
+
 // DynamoDBAWSRegion :nodoc:
 func DynamoDBAWSRegion() string {
 	return viper.GetString("dynamodb.aws_region")
@@ -94,3 +120,213 @@ func DynamoDBAWSAccessKey() string {
 func DynamoDBAWSSecretAccess() string {
 	return viper.GetString("dynamodb.aws_secret_access")
 }
+
+// RerunJobRate default number of tasks rerun per second by background jobs
+func RerunJobRate() float64 {
+	if viper.GetFloat64("rerun_job.rate") <= 0 {
+		return 10
+	}
+	return viper.GetFloat64("rerun_job.rate")
+}
+
+// RerunJobMaxRate maximum number of tasks rerun per second by background jobs
+func RerunJobMaxRate() float64 {
+	if viper.GetFloat64("rerun_job.max_rate") <= 0 {
+		return 100
+	}
+	return viper.GetFloat64("rerun_job.max_rate")
+}
+
+// RerunJobRetention how long a finished background job is kept
+func RerunJobRetention() time.Duration {
+	if viper.GetInt("rerun_job.retention") <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(viper.GetInt("rerun_job.retention")) * time.Second
+}
+
+// RerunJobMaxHistory maximum number of finished background jobs kept
+func RerunJobMaxHistory() int {
+	if viper.GetInt("rerun_job.max_history") <= 0 {
+		return 100
+	}
+	return viper.GetInt("rerun_job.max_history")
+}
+
+// RerunFreshUUID whether the tasks are rerun as new tasks by default, linked to the original by a signature header
+func RerunFreshUUID() bool {
+	return viper.GetBool("rerun.fresh_uuid")
+}
+
+// CountCacheTTL how long the task counts are fresh, since counting reads all the tasks.
+// The expired counts are still shown while they are counted again in the background
+func CountCacheTTL() time.Duration {
+	if viper.GetInt("count_cache_ttl") <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(viper.GetInt("count_cache_ttl")) * time.Second
+}
+
+// MetricsRefreshInterval how often the task count metrics are refreshed from the result backend
+func MetricsRefreshInterval() time.Duration {
+	if viper.GetInt("metrics_refresh_interval") <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(viper.GetInt("metrics_refresh_interval")) * time.Second
+}
+
+// AlertRule see alert.Rule
+type AlertRule struct {
+	TaskName  string  `mapstructure:"task_name"`
+	Threshold int64   `mapstructure:"threshold"`
+	Rate      float64 `mapstructure:"rate"`
+}
+
+// AlertingRules :nodoc:
+func AlertingRules() ([]*AlertRule, error) {
+	var rules []*AlertRule
+	if err := viper.UnmarshalKey("alerting.rules", &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// AlertingWebhooks urls of the Slack compatible webhooks the alerts are posted to
+func AlertingWebhooks() []string {
+	return viper.GetStringSlice("alerting.webhooks")
+}
+
+// AlertingDashboardURL public url of the dashboard, used to link the failed tasks on the alerts
+func AlertingDashboardURL() string {
+	return viper.GetString("alerting.dashboard_url")
+}
+
+// AlertingInterval how often the alert rules are evaluated
+func AlertingInterval() time.Duration {
+	if viper.GetInt("alerting.interval") <= 0 {
+		return 60 * time.Second
+	}
+	return time.Duration(viper.GetInt("alerting.interval")) * time.Second
+}
+
+// AlertingCooldown minimum time between the firing notifications of the same alert
+func AlertingCooldown() time.Duration {
+	if viper.GetInt("alerting.cooldown") <= 0 {
+		return 30 * time.Minute
+	}
+	return time.Duration(viper.GetInt("alerting.cooldown")) * time.Second
+}
+
+// AutoRerunPolicy see retry.Policy, Backoff is in seconds
+type AutoRerunPolicy struct {
+	Name      string `mapstructure:"name"`
+	TaskName  string `mapstructure:"task_name"`
+	Error     string `mapstructure:"error"`
+	MaxReruns int    `mapstructure:"max_reruns"`
+	Backoff   int    `mapstructure:"backoff"`
+}
+
+// AutoRerunPolicies :nodoc:
+func AutoRerunPolicies() ([]*AutoRerunPolicy, error) {
+	var policies []*AutoRerunPolicy
+	if err := viper.UnmarshalKey("auto_rerun.policies", &policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// AutoRerunInterval how often the failed tasks are scanned for auto rerun
+func AutoRerunInterval() time.Duration {
+	if viper.GetInt("auto_rerun.interval") <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(viper.GetInt("auto_rerun.interval")) * time.Second
+}
+
+// AutoRerunScanLimit maximum failed tasks read per scan
+func AutoRerunScanLimit() int {
+	if viper.GetInt("auto_rerun.scan_limit") <= 0 {
+		return 5000
+	}
+	return viper.GetInt("auto_rerun.scan_limit")
+}
+
+// AutoRerunMaxPerScan maximum tasks rerun per scan
+func AutoRerunMaxPerScan() int {
+	if viper.GetInt("auto_rerun.max_per_scan") <= 0 {
+		return 100
+	}
+	return viper.GetInt("auto_rerun.max_per_scan")
+}
+
+// list of audit stores
+const (
+	AuditStoreFile     = "file"
+	AuditStoreDynamoDB = "dynamodb"
+)
+
+// AuditStore where the reruns are recorded, either file or dynamodb, empty disables the audit log
+func AuditStore() string {
+	return viper.GetString("audit.store")
+}
+
+// AuditFilePath :nodoc:
+func AuditFilePath() string {
+	if viper.GetString("audit.file_path") == "" {
+		return "audit.log"
+	}
+	return viper.GetString("audit.file_path")
+}
+
+// AuditDynamoDBTable :nodoc:
+func AuditDynamoDBTable() string {
+	if viper.GetString("audit.dynamodb_table") == "" {
+		return "machinerydash_audit"
+	}
+	return viper.GetString("audit.dynamodb_table")
+}
+
+// AuditActorHeader request header set by the authenticating proxy with the user name, disabled by default.
+// Only enable it behind a proxy that strips the header from the client requests and sets it,
+// otherwise anyone can claim to be anyone. The client IP is recorded when it is disabled or the header is empty
+func AuditActorHeader() string {
+	return viper.GetString("audit.actor_header")
+}
+
+// list of rerun log stores
+const (
+	RerunLogStoreMemory   = "memory"
+	RerunLogStoreDynamoDB = "dynamodb"
+)
+
+// RerunLogStore where the reruns are kept to show the scheduled reruns & the rerun lineage, either memory or dynamodb
+func RerunLogStore() string {
+	if viper.GetString("rerun_log.store") == "" {
+		return RerunLogStoreMemory
+	}
+	return viper.GetString("rerun_log.store")
+}
+
+// RerunLogMaxRecords maximum number of reruns kept when the store is memory
+func RerunLogMaxRecords() int {
+	if viper.GetInt("rerun_log.max_records") <= 0 {
+		return 10000
+	}
+	return viper.GetInt("rerun_log.max_records")
+}
+
+// RerunLogDynamoDBTable :nodoc:
+func RerunLogDynamoDBTable() string {
+	if viper.GetString("rerun_log.dynamodb_table") == "" {
+		return "machinerydash_rerun_log"
+	}
+	return viper.GetString("rerun_log.dynamodb_table")
+}
+
+// RerunLogRetention how long the reruns are kept when the store is dynamodb, counted from the rerun ETA
+func RerunLogRetention() time.Duration {
+	if viper.GetInt("rerun_log.retention") <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(viper.GetInt("rerun_log.retention")) * time.Second
+}
@@ -0,0 +1,81 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/kumparan/machinerydash/config"
+	"github.com/kumparan/machinerydash/db"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var migrateCMD = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate dynamodb schema",
+	Long:  `This subcommand create the DynamoDB tables, indexes & TTL needed by machinery and the dashboard`,
+	Run:   runMigrate,
+}
+
+func init() {
+	migrateCMD.Flags().Bool("dry-run", false, "only print the migration plan")
+	migrateCMD.Flags().Duration("timeout", 0, "how long to wait for each step to be ACTIVE, default to 30m")
+	RootCmd.AddCommand(migrateCMD)
+}
+
+func runMigrate(cmd *cobra.Command, args []string) {
+	taskTable, groupTable := config.DynamoDBTaskTable(), config.DynamoDBGroupTable()
+	resultBackend := config.MachineryResultBackend()
+	if isRedisResultBackend(resultBackend) || isMongoResultBackend(resultBackend) {
+		taskTable, groupTable = "", ""
+	}
+
+	var auditTable string
+	if config.AuditStore() == config.AuditStoreDynamoDB {
+		auditTable = config.AuditDynamoDBTable()
+	}
+
+	var rerunLogTable string
+	if config.RerunLogStore() == config.RerunLogStoreDynamoDB {
+		rerunLogTable = config.RerunLogDynamoDBTable()
+	}
+
+	if taskTable == "" && auditTable == "" && rerunLogTable == "" {
+		logrus.Info("neither result backend, audit store nor rerun log store is dynamodb, nothing to migrate")
+		return
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	migration := db.NewDynamoDBMigration(db.NewDynamoDBClient(), taskTable, groupTable)
+	migration.AuditTable = auditTable
+	migration.RerunLogTable = rerunLogTable
+	if timeout > 0 {
+		migration.Timeout = timeout
+	}
+
+	steps, err := migration.Plan()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	if len(steps) == 0 {
+		fmt.Println("schema is up to date")
+		return
+	}
+
+	fmt.Println("migration plan:")
+	for i, step := range steps {
+		fmt.Printf("%d. %s\n", i+1, step.Description)
+	}
+
+	if dryRun {
+		return
+	}
+
+	err = migration.Apply(steps)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	fmt.Println("migration done")
+}
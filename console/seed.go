@@ -0,0 +1,55 @@
+package console
+
+import (
+	"fmt"
+
+	"github.com/kumparan/machinerydash/config"
+	"github.com/kumparan/machinerydash/db"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var seedCMD = &cobra.Command{
+	Use:   "seed",
+	Short: "seed fake tasks",
+	Long:  `This subcommand create the DynamoDB tables and insert fake tasks & groups, used to develop the dashboard without real workers`,
+	Run:   runSeed,
+}
+
+func init() {
+	seedCMD.Flags().Int("tasks", 500, "minimum number of tasks to insert")
+	seedCMD.Flags().Int64("random-seed", 1, "the same seed inserts the same tasks")
+	seedCMD.Flags().Bool("force", false, "seed even when dynamodb.host is empty, i.e. on AWS")
+	RootCmd.AddCommand(seedCMD)
+}
+
+func runSeed(cmd *cobra.Command, args []string) {
+	n, _ := cmd.Flags().GetInt("tasks")
+	randomSeed, _ := cmd.Flags().GetInt64("random-seed")
+	force, _ := cmd.Flags().GetBool("force")
+
+	// an empty host means the AWS endpoint, don't pollute a real table by mistake
+	if config.DynamoDBHost() == "" && !force {
+		logrus.Fatal("dynamodb.host is empty, seed is meant for a local DynamoDB, use --force to seed anyway")
+	}
+
+	client := db.NewDynamoDBClient()
+	migration := db.NewDynamoDBMigration(client, config.DynamoDBTaskTable(), config.DynamoDBGroupTable())
+	steps, err := migration.Plan()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	err = migration.Apply(steps)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	seeder := db.NewDynamoDBSeeder(client, config.DynamoDBTaskTable(), config.DynamoDBGroupTable(), randomSeed)
+	report, err := seeder.Seed(n)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	fmt.Printf("inserted %d tasks and %d groups into %s\n", report.Tasks, report.Groups, config.DynamoDBHost())
+}
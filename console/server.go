@@ -1,11 +1,21 @@
 package console
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/RichardKnop/machinery/v1"
 	machineryConfig "github.com/RichardKnop/machinery/v1/config"
+	"github.com/kumparan/machinerydash/alert"
+	"github.com/kumparan/machinerydash/audit"
 	"github.com/kumparan/machinerydash/config"
 	"github.com/kumparan/machinerydash/dashboard"
 	"github.com/kumparan/machinerydash/db"
+	"github.com/kumparan/machinerydash/job"
+	"github.com/kumparan/machinerydash/metrics"
+	"github.com/kumparan/machinerydash/rerunlog"
+	"github.com/kumparan/machinerydash/retry"
 	"github.com/kumparan/machinerydash/server"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -30,36 +40,209 @@ func runServer(cmd *cobra.Command, args []string) {
 		logrus.Fatal(err)
 	}
 
-	machineryDash := dashboard.NewDynamodb(cfg, machineryServer)
-	srv := server.New(config.Port(), machineryDash)
+	machineryDash, err := newDashboard(cfg, metrics.InstrumentServer(machineryServer))
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	machineryDash = metrics.InstrumentDashboard(dashboard.NewCountCache(machineryDash, config.CountCacheTTL()))
+
+	auditStore, err := newAuditStore()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if auditStore != nil {
+		machineryDash = audit.NewDashboard(machineryDash, auditStore)
+	}
+
+	rerunLogStore, err := newRerunLogStore()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	machineryDash = rerunlog.NewDashboard(machineryDash, rerunLogStore)
+
+	countRefresher := metrics.NewTaskCountRefresher(machineryDash, server.StateList(), config.MetricsRefreshInterval())
+	countRefresher.Start()
+
+	// machinery mongodb result backend doesn't store the signatures to rerun
+	rerunSupported := !isMongoResultBackend(cfg.ResultBackend)
+
+	startAlertEvaluator(machineryDash)
+	if rerunSupported {
+		startAutoRerun(machineryDash)
+	} else {
+		logrus.Warn("auto rerun is disabled, the result backend doesn't support rerun")
+	}
+
+	loc, err := time.LoadLocation(config.Timezone())
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	jobManager := job.NewManager(machineryDash, config.RerunJobRate(), config.RerunJobMaxRate())
+	jobManager.SetHistory(config.RerunJobRetention(), config.RerunJobMaxHistory())
+	srv := server.New(config.Port(), machineryDash, jobManager, loc)
+	if auditStore != nil {
+		srv.EnableAuditLog(auditStore, config.AuditActorHeader())
+	}
+	srv.EnableRerunLog(rerunLogStore)
+	if config.RerunFreshUUID() {
+		srv.EnableFreshUUID()
+	}
+	if !rerunSupported {
+		srv.DisableRerun()
+	}
 	srv.Start()
 }
 
+type auditStore interface {
+	Record(entries []*audit.Entry) error
+	Find(filter *audit.Filter, cursor string, size int64) (entries []*audit.Entry, next string, err error)
+}
+
+// newAuditStore return nil when the audit log is disabled
+func newAuditStore() (auditStore, error) {
+	switch config.AuditStore() {
+	case "":
+		return nil, nil
+	case config.AuditStoreFile:
+		store, err := audit.NewFileStore(config.AuditFilePath())
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case config.AuditStoreDynamoDB:
+		client := db.NewDynamoDBClient()
+		metrics.InstrumentDynamoDBClient(client)
+		return audit.NewDynamoDBStore(client, config.AuditDynamoDBTable()), nil
+	default:
+		return nil, fmt.Errorf("invalid audit store %s, must be file or dynamodb", config.AuditStore())
+	}
+}
+
+type rerunLogStore interface {
+	Record(records []*rerunlog.Record) error
+	Find(uuid string, size int64) ([]*rerunlog.Record, error)
+}
+
+func newRerunLogStore() (rerunLogStore, error) {
+	switch config.RerunLogStore() {
+	case config.RerunLogStoreMemory:
+		return rerunlog.NewMemoryStore(config.RerunLogMaxRecords()), nil
+	case config.RerunLogStoreDynamoDB:
+		client := db.NewDynamoDBClient()
+		metrics.InstrumentDynamoDBClient(client)
+		return rerunlog.NewDynamoDBStore(client, config.RerunLogDynamoDBTable(), config.RerunLogRetention()), nil
+	default:
+		return nil, fmt.Errorf("invalid rerun log store %s, must be memory or dynamodb", config.RerunLogStore())
+	}
+}
+
+// startAlertEvaluator alerting is disabled when there is no rule or webhook
+func startAlertEvaluator(d dashboard.Dashboard) {
+	configRules, err := config.AlertingRules()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	webhooks := config.AlertingWebhooks()
+	if len(configRules) == 0 || len(webhooks) == 0 {
+		return
+	}
+
+	rules := make([]*alert.Rule, 0, len(configRules))
+	for _, rule := range configRules {
+		rules = append(rules, &alert.Rule{TaskName: rule.TaskName, Threshold: rule.Threshold, Rate: rule.Rate})
+	}
+
+	notifier := alert.NewWebhookNotifier(webhooks, config.AlertingDashboardURL())
+	evaluator, err := alert.NewEvaluator(d, rules, notifier, config.AlertingInterval(), config.AlertingCooldown())
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	evaluator.Start()
+}
+
+// startAutoRerun auto rerun is disabled when there is no policy
+func startAutoRerun(d dashboard.Dashboard) {
+	configPolicies, err := config.AutoRerunPolicies()
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
+	if len(configPolicies) == 0 {
+		logrus.Info("auto rerun is disabled, no policy is configured")
+		return
+	}
+
+	policies := make([]*retry.Policy, 0, len(configPolicies))
+	for _, p := range configPolicies {
+		policy, err := retry.NewPolicy(p.Name, p.TaskName, p.Error, p.MaxReruns, time.Duration(p.Backoff)*time.Second)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		policies = append(policies, policy)
+	}
+
+	retrier := retry.NewRetrier(d, policies, config.AutoRerunInterval())
+	retrier.ScanLimit = config.AutoRerunScanLimit()
+	retrier.MaxPerScan = config.AutoRerunMaxPerScan()
+	retrier.Start()
+}
+
 func createMachineryCfg() *machineryConfig.Config {
-	dynamoDBClient := db.NewDynamoDBClient()
 	cfg := &machineryConfig.Config{
 		Broker: config.MachineryBrokerHost(),
-		DynamoDB: &machineryConfig.DynamoDBConfig{
-			TaskStatesTable: config.DynamoDBTaskTable(),
-			GroupMetasTable: config.DynamoDBGroupTable(),
-			Client:          dynamoDBClient,
-		},
 		// machinery uses ResultBackend to determine which backend will be used
 		// see https://github.com/kumparan/machinery/blob/master/v1/factories.go#L178
-		ResultBackend:   "https://dynamodb",
+		ResultBackend:   config.MachineryResultBackend(),
 		DefaultQueue:    config.MachineryBrokerNamespace(), // use namespace as queue
 		ResultsExpireIn: config.MachineryResultExpiry(),
 	}
 
-	err := db.EnableDynamoDBTTL(dynamoDBClient, cfg.DynamoDB.TaskStatesTable, "TTL")
-	if err != nil {
-		logrus.Fatal(err)
+	switch {
+	case isRedisResultBackend(cfg.ResultBackend):
+		return cfg
+	case isMongoResultBackend(cfg.ResultBackend):
+		mongoClient, err := db.NewMongoClient(cfg.ResultBackend)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
+		cfg.MongoDB = &machineryConfig.MongoDBConfig{
+			Client:   mongoClient,
+			Database: config.MongoDBDatabase(),
+		}
+		return cfg
 	}
 
-	err = db.EnableDynamoDBTTL(dynamoDBClient, cfg.DynamoDB.GroupMetasTable, "TTL")
-	if err != nil {
-		logrus.Fatal(err)
+	dynamoDBClient := db.NewDynamoDBClient()
+	metrics.InstrumentDynamoDBClient(dynamoDBClient)
+
+	// the tables, indexes & TTL are created by the migrate subcommand
+	cfg.DynamoDB = &machineryConfig.DynamoDBConfig{
+		TaskStatesTable: config.DynamoDBTaskTable(),
+		GroupMetasTable: config.DynamoDBGroupTable(),
+		Client:          dynamoDBClient,
 	}
 
 	return cfg
 }
+
+func newDashboard(cfg *machineryConfig.Config, machineryServer *metrics.InstrumentedServer) (dashboard.Dashboard, error) {
+	switch {
+	case isRedisResultBackend(cfg.ResultBackend):
+		return dashboard.NewRedis(cfg, machineryServer)
+	case isMongoResultBackend(cfg.ResultBackend):
+		return dashboard.NewMongo(cfg)
+	default:
+		return dashboard.NewDynamodb(cfg, machineryServer), nil
+	}
+}
+
+func isRedisResultBackend(resultBackend string) bool {
+	return strings.HasPrefix(resultBackend, "redis://") || strings.HasPrefix(resultBackend, "rediss://")
+}
+
+func isMongoResultBackend(resultBackend string) bool {
+	return strings.HasPrefix(resultBackend, "mongodb://") || strings.HasPrefix(resultBackend, "mongodb+srv://")
+}
@@ -0,0 +1,191 @@
+package dashboard
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+const (
+	defaultBulkRerunConcurrency = 10
+	maxBulkRerunConcurrency     = 50
+	defaultBulkRerunLimit       = 5000
+	bulkRerunPageSize           = 100
+)
+
+// BulkRerunRequest select the tasks to rerun either by UUIDs or by Filter
+type BulkRerunRequest struct {
+	UUIDs       []string
+	Filter      *BulkRerunFilter
+	Concurrency int
+	// ETA schedule the reruns, nil means rerun immediately
+	ETA *time.Time
+	// FreshUUID rerun the tasks as new tasks, except the group members, see ScheduleRerunTask
+	FreshUUID bool
+}
+
+// BulkRerunFilter match tasks by state, task name, created at range & error,
+// zero values are ignored
+type BulkRerunFilter struct {
+	State         string
+	TaskName      string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	ErrorContains string
+	// ErrorSignature match the tasks whose normalized error equals, see NormalizeError
+	ErrorSignature string
+	Limit          int
+}
+
+// BulkRerunReport :nodoc:
+type BulkRerunReport struct {
+	Total     int                `json:"total"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Results   []*BulkRerunResult `json:"results"`
+	ETA       *time.Time         `json:"eta,omitempty"`
+}
+
+// BulkRerunResult rerun result of a single task
+type BulkRerunResult struct {
+	UUID string `json:"uuid"`
+	// NewUUID of the new task when the task is rerun as a new task
+	NewUUID string `json:"new_uuid,omitempty"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Match check whether the task matches the filter
+func (f *BulkRerunFilter) Match(task *TaskWithSignature) bool {
+	if f.ErrorSignature != "" && NormalizeError(task.Error) != f.ErrorSignature {
+		return false
+	}
+
+	tf := f.taskFilter()
+	return tf.MatchTaskName(task.TaskName) && tf.MatchCreatedAt(task.CreatedAt) && tf.MatchError(task.Error)
+}
+
+func (f *BulkRerunFilter) taskFilter() *TaskFilter {
+	tf := &TaskFilter{
+		State:         strings.ToUpper(f.State),
+		TaskName:      f.TaskName,
+		CreatedAfter:  f.CreatedAfter,
+		CreatedBefore: f.CreatedBefore,
+		ErrorContains: f.ErrorContains,
+	}
+	if tf.State == "" {
+		tf.State = tasks.StateFailure
+	}
+	return tf
+}
+
+// bulkRerunTasks rerun the selected tasks with bounded concurrency,
+// failures are reported per task instead of stopping the whole process
+func bulkRerunTasks(d Dashboard, req *BulkRerunRequest) (*BulkRerunReport, error) {
+	uuids := req.UUIDs
+	if req.Filter != nil {
+		var err error
+		uuids, err = FindTaskUUIDsByFilter(d, req.Filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	concurrency := req.Concurrency
+	switch {
+	case concurrency <= 0:
+		concurrency = defaultBulkRerunConcurrency
+	case concurrency > maxBulkRerunConcurrency:
+		concurrency = maxBulkRerunConcurrency
+	}
+
+	report := &BulkRerunReport{
+		Total:   len(uuids),
+		Results: make([]*BulkRerunResult, len(uuids)),
+		ETA:     req.ETA,
+	}
+
+	sem := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for i, uuid := range uuids {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, uuid string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			res := &BulkRerunResult{UUID: uuid, Success: true}
+			newUUID, err := ScheduleRerunTask(d, uuid, req.ETA, req.FreshUUID)
+			if err != nil {
+				res.Success = false
+				res.Error = err.Error()
+			}
+			if newUUID != uuid {
+				res.NewUUID = newUUID
+			}
+			report.Results[i] = res
+		}(i, uuid)
+	}
+	wg.Wait()
+
+	for _, res := range report.Results {
+		if res.Success {
+			report.Succeeded++
+			continue
+		}
+		report.Failed++
+	}
+
+	return report, nil
+}
+
+// ScheduleRerunTask rerun the task at the eta, immediately when eta is nil, and return the uuid it is resent with,
+// see RerunTaskWithEdit
+func ScheduleRerunTask(d Dashboard, uuid string, eta *time.Time, freshUUID bool) (string, error) {
+	var edit *RerunEdit
+	if eta != nil {
+		edit = &RerunEdit{ETA: eta}
+	}
+	return RerunTaskWithEdit(d, uuid, edit, freshUUID)
+}
+
+// FindTaskUUIDsByFilter page through the tasks matching the filter and collect their uuids
+func FindTaskUUIDsByFilter(d Dashboard, filter *BulkRerunFilter) ([]string, error) {
+	taskFilter := filter.taskFilter()
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultBulkRerunLimit
+	}
+
+	var (
+		uuids  []string
+		cursor string
+	)
+	for {
+		taskStates, next, err := d.FindAllTasks(taskFilter, cursor, true, bulkRerunPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ts := range taskStates {
+			if !filter.Match(ts) {
+				continue
+			}
+
+			uuids = append(uuids, ts.TaskUUID)
+			if len(uuids) >= limit {
+				return uuids, nil
+			}
+		}
+
+		if next == "" {
+			return uuids, nil
+		}
+		cursor = next
+	}
+}
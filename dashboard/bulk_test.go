@@ -0,0 +1,116 @@
+package dashboard
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_BulkRerunTasks(t *testing.T) {
+	t.Run("by uuids", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{UUIDs: []string{"1", "2", "99"}, Concurrency: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Total)
+		assert.Equal(t, 2, report.Succeeded)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, "99", report.Results[2].UUID)
+		assert.False(t, report.Results[2].Success)
+		assert.NotEmpty(t, report.Results[2].Error)
+		assert.Equal(t, 2, len(dyn.server.(*machineryServerMock).sent))
+	})
+
+	t.Run("fresh uuid", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{UUIDs: []string{"1", "99"}, FreshUUID: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Succeeded)
+		assert.NotEmpty(t, report.Results[0].NewUUID)
+		assert.Empty(t, report.Results[1].NewUUID)
+
+		sent := dyn.server.(*machineryServerMock).sent
+		assert.Equal(t, 1, len(sent))
+		assert.Equal(t, report.Results[0].NewUUID, sent[0].UUID)
+	})
+
+	t.Run("by filter", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{
+			Filter: &BulkRerunFilter{
+				State:         tasks.StateFailure,
+				TaskName:      "DLQTaskCreateComment",
+				CreatedAfter:  time.Date(2020, 12, 10, 7, 2, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2020, 12, 10, 7, 4, 0, 0, time.UTC),
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Succeeded)
+
+		var uuids []string
+		for _, res := range report.Results {
+			uuids = append(uuids, res.UUID)
+		}
+		sort.Strings(uuids)
+		assert.Equal(t, []string{"2", "3", "4"}, uuids)
+	})
+
+	t.Run("filter limit", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{Filter: &BulkRerunFilter{Limit: 2}})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Total)
+	})
+
+	t.Run("scheduled", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+		eta := time.Date(2020, 12, 11, 7, 0, 0, 0, time.UTC)
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{UUIDs: []string{"1", "2"}, ETA: &eta})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Succeeded)
+		assert.Equal(t, &eta, report.ETA)
+
+		for _, sig := range dyn.server.(*machineryServerMock).sent {
+			assert.Equal(t, eta, *sig.ETA)
+		}
+	})
+
+	t.Run("handle Query error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.queryErr = errors.New("gotcha")
+
+		_, err := dyn.BulkRerunTasks(&BulkRerunRequest{Filter: &BulkRerunFilter{}})
+		assert.Error(t, err)
+	})
+
+	t.Run("handle SendTask error", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+		dyn.server = &machineryServerMock{err: errors.New("gotcha")}
+
+		report, err := dyn.BulkRerunTasks(&BulkRerunRequest{UUIDs: []string{"1", "2"}})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Failed)
+	})
+}
+
+func Test_BulkRerunFilter_Match(t *testing.T) {
+	task := &TaskWithSignature{TaskName: "Foo", CreatedAt: "2020-12-10T07:53:14.436882456Z", Error: "gotcha 42"}
+
+	assert.True(t, (&BulkRerunFilter{}).Match(task))
+	assert.True(t, (&BulkRerunFilter{TaskName: "Foo"}).Match(task))
+	assert.False(t, (&BulkRerunFilter{TaskName: "Bar"}).Match(task))
+	assert.True(t, (&BulkRerunFilter{CreatedAfter: time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC)}).Match(task))
+	assert.False(t, (&BulkRerunFilter{CreatedBefore: time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC)}).Match(task))
+	assert.False(t, (&BulkRerunFilter{CreatedAfter: time.Now()}).Match(&TaskWithSignature{}))
+	assert.True(t, (&BulkRerunFilter{ErrorContains: "otc"}).Match(task))
+	assert.True(t, (&BulkRerunFilter{ErrorSignature: "gotcha <n>"}).Match(task))
+	assert.False(t, (&BulkRerunFilter{ErrorSignature: "connection refused"}).Match(task))
+}
@@ -1,23 +1,106 @@
 package dashboard
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
 	"github.com/RichardKnop/machinery/v1/backends/result"
 	"github.com/RichardKnop/machinery/v1/tasks"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/go-redis/redis/v8"
 )
 
-type dynamodbClientMock struct{}
+// machineryServerMock record the sent signatures and fails when err is set
+type machineryServerMock struct {
+	mu   sync.Mutex
+	err  error
+	sent []*tasks.Signature
+}
+
+func (m *machineryServerMock) SendTask(signature *tasks.Signature) (*result.AsyncResult, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
 
-func (d *dynamodbClientMock) Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent = append(m.sent, signature)
 	return nil, nil
 }
 
-func (d *dynamodbClientMock) GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
-	return nil, nil
+// redisClientMock store string keys in memory, the SCAN cursor is the index of the sorted keys
+type redisClientMock struct {
+	items map[string]string
+	ttls  map[string]time.Duration
+	// scans count the Scan calls
+	scans int
 }
 
-type machineryServerMock struct{}
+func (r *redisClientMock) sortedKeys() []string {
+	keys := make([]string, 0, len(r.items))
+	for k := range r.items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
-func (m *machineryServerMock) SendTask(signature *tasks.Signature) (*result.AsyncResult, error) {
-	return nil, nil
+func (r *redisClientMock) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
+	r.scans++
+	keys := r.sortedKeys()
+	end := cursor + uint64(count)
+	if end >= uint64(len(keys)) {
+		return redis.NewScanCmdResult(keys[cursor:], 0, nil)
+	}
+
+	return redis.NewScanCmdResult(keys[cursor:end], end, nil)
+}
+
+func (r *redisClientMock) Get(ctx context.Context, key string) *redis.StringCmd {
+	val, ok := r.items[key]
+	if !ok {
+		return redis.NewStringResult("", redis.Nil)
+	}
+
+	return redis.NewStringResult(val, nil)
+}
+
+func (r *redisClientMock) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd {
+	switch v := value.(type) {
+	case []byte:
+		r.items[key] = string(v)
+	default:
+		r.items[key] = fmt.Sprint(v)
+	}
+
+	if r.ttls == nil {
+		r.ttls = map[string]time.Duration{}
+	}
+	r.ttls[key] = expiration
+	return redis.NewStatusResult("OK", nil)
+}
+
+// TTL return -2 for missing keys and -1 for keys without expiration like redis does
+func (r *redisClientMock) TTL(ctx context.Context, key string) *redis.DurationCmd {
+	if _, ok := r.items[key]; !ok {
+		return redis.NewDurationResult(-2, nil)
+	}
+
+	if ttl, ok := r.ttls[key]; ok && ttl > 0 {
+		return redis.NewDurationResult(ttl, nil)
+	}
+	return redis.NewDurationResult(-1, nil)
+}
+
+func (r *redisClientMock) MGet(ctx context.Context, keys ...string) *redis.SliceCmd {
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		if val, ok := r.items[key]; ok {
+			values[i] = val
+		}
+	}
+
+	return redis.NewSliceResult(values, nil)
 }
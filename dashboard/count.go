@@ -0,0 +1,201 @@
+package dashboard
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TaskCount number of tasks on a state, in total and per task name
+type TaskCount struct {
+	State      string           `json:"state"`
+	Total      int64            `json:"total"`
+	ByTaskName map[string]int64 `json:"by_task_name"`
+}
+
+// TaskNameCount :nodoc:
+type TaskNameCount struct {
+	TaskName string
+	Count    int64
+}
+
+func newTaskCount(state string) *TaskCount {
+	return &TaskCount{State: state, ByTaskName: map[string]int64{}}
+}
+
+func (c *TaskCount) add(taskName string, n int64) {
+	c.Total += n
+	c.ByTaskName[taskName] += n
+}
+
+// SortedByTaskName list the count per task name, the most tasks first
+func (c *TaskCount) SortedByTaskName() []*TaskNameCount {
+	res := make([]*TaskNameCount, 0, len(c.ByTaskName))
+	for name, n := range c.ByTaskName {
+		res = append(res, &TaskNameCount{TaskName: name, Count: n})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].TaskName < res[j].TaskName
+	})
+	return res
+}
+
+// newTaskCounts create empty counts of the states, indexed by state
+func newTaskCounts(states []string) ([]*TaskCount, map[string]*TaskCount) {
+	counts := make([]*TaskCount, 0, len(states))
+	byState := make(map[string]*TaskCount, len(states))
+	for _, state := range states {
+		count := newTaskCount(state)
+		counts = append(counts, count)
+		byState[state] = count
+	}
+	return counts, byState
+}
+
+// countCache cache the task counts of the wrapped Dashboard per state, since counting reads all the tasks.
+// The expired counts are still served while they are counted again in the background, so only the states
+// never counted are waited for. A state is only counted by one caller at a time
+type countCache struct {
+	Dashboard
+	ttl time.Duration
+	now func() time.Time
+
+	mu       sync.Mutex
+	entries  map[string]*countCacheEntry
+	inflight map[string]*countCall
+}
+
+type countCacheEntry struct {
+	count     *TaskCount
+	expiredAt time.Time
+}
+
+// countCall count the states not being counted by another call, done is closed once the entries are updated
+type countCall struct {
+	states []string
+	done   chan struct{}
+	err    error
+}
+
+// NewCountCache wrap the Dashboard caching CountTasks result per state for ttl,
+// the other methods are passed through
+func NewCountCache(d Dashboard, ttl time.Duration) Dashboard {
+	return &countCache{
+		Dashboard: d,
+		ttl:       ttl,
+		now:       time.Now,
+		entries:   map[string]*countCacheEntry{},
+		inflight:  map[string]*countCall{},
+	}
+}
+
+// CountTasks :nodoc:
+// the lock is never held while counting, so a slow count doesn't block the callers served from the cache
+func (c *countCache) CountTasks(states []string) ([]*TaskCount, error) {
+	c.mu.Lock()
+	now := c.now()
+	var missing, expired []string
+	for _, state := range states {
+		entry, ok := c.entries[state]
+		switch {
+		case !ok:
+			missing = append(missing, state)
+		case !now.Before(entry.expiredAt):
+			expired = append(expired, state)
+		}
+	}
+
+	if call, _ := c.startCount(expired); call != nil {
+		go func() {
+			if err := c.count(call); err != nil {
+				logrus.Error(err)
+			}
+		}()
+	}
+	call, waits := c.startCount(missing)
+	c.mu.Unlock()
+
+	if call != nil {
+		if err := c.count(call); err != nil {
+			return nil, err
+		}
+	}
+	for _, wait := range waits {
+		<-wait.done
+		if wait.err != nil {
+			return nil, wait.err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counts := make([]*TaskCount, 0, len(states))
+	for _, state := range states {
+		entry, ok := c.entries[state]
+		if !ok {
+			return nil, fmt.Errorf("task count of %s is not cached", state)
+		}
+		counts = append(counts, entry.count)
+	}
+	return counts, nil
+}
+
+// startCount register a call counting the states not counted yet, and return the calls already counting the others.
+// Requires c.mu held
+func (c *countCache) startCount(states []string) (call *countCall, waits []*countCall) {
+	var own []string
+	for _, state := range states {
+		if wait, ok := c.inflight[state]; ok {
+			waits = appendCountCall(waits, wait)
+			continue
+		}
+		own = append(own, state)
+	}
+	if len(own) == 0 {
+		return nil, waits
+	}
+
+	call = &countCall{states: own, done: make(chan struct{})}
+	for _, state := range own {
+		c.inflight[state] = call
+	}
+	return call, waits
+}
+
+// count run the call without holding the lock, the entries are kept when counting failed
+func (c *countCache) count(call *countCall) error {
+	counts, err := c.Dashboard.CountTasks(call.states)
+
+	c.mu.Lock()
+	if err == nil {
+		expiredAt := c.now().Add(c.ttl)
+		for _, count := range counts {
+			c.entries[count.State] = &countCacheEntry{count: count, expiredAt: expiredAt}
+		}
+	}
+	for _, state := range call.states {
+		delete(c.inflight, state)
+	}
+	c.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+	return err
+}
+
+func appendCountCall(calls []*countCall, call *countCall) []*countCall {
+	for _, c := range calls {
+		if c == call {
+			return calls
+		}
+	}
+	return append(calls, call)
+}
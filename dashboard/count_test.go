@@ -0,0 +1,222 @@
+package dashboard
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_DynamoDB_CountTasks(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.putTask(taskTable, &TaskWithSignature{TaskUUID: "7", State: tasks.StateFailure, TaskName: "DLQTaskDeleteComment"})
+
+		counts, err := dyn.CountTasks([]string{tasks.StateFailure, tasks.StateSuccess, tasks.StatePending})
+		require.NoError(t, err)
+		require.Len(t, counts, 3)
+
+		assert.Equal(t, &TaskCount{
+			State:      tasks.StateFailure,
+			Total:      6,
+			ByTaskName: map[string]int64{"DLQTaskCreateComment": 5, "DLQTaskDeleteComment": 1},
+		}, counts[0])
+		assert.Equal(t, int64(1), counts[1].Total)
+		assert.Equal(t, &TaskCount{State: tasks.StatePending, ByTaskName: map[string]int64{}}, counts[2])
+	})
+
+	t.Run("handle Query error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.queryErr = errors.New("gotcha")
+
+		_, err := dyn.CountTasks([]string{tasks.StateFailure})
+		assert.Error(t, err)
+	})
+}
+
+func Test_Redis_CountTasks(t *testing.T) {
+	r := newRedisMock()
+
+	counts, err := r.CountTasks([]string{tasks.StateFailure, tasks.StateSuccess})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"Foo": 1, "Bar": 2}, counts[0].ByTaskName)
+	assert.Equal(t, int64(3), counts[0].Total)
+	assert.Equal(t, int64(1), counts[1].Total)
+}
+
+func Test_buildMongoCountPipeline(t *testing.T) {
+	pipeline := buildMongoCountPipeline([]string{tasks.StateFailure})
+	assert.Equal(t, bson.M{"$match": bson.M{"state": bson.M{"$in": []string{tasks.StateFailure}}}}, pipeline[0])
+}
+
+func Test_TaskCount_SortedByTaskName(t *testing.T) {
+	count := newTaskCount(tasks.StateFailure)
+	count.add("Foo", 1)
+	count.add("Bar", 3)
+	count.add("Baz", 1)
+
+	assert.Equal(t, []*TaskNameCount{
+		{TaskName: "Bar", Count: 3},
+		{TaskName: "Baz", Count: 1},
+		{TaskName: "Foo", Count: 1},
+	}, count.SortedByTaskName())
+}
+
+// countDashboardStub count the CountTasks calls, the counts Total is the call number
+type countDashboardStub struct {
+	Dashboard
+	// wait block the counts until closed
+	wait chan struct{}
+
+	mu      sync.Mutex
+	calls   int
+	counted [][]string
+	err     error
+}
+
+func (d *countDashboardStub) CountTasks(states []string) ([]*TaskCount, error) {
+	if d.wait != nil {
+		<-d.wait
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.calls++
+	d.counted = append(d.counted, states)
+	if d.err != nil {
+		return nil, d.err
+	}
+	counts, _ := newTaskCounts(states)
+	for _, count := range counts {
+		count.Total = int64(d.calls)
+	}
+	return counts, nil
+}
+
+func (d *countDashboardStub) callCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.calls
+}
+
+func (d *countDashboardStub) setErr(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.err = err
+}
+
+func newCountCacheMock(stub *countDashboardStub, now *time.Time) *countCache {
+	cache := NewCountCache(stub, time.Minute).(*countCache)
+	cache.now = func() time.Time { return *now }
+	return cache
+}
+
+func Test_NewCountCache(t *testing.T) {
+	t.Run("cache per state", func(t *testing.T) {
+		stub := &countDashboardStub{}
+		now := time.Now()
+		cache := newCountCacheMock(stub, &now)
+
+		counts, err := cache.CountTasks([]string{tasks.StateFailure, tasks.StateSuccess})
+		require.NoError(t, err)
+		require.Len(t, counts, 2)
+
+		counts, err = cache.CountTasks([]string{tasks.StateFailure})
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, tasks.StateFailure, counts[0].State)
+		assert.Equal(t, 1, stub.callCount(), "the entries are shared by the callers")
+
+		_, err = cache.CountTasks([]string{tasks.StateFailure, tasks.StatePending})
+		require.NoError(t, err)
+		assert.Equal(t, 2, stub.callCount())
+		assert.Equal(t, []string{tasks.StatePending}, stub.counted[1], "only the missing state is counted")
+	})
+
+	t.Run("serve the expired counts while counting in the background", func(t *testing.T) {
+		stub := &countDashboardStub{}
+		now := time.Now()
+		cache := newCountCacheMock(stub, &now)
+
+		states := []string{tasks.StateFailure}
+		_, err := cache.CountTasks(states)
+		require.NoError(t, err)
+
+		stub.wait = make(chan struct{})
+		now = now.Add(time.Minute)
+		for i := 0; i < 3; i++ {
+			counts, err := cache.CountTasks(states)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, counts[0].Total, "the expired count is served without waiting")
+		}
+
+		close(stub.wait)
+		assert.Eventually(t, func() bool {
+			counts, err := cache.CountTasks(states)
+			return err == nil && counts[0].Total == 2
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, 2, stub.callCount(), "the state is counted once at a time")
+	})
+
+	t.Run("wait for the states being counted", func(t *testing.T) {
+		stub := &countDashboardStub{wait: make(chan struct{})}
+		now := time.Now()
+		cache := newCountCacheMock(stub, &now)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				counts, err := cache.CountTasks([]string{tasks.StateFailure})
+				assert.NoError(t, err)
+				assert.Len(t, counts, 1)
+			}()
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		close(stub.wait)
+		wg.Wait()
+		assert.Equal(t, 1, stub.callCount())
+	})
+
+	t.Run("error is not cached", func(t *testing.T) {
+		stub := &countDashboardStub{err: errors.New("gotcha")}
+		now := time.Now()
+		cache := newCountCacheMock(stub, &now)
+
+		_, err := cache.CountTasks([]string{tasks.StateFailure})
+		assert.Error(t, err)
+		_, err = cache.CountTasks([]string{tasks.StateFailure})
+		assert.Error(t, err)
+		assert.Equal(t, 2, stub.callCount())
+	})
+
+	t.Run("keep the expired counts when counting failed", func(t *testing.T) {
+		stub := &countDashboardStub{}
+		now := time.Now()
+		cache := newCountCacheMock(stub, &now)
+
+		states := []string{tasks.StateFailure}
+		_, err := cache.CountTasks(states)
+		require.NoError(t, err)
+
+		stub.setErr(errors.New("gotcha"))
+		now = now.Add(time.Minute)
+		counts, err := cache.CountTasks(states)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, counts[0].Total)
+
+		assert.Eventually(t, func() bool {
+			return stub.callCount() == 2
+		}, time.Second, 10*time.Millisecond)
+		counts, err = cache.CountTasks(states)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, counts[0].Total)
+	})
+}
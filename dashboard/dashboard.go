@@ -1,16 +1,89 @@
 package dashboard
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/RichardKnop/machinery/v1/backends/result"
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ErrNotFound returned when the task doesn't exist on the result backend
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidCursor returned when the pagination cursor can't be decoded
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidEdit returned when the rerun edit doesn't fit the original signature
+	ErrInvalidEdit = errors.New("invalid edit")
+	// ErrRerunUnsupported returned when the result backend doesn't store the task signatures, e.g. MongoDB
+	ErrRerunUnsupported = errors.New("rerun is not supported by the result backend")
+	// ErrNoSignature returned when the task signature isn't stored
+	ErrNoSignature = errors.New("signature is not stored")
+	// ErrSortUnsupported returned when the tasks are requested newest first, but the result backend doesn't keep them sorted by created at
+	ErrSortUnsupported = errors.New("sort order is not supported")
 )
 
+// HeaderRerunOf is the signature header linking a task rerun with a new UUID back to the original task
+const HeaderRerunOf = "machinerydash_rerun_of"
+
 // Dashboard :noodc:
 type Dashboard interface {
 	FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error)
+	FindAllTasks(filter *TaskFilter, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error)
 	RerunTask(uuid string) error
+	EditAndRerunTask(uuid string, edit *RerunEdit) error
 	FindTaskByUUID(uuid string) (*TaskWithSignature, error)
+	BulkRerunTasks(req *BulkRerunRequest) (*BulkRerunReport, error)
+	FindGroupByUUID(groupUUID string) (*Group, error)
+	RerunGroup(groupUUID string, all bool) (*GroupRerunReport, error)
+	CountTasks(states []string) ([]*TaskCount, error)
+}
+
+// TaskWithSignature :nodoc:
+type TaskWithSignature struct {
+	TaskUUID  string `bson:"task_uuid"`
+	State     string `bson:"state"`
+	TaskName  string `bson:"task_name"`
+	Signature string `bson:"signature"`
+	CreatedAt string `bson:"created_at"`
+	Error     string `bson:"error"`
+	// Results is only loaded when finding a single task
+	Results []*tasks.TaskResult `bson:"results"`
+}
+
+// UnmarshalSignature :nodoc:
+func (t *TaskWithSignature) UnmarshalSignature(v interface{}) error {
+	if t.Signature == "" {
+		return fmt.Errorf("task %s %w", t.TaskUUID, ErrNoSignature)
+	}
+
+	reader := strings.NewReader(t.Signature)
+	dec := json.NewDecoder(reader)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+// ScheduledAt return the signature ETA when the task is scheduled to run in the future
+func (t *TaskWithSignature) ScheduledAt() *time.Time {
+	sig := struct {
+		ETA *time.Time
+	}{}
+	if err := json.Unmarshal([]byte(t.Signature), &sig); err != nil || sig.ETA == nil {
+		return nil
+	}
+
+	if !sig.ETA.After(time.Now()) {
+		return nil
+	}
+	return sig.ETA
 }
 
 type machineryServer interface {
@@ -20,4 +93,141 @@ type machineryServer interface {
 type dynamoDBClient interface {
 	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
 	GetItem(*dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	UpdateItem(*dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error)
+	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+}
+
+type redisClient interface {
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
+	Get(ctx context.Context, key string) *redis.StringCmd
+	MGet(ctx context.Context, keys ...string) *redis.SliceCmd
+	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	TTL(ctx context.Context, key string) *redis.DurationCmd
+}
+
+type mongoCollection interface {
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (mongoResultCursor, error)
+	FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) mongoSingleResult
+	Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (mongoResultCursor, error)
+}
+
+type mongoResultCursor interface {
+	All(ctx context.Context, results interface{}) error
+	Close(ctx context.Context) error
+}
+
+type mongoSingleResult interface {
+	Decode(v interface{}) error
+}
+
+// newTaskWithSignature convert machinery task state into TaskWithSignature,
+// the signature is stored as json string just like the dynamodb backend does
+func newTaskWithSignature(state *tasks.TaskState) (*TaskWithSignature, error) {
+	task := &TaskWithSignature{
+		TaskUUID: state.TaskUUID,
+		State:    state.State,
+		TaskName: state.TaskName,
+		Error:    state.Error,
+		Results:  state.Results,
+	}
+
+	if state.Signature != nil {
+		bt, err := json.Marshal(state.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal signature: %w", err)
+		}
+		task.Signature = string(bt)
+
+		if task.TaskName == "" {
+			task.TaskName = state.Signature.Name
+		}
+	}
+
+	if !state.CreatedAt.IsZero() {
+		task.CreatedAt = state.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return task, nil
+}
+
+// RerunOf return the original task uuid when the task is a rerun with a new UUID, see HeaderRerunOf
+func (t *TaskWithSignature) RerunOf() string {
+	sig := &tasks.Signature{}
+	if err := t.UnmarshalSignature(sig); err != nil {
+		return ""
+	}
+
+	original, _ := sig.Headers[HeaderRerunOf].(string)
+	return original
+}
+
+// NewTaskUUID generate a task uuid the way machinery does
+func NewTaskUUID() string {
+	return "task_" + uuid.New().String()
+}
+
+// rerunTask find the task then resend its signature to the broker
+func rerunTask(d Dashboard, srv machineryServer, uuid string) error {
+	return editAndRerunTask(d, srv, uuid, nil)
+}
+
+// editAndRerunTask find the task then resend its signature with the edit applied,
+// the task found by RerunTaskWithEdit is reused
+func editAndRerunTask(d Dashboard, srv machineryServer, uuid string, edit *RerunEdit) error {
+	var task *TaskWithSignature
+	if edit != nil && edit.task != nil && edit.task.TaskUUID == uuid {
+		task = edit.task
+	} else {
+		var err error
+		task, err = d.FindTaskByUUID(uuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	sig, err := rerunSignatureOf(task)
+	if err != nil {
+		return err
+	}
+
+	sig.ETA = nil // reset ETA
+	if edit != nil {
+		if err = edit.apply(sig); err != nil {
+			return err
+		}
+	}
+
+	_, err = srv.SendTask(sig)
+	if err != nil {
+		err = fmt.Errorf("failed to send task: %w", err)
+		return err
+	}
+	return err
+}
+
+// rerunSignatureOf unmarshal the signature to resend, a task without signature can't be rerun
+func rerunSignatureOf(task *TaskWithSignature) (*tasks.Signature, error) {
+	sig := &tasks.Signature{}
+	err := task.UnmarshalSignature(sig)
+	if errors.Is(err, ErrNoSignature) {
+		return nil, fmt.Errorf("%w: %s", ErrRerunUnsupported, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	return sig, nil
+}
+
+// actorDashboard is implemented by the decorators recording who reruns the tasks, see audit.NewDashboard
+type actorDashboard interface {
+	WithActor(actor string) Dashboard
+}
+
+// WithActor return the Dashboard attributing the reruns to the actor,
+// the Dashboard is returned as is when it doesn't record the actor
+func WithActor(d Dashboard, actor string) Dashboard {
+	if ad, ok := d.(actorDashboard); ok {
+		return ad.WithActor(actor)
+	}
+	return d
 }
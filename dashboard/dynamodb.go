@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/RichardKnop/machinery/v1/config"
 	"github.com/RichardKnop/machinery/v1/log"
@@ -20,24 +22,12 @@ type DynamoDB struct {
 	cnf    *config.Config
 	client dynamoDBClient
 	server machineryServer
-}
-
-// TaskWithSignature :nodoc:
-type TaskWithSignature struct {
-	TaskUUID  string `bson:"task_uuid"`
-	State     string `bson:"state"`
-	TaskName  string `bson:"task_name"`
-	Signature string `bson:"signature"`
-	CreatedAt string `bson:"created_at"`
-	Error     string `bson:"error"`
-}
 
-// UnmarshalSignature :nodoc:
-func (t *TaskWithSignature) UnmarshalSignature(v interface{}) error {
-	reader := strings.NewReader(t.Signature)
-	dec := json.NewDecoder(reader)
-	dec.UseNumber()
-	return dec.Decode(v)
+	mu sync.Mutex
+	// stateIndexSorted is nil until StateIndex is described
+	stateIndexSorted *bool
+	// describeRetryAt delay describing StateIndex again after it failed
+	describeRetryAt time.Time
 }
 
 // NewDynamodb :nodoc:
@@ -59,17 +49,44 @@ func NewDynamodb(cnf *config.Config, srv machineryServer) Dashboard {
 	return dash
 }
 
+// maxFilteredQueries bound the queries made to fill a single filtered page,
+// the page may contain less than size tasks when the matching tasks are sparse
+const maxFilteredQueries = 10
+
+// dynamoDBCreatedAtLayout is the second precision prefix of CreatedAt, stored as RFC3339Nano in UTC
+const dynamoDBCreatedAtLayout = "2006-01-02T15:04:05"
+
+// describeRetryInterval how long StateIndex is treated as unsorted after describing it failed
+const describeRetryInterval = time.Minute
+
 // FindAllTasksByState :nodoc:
-// cursor e.g. "prev" & "next" are base64 encoded LastEvaluatedKey
 func (m *DynamoDB) FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
+	return m.FindAllTasks(&TaskFilter{State: state}, cursor, asc, size)
+}
+
+// FindAllTasks :nodoc:
+// cursor e.g. "prev" & "next" are base64 encoded LastEvaluatedKey.
+// StateIndex is only sorted by created at when it has the CreatedAt range key, otherwise the tasks can't be listed newest first.
+// The created at range is part of the KeyConditionExpression on the sorted index, so only the tasks in range are read.
+// The task name & error, and the created at range on the unsorted index, are matched using FilterExpression.
+// It is applied after the Limit, so the index is queried again with the remaining size until the page is filled.
+// The table is only described when the order or the created at range needs the sorted index.
+func (m *DynamoDB) FindAllTasks(filter *TaskFilter, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
 	if size <= 0 {
 		size = 10
 	}
 
+	sorted := false
+	if !asc || !filter.CreatedAfter.IsZero() || !filter.CreatedBefore.IsZero() {
+		sorted = m.isStateIndexSorted()
+	}
+	if !asc && !sorted {
+		return nil, next, ErrSortUnsupported
+	}
+
 	queryInput := &dynamodb.QueryInput{
 		TableName:              aws.String(m.cnf.DynamoDB.TaskStatesTable),
 		IndexName:              aws.String(tasks.TaskStateIndex), // use secondary global index
-		Limit:                  aws.Int64(size),
 		ProjectionExpression:   aws.String("TaskUUID, #st, TaskName, #err, Signature, CreatedAt"),
 		KeyConditionExpression: aws.String("#st = :st"),
 		ScanIndexForward:       aws.Bool(asc),
@@ -79,53 +96,121 @@ func (m *DynamoDB) FindAllTasksByState(state, cursor string, asc bool, size int6
 		},
 		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
 			":st": {
-				S: aws.String(state),
+				S: aws.String(filter.State),
 			},
 		},
 	}
+	queryInput.FilterExpression = buildDynamoDBFilterExpression(filter, queryInput.ExpressionAttributeValues, sorted)
+	if sorted {
+		if cond := buildDynamoDBCreatedAtCondition(filter, queryInput.ExpressionAttributeValues); cond != "" {
+			queryInput.KeyConditionExpression = aws.String("#st = :st AND " + cond)
+		}
+	}
 
-	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
 	if cursor != "" {
-		lastEvaluatedKey, err = decodeB64LastEvaluatedKey(cursor)
+		queryInput.ExclusiveStartKey, err = decodeB64LastEvaluatedKey(cursor)
 		if err != nil {
 			log.ERROR.Println(err)
-			return nil, next, err
+			return nil, next, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
 		}
 	}
 
-	queryInput.ExclusiveStartKey = lastEvaluatedKey
-	out, err := m.client.Query(queryInput)
-	if err != nil {
-		log.ERROR.Print(err)
-		return nil, next, err
-	}
+	var lastEvaluatedKey map[string]*dynamodb.AttributeValue
+	for i := 0; i < maxFilteredQueries; i++ {
+		// the evaluated items never exceed the remaining size, so LastEvaluatedKey is a valid cursor
+		queryInput.Limit = aws.Int64(size - int64(len(taskStates)))
+		out, err := m.client.Query(queryInput)
+		if err != nil {
+			log.ERROR.Print(err)
+			return nil, next, err
+		}
+
+		if out == nil {
+			break
+		}
+
+		var page []*TaskWithSignature
+		err = dynamodbattribute.UnmarshalListOfMaps(out.Items, &page)
+		if err != nil {
+			log.ERROR.Print(err)
+			return nil, next, err
+		}
+
+		// the created at range is only pushed down with second precision
+		for _, task := range page {
+			if filter.MatchCreatedAt(task.CreatedAt) {
+				taskStates = append(taskStates, task)
+			}
+		}
 
-	if out == nil {
-		return nil, "", nil
+		lastEvaluatedKey = out.LastEvaluatedKey
+		if queryInput.FilterExpression == nil || lastEvaluatedKey == nil || int64(len(taskStates)) >= size {
+			break
+		}
+		queryInput.ExclusiveStartKey = lastEvaluatedKey
 	}
 
-	if out.LastEvaluatedKey != nil {
-		next, err = encodeB64LastEvaluatedKey(out.LastEvaluatedKey)
+	if lastEvaluatedKey != nil {
+		next, err = encodeB64LastEvaluatedKey(lastEvaluatedKey)
 		if err != nil {
 			log.ERROR.Println(err)
 			return nil, next, err
 		}
 	}
 
-	err = dynamodbattribute.UnmarshalListOfMaps(out.Items, &taskStates)
-	if err != nil {
-		log.ERROR.Print(err)
-		return nil, next, err
+	return
+}
+
+// CountTasks :nodoc:
+// StateIndex is queried projecting only the TaskName, it reads every task on the states
+// so the counts should be cached, see NewCountCache
+func (m *DynamoDB) CountTasks(states []string) ([]*TaskCount, error) {
+	counts, _ := newTaskCounts(states)
+	for _, count := range counts {
+		queryInput := &dynamodb.QueryInput{
+			TableName:              aws.String(m.cnf.DynamoDB.TaskStatesTable),
+			IndexName:              aws.String(tasks.TaskStateIndex),
+			ProjectionExpression:   aws.String("TaskName"),
+			KeyConditionExpression: aws.String("#st = :st"),
+			ExpressionAttributeNames: map[string]*string{
+				"#st": aws.String("State"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":st": {
+					S: aws.String(count.State),
+				},
+			},
+		}
+
+		for {
+			out, err := m.client.Query(queryInput)
+			if err != nil {
+				return nil, fmt.Errorf("failed to count %s tasks: %w", count.State, err)
+			}
+
+			for _, item := range out.Items {
+				var taskName string
+				if attr, ok := item["TaskName"]; ok {
+					taskName = aws.StringValue(attr.S)
+				}
+				count.add(taskName, 1)
+			}
+
+			if out.LastEvaluatedKey == nil {
+				break
+			}
+			queryInput.ExclusiveStartKey = out.LastEvaluatedKey
+		}
 	}
 
-	return
+	return counts, nil
 }
 
 // FindTaskByUUID :nodoc:
 func (m *DynamoDB) FindTaskByUUID(uuid string) (*TaskWithSignature, error) {
 	res, err := m.client.GetItem(&dynamodb.GetItemInput{
 		TableName:            aws.String(m.cnf.DynamoDB.TaskStatesTable),
-		ProjectionExpression: aws.String("TaskUUID, #st, TaskName, #err, Signature, CreatedAt"),
+		ProjectionExpression: aws.String("TaskUUID, #st, TaskName, #err, Signature, CreatedAt, Results"),
 		ExpressionAttributeNames: map[string]*string{
 			"#st":  aws.String("State"),
 			"#err": aws.String("Error"),
@@ -141,6 +226,10 @@ func (m *DynamoDB) FindTaskByUUID(uuid string) (*TaskWithSignature, error) {
 		return nil, err
 	}
 
+	if len(res.Item) == 0 {
+		return nil, fmt.Errorf("task %s %w", uuid, ErrNotFound)
+	}
+
 	task := &TaskWithSignature{}
 	err = dynamodbattribute.UnmarshalMap(res.Item, task)
 	if err != nil {
@@ -151,27 +240,82 @@ func (m *DynamoDB) FindTaskByUUID(uuid string) (*TaskWithSignature, error) {
 	return task, nil
 }
 
-// RerunTask :nodo:
-func (m *DynamoDB) RerunTask(uuid string) error {
-	task, err := m.FindTaskByUUID(uuid)
+// FindGroupByUUID :nodoc:
+func (m *DynamoDB) FindGroupByUUID(groupUUID string) (*Group, error) {
+	res, err := m.client.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String(m.cnf.DynamoDB.GroupMetasTable),
+		Key: map[string]*dynamodb.AttributeValue{
+			"GroupUUID": {
+				S: aws.String(groupUUID),
+			},
+		},
+	})
 	if err != nil {
-		return err
+		err = fmt.Errorf("failed to get group %s: %w", groupUUID, err)
+		return nil, err
+	}
+
+	if len(res.Item) == 0 {
+		return nil, fmt.Errorf("group %s %w", groupUUID, ErrNotFound)
 	}
 
-	sig := &tasks.Signature{}
-	err = task.UnmarshalSignature(sig)
+	meta := &tasks.GroupMeta{}
+	err = dynamodbattribute.UnmarshalMap(res.Item, meta)
 	if err != nil {
 		err = fmt.Errorf("failed to unmarshal: %w", err)
-		return err
+		return nil, err
 	}
 
-	sig.ETA = nil // reset ETA
-	_, err = m.server.SendTask(sig)
+	return newGroup(m, meta), nil
+}
+
+// RerunGroup :nodoc:
+func (m *DynamoDB) RerunGroup(groupUUID string, all bool) (*GroupRerunReport, error) {
+	return rerunGroup(m, m.server, m, groupUUID, all)
+}
+
+// setChordTriggered :nodoc:
+func (m *DynamoDB) setChordTriggered(groupUUID string, triggered bool) error {
+	_, err := m.client.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName: aws.String(m.cnf.DynamoDB.GroupMetasTable),
+		Key: map[string]*dynamodb.AttributeValue{
+			"GroupUUID": {
+				S: aws.String(groupUUID),
+			},
+		},
+		UpdateExpression: aws.String("SET #CT = :ct, #L = :l"),
+		ExpressionAttributeNames: map[string]*string{
+			"#CT": aws.String("ChordTriggered"),
+			"#L":  aws.String("Lock"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":ct": {
+				BOOL: aws.Bool(triggered),
+			},
+			":l": {
+				BOOL: aws.Bool(false),
+			},
+		},
+	})
 	if err != nil {
-		err = fmt.Errorf("failed to send task: %w", err)
-		return err
+		return fmt.Errorf("failed to update group %s: %w", groupUUID, err)
 	}
-	return err
+	return nil
+}
+
+// RerunTask :nodo:
+func (m *DynamoDB) RerunTask(uuid string) error {
+	return rerunTask(m, m.server, uuid)
+}
+
+// EditAndRerunTask :nodoc:
+func (m *DynamoDB) EditAndRerunTask(uuid string, edit *RerunEdit) error {
+	return editAndRerunTask(m, m.server, uuid, edit)
+}
+
+// BulkRerunTasks :nodoc:
+func (m *DynamoDB) BulkRerunTasks(req *BulkRerunRequest) (*BulkRerunReport, error) {
+	return bulkRerunTasks(m, req)
 }
 
 func decodeB64LastEvaluatedKey(cursor string) (key map[string]*dynamodb.AttributeValue, err error) {
@@ -197,3 +341,107 @@ func encodeB64LastEvaluatedKey(key map[string]*dynamodb.AttributeValue) (decoded
 	decoded = base64.StdEncoding.EncodeToString(bt)
 	return
 }
+
+// isStateIndexSorted tell whether StateIndex has the CreatedAt range key, the table is described once and the result is kept.
+// The index is treated as unsorted when describing fails, e.g. without the dynamodb:DescribeTable permission,
+// and it is described again after describeRetryInterval
+func (m *DynamoDB) isStateIndexSorted() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.stateIndexSorted != nil {
+		return *m.stateIndexSorted
+	}
+
+	if time.Now().Before(m.describeRetryAt) {
+		return false
+	}
+
+	out, err := m.client.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(m.cnf.DynamoDB.TaskStatesTable),
+	})
+	if err != nil {
+		log.ERROR.Printf("failed to describe table %s, treating %s as unsorted: %s", m.cnf.DynamoDB.TaskStatesTable, tasks.TaskStateIndex, err)
+		m.describeRetryAt = time.Now().Add(describeRetryInterval)
+		return false
+	}
+
+	sorted := false
+	for _, index := range out.Table.GlobalSecondaryIndexes {
+		if aws.StringValue(index.IndexName) != tasks.TaskStateIndex {
+			continue
+		}
+		for _, key := range index.KeySchema {
+			if aws.StringValue(key.KeyType) == dynamodb.KeyTypeRange && aws.StringValue(key.AttributeName) == "CreatedAt" {
+				sorted = true
+			}
+		}
+	}
+
+	m.stateIndexSorted = &sorted
+	return sorted
+}
+
+// buildDynamoDBFilterExpression build the FilterExpression of the task name & error, the values are added into values
+// and #err must be the Error attribute name. The created at range is only matched here when the index isn't sorted,
+// otherwise it is part of the KeyConditionExpression, see buildDynamoDBCreatedAtCondition
+func buildDynamoDBFilterExpression(filter *TaskFilter, values map[string]*dynamodb.AttributeValue, sorted bool) *string {
+	var conds []string
+	if filter.TaskName != "" {
+		cond := "TaskName = :tn"
+		if filter.TaskNamePrefix {
+			cond = "begins_with(TaskName, :tn)"
+		}
+		conds = append(conds, cond)
+		values[":tn"] = &dynamodb.AttributeValue{
+			S: aws.String(filter.TaskName),
+		}
+	}
+
+	if filter.ErrorContains != "" {
+		conds = append(conds, "contains(#err, :ec)")
+		values[":ec"] = &dynamodb.AttributeValue{
+			S: aws.String(filter.ErrorContains),
+		}
+	}
+
+	if !sorted {
+		if cond := buildDynamoDBCreatedAtCondition(filter, values); cond != "" {
+			conds = append(conds, cond)
+		}
+	}
+
+	if len(conds) == 0 {
+		return nil
+	}
+	return aws.String(strings.Join(conds, " AND "))
+}
+
+// buildDynamoDBCreatedAtCondition build the condition of the created at range, valid both as FilterExpression
+// and as the range key condition, which allows a single comparison. The bounds are widened to whole seconds,
+// since CreatedAt is compared as string and its fraction length varies. The upper bound is a second precision prefix,
+// so BETWEEN excludes the tasks created on that second just like "<" does.
+func buildDynamoDBCreatedAtCondition(filter *TaskFilter, values map[string]*dynamodb.AttributeValue) string {
+	if !filter.CreatedAfter.IsZero() {
+		values[":ca"] = &dynamodb.AttributeValue{
+			S: aws.String(filter.CreatedAfter.UTC().Format(dynamoDBCreatedAtLayout)),
+		}
+	}
+
+	if !filter.CreatedBefore.IsZero() {
+		values[":cb"] = &dynamodb.AttributeValue{
+			S: aws.String(filter.CreatedBefore.UTC().Truncate(time.Second).Add(time.Second).Format(dynamoDBCreatedAtLayout)),
+		}
+	}
+
+	switch {
+	case !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero():
+		return "CreatedAt BETWEEN :ca AND :cb"
+	case !filter.CreatedAfter.IsZero():
+		return "CreatedAt >= :ca"
+	case !filter.CreatedBefore.IsZero():
+		return "CreatedAt < :cb"
+	default:
+		return ""
+	}
+}
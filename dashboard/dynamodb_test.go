@@ -2,11 +2,10 @@ package dashboard
 
 import (
 	"errors"
-	"reflect"
+	"fmt"
 	"testing"
+	"time"
 
-	"bou.ke/monkey"
-	"github.com/RichardKnop/machinery/v1/backends/result"
 	"github.com/RichardKnop/machinery/v1/config"
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,145 +28,338 @@ var jsonSignature = `{
 	"RetryTimeout": 8
 }`
 
-func Test_FindAllTasksByState(t *testing.T) {
-	t.Run("not found", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
+const (
+	taskTable  = "task_table"
+	groupTable = "group_table"
+)
+
+// newDynamoDBMock create DynamoDB dashboard backed by fakeDynamoDB,
+// seeded with 5 FAILURE tasks (1-5) and 1 SUCCESS task (6) created one minute apart
+func newDynamoDBMock() (*DynamoDB, *fakeDynamoDB) {
+	client := newFakeDynamoDB()
+	for i := 1; i <= 6; i++ {
+		state := tasks.StateFailure
+		if i == 6 {
+			state = tasks.StateSuccess
 		}
 
-		monkey.PatchInstanceMethod(reflect.TypeOf(dynamodbClient), "Query", func(*dynamodbClientMock, *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
-			return nil, nil
+		client.putTask(taskTable, &TaskWithSignature{
+			TaskUUID:  fmt.Sprint(i),
+			State:     state,
+			TaskName:  "DLQTaskCreateComment",
+			Signature: jsonSignature,
+			CreatedAt: fmt.Sprintf("2020-12-10T07:%02d:00Z", i),
+			Error:     "gotcha",
 		})
+	}
+
+	dyn := &DynamoDB{
+		cnf: &config.Config{
+			DynamoDB: &config.DynamoDBConfig{TaskStatesTable: taskTable, GroupMetasTable: groupTable},
+		},
+		client: client,
+		server: &machineryServerMock{},
+	}
+	return dyn, client
+}
 
-		res, cursor, err := dyn.FindAllTasksByState("", "", true, 10)
+func taskUUIDs(taskStates []*TaskWithSignature) (uuids []string) {
+	for _, ts := range taskStates {
+		uuids = append(uuids, ts.TaskUUID)
+	}
+	return
+}
+
+func Test_FindAllTasksByState(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		res, cursor, err := dyn.FindAllTasksByState(tasks.StatePending, "", true, 10)
 		assert.NoError(t, err)
-		assert.Nil(t, res)
+		assert.Empty(t, res)
 		assert.Empty(t, cursor)
 	})
 
 	t.Run("ok", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
-		}
+		dyn, _ := newDynamoDBMock()
 
-		lasEvaluatedKey := map[string]*dynamodb.AttributeValue{
-			"State":    {S: aws.String("FAILURE")},
-			"TaskUUID": {S: aws.String("3")},
-		}
+		res, cursor, err := dyn.FindAllTasksByState(tasks.StateFailure, "", true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, taskUUIDs(res))
+		assert.NotEmpty(t, cursor)
 
-		queryResult := &dynamodb.QueryOutput{
-			LastEvaluatedKey: lasEvaluatedKey,
-			Items: []map[string]*dynamodb.AttributeValue{
-				{
-					"State":    {S: aws.String("FAILURE")},
-					"TaskUUID": {S: aws.String("1")},
-				},
-				{
-					"State":    {S: aws.String("FAILURE")},
-					"TaskUUID": {S: aws.String("3")},
-				},
-			},
-		}
-		monkey.PatchInstanceMethod(reflect.TypeOf(dynamodbClient), "Query", func(*dynamodbClientMock, *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
-			return queryResult, nil
+		res, cursor, err = dyn.FindAllTasksByState(tasks.StateFailure, cursor, true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"3", "4"}, taskUUIDs(res))
+		assert.NotEmpty(t, cursor)
+
+		res, cursor, err = dyn.FindAllTasksByState(tasks.StateFailure, cursor, true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"5"}, taskUUIDs(res))
+		assert.Empty(t, cursor)
+
+		assert.Equal(t, "gotcha", res[0].Error)
+		assert.Equal(t, "DLQTaskCreateComment", res[0].TaskName)
+		assert.Equal(t, "2020-12-10T07:05:00Z", res[0].CreatedAt)
+		assert.Equal(t, jsonSignature, res[0].Signature)
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.sortedStateIndex = true
+
+		res, cursor, err := dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"5", "4", "3"}, taskUUIDs(res))
+
+		res, _, err = dyn.FindAllTasksByState(tasks.StateFailure, cursor, false, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2", "1"}, taskUUIDs(res))
+	})
+
+	t.Run("descending requires the sorted index", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, _, err := dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.True(t, errors.Is(err, ErrSortUnsupported))
+	})
+
+	t.Run("only describe the table when the sorted index is needed", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.sortedStateIndex = true
+
+		_, _, err := dyn.FindAllTasksByState(tasks.StateFailure, "", true, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, client.describeCalls)
+
+		_, _, err = dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.NoError(t, err)
+		_, _, err = dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, client.describeCalls, "the result is kept")
+	})
+
+	t.Run("treat the index as unsorted when DescribeTable fails", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.sortedStateIndex = true
+		client.describeTableErr = errors.New("gotcha")
+
+		_, _, err := dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.True(t, errors.Is(err, ErrSortUnsupported))
+
+		// the created at range is matched by FilterExpression instead
+		filter := &TaskFilter{State: tasks.StateFailure, CreatedAfter: time.Date(2020, 12, 10, 7, 4, 0, 0, time.UTC)}
+		res, _, err := dyn.FindAllTasks(filter, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"4", "5"}, taskUUIDs(res))
+		assert.Equal(t, "CreatedAt >= :ca", aws.StringValue(client.lastQuery.FilterExpression))
+		assert.Equal(t, 1, client.describeCalls, "described again after describeRetryInterval")
+
+		client.describeTableErr = nil
+		dyn.describeRetryAt = time.Time{}
+		_, _, err = dyn.FindAllTasksByState(tasks.StateFailure, "", false, 3)
+		assert.NoError(t, err)
+	})
+
+	t.Run("cursor is the LastEvaluatedKey", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, cursor, err := dyn.FindAllTasksByState(tasks.StateFailure, "", true, 1)
+		assert.NoError(t, err)
+
+		expectedCursor, err := encodeB64LastEvaluatedKey(map[string]*dynamodb.AttributeValue{
+			"State":    {S: aws.String(tasks.StateFailure)},
+			"TaskUUID": {S: aws.String("1")},
 		})
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCursor, cursor)
+	})
+
+	t.Run("cursor has CreatedAt on the sorted index", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.sortedStateIndex = true
 
-		expectedCursor, err := encodeB64LastEvaluatedKey(lasEvaluatedKey)
+		_, cursor, err := dyn.FindAllTasks(&TaskFilter{State: tasks.StateFailure, TaskName: "DLQTaskCreateComment"}, "", true, 1)
 		assert.NoError(t, err)
 
-		res, cursor, err := dyn.FindAllTasksByState(tasks.StateFailure, "", true, 1)
+		expectedCursor, err := encodeB64LastEvaluatedKey(map[string]*dynamodb.AttributeValue{
+			"State":     {S: aws.String(tasks.StateFailure)},
+			"TaskUUID":  {S: aws.String("1")},
+			"CreatedAt": {S: aws.String("2020-12-10T07:01:00Z")},
+		})
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(res))
 		assert.Equal(t, expectedCursor, cursor)
 	})
+
+	t.Run("handle invalid cursor", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, _, err := dyn.FindAllTasksByState(tasks.StateFailure, "invalid", true, 1)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+
+	t.Run("handle Query error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.queryErr = errors.New("gotcha")
+
+		_, _, err := dyn.FindAllTasksByState(tasks.StateFailure, "", true, 1)
+		assert.Error(t, err)
+	})
 }
 
-func Test_Rerun(t *testing.T) {
-	t.Run("ok", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
+func Test_FindAllTasks(t *testing.T) {
+	// seed tasks 7-9 after the 5 FAILURE tasks so the first queries don't match anything
+	newFilteredDynamoDBMock := func() *DynamoDB {
+		dyn, client := newDynamoDBMock()
+		for i := 7; i <= 9; i++ {
+			client.putTask(taskTable, &TaskWithSignature{
+				TaskUUID:  fmt.Sprint(i),
+				State:     tasks.StateFailure,
+				TaskName:  "DLQTaskDeleteComment",
+				Signature: jsonSignature,
+				CreatedAt: fmt.Sprintf("2020-12-10T07:%02d:00Z", i),
+			})
 		}
+		return dyn
+	}
 
-		pg := monkey.PatchInstanceMethod(reflect.TypeOf(dyn), "FindTaskByUUID", func(*DynamoDB, string) (*TaskWithSignature, error) {
-			return &TaskWithSignature{TaskUUID: "3", State: "FAILURE", Signature: jsonSignature}, nil
-		})
-		defer pg.Unpatch()
+	t.Run("exact task name", func(t *testing.T) {
+		dyn := newFilteredDynamoDBMock()
+		filter := &TaskFilter{State: tasks.StateFailure, TaskName: "DLQTaskDeleteComment"}
+
+		res, cursor, err := dyn.FindAllTasks(filter, "", true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"7", "8"}, taskUUIDs(res))
+		assert.NotEmpty(t, cursor)
 
-		pg2 := monkey.PatchInstanceMethod(reflect.TypeOf(machineryServer), "SendTask", func(*machineryServerMock, *tasks.Signature) (*result.AsyncResult, error) {
-			return nil, nil
+		res, cursor, err = dyn.FindAllTasks(filter, cursor, true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"9"}, taskUUIDs(res))
+		assert.Empty(t, cursor)
+	})
+
+	t.Run("task name prefix", func(t *testing.T) {
+		dyn := newFilteredDynamoDBMock()
+		dyn.client.(*fakeDynamoDB).sortedStateIndex = true
+		filter := &TaskFilter{State: tasks.StateFailure, TaskName: "DLQTaskDelete", TaskNamePrefix: true}
+
+		res, cursor, err := dyn.FindAllTasks(filter, "", false, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"9", "8", "7"}, taskUUIDs(res))
+		assert.Empty(t, cursor)
+
+		filter.TaskName = "DLQTask"
+		res, _, err = dyn.FindAllTasks(filter, "", true, 10)
+		assert.NoError(t, err)
+		assert.Len(t, res, 8)
+	})
+
+	t.Run("query the remaining size so the cursor is right after the last returned task", func(t *testing.T) {
+		dyn := newFilteredDynamoDBMock()
+		filter := &TaskFilter{State: tasks.StateFailure, TaskName: "DLQTaskDeleteComment"}
+
+		// the queries evaluate [1 2] [3 4] [5 7] [8], the last one is limited to the remaining size
+		res, cursor, err := dyn.FindAllTasks(filter, "", true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"7", "8"}, taskUUIDs(res))
+
+		expectedCursor, err := encodeB64LastEvaluatedKey(map[string]*dynamodb.AttributeValue{
+			"State":    {S: aws.String(tasks.StateFailure)},
+			"TaskUUID": {S: aws.String("8")},
 		})
-		defer pg2.Unpatch()
+		assert.NoError(t, err)
+		assert.Equal(t, expectedCursor, cursor)
+	})
 
-		err := dyn.RerunTask("3")
+	t.Run("created at range", func(t *testing.T) {
+		dyn := newFilteredDynamoDBMock()
+		filter := &TaskFilter{
+			State:         tasks.StateFailure,
+			CreatedAfter:  time.Date(2020, 12, 10, 7, 2, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2020, 12, 10, 7, 4, 0, 0, time.UTC),
+		}
+
+		res, cursor, err := dyn.FindAllTasks(filter, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2", "3", "4"}, taskUUIDs(res))
+		assert.Empty(t, cursor)
+		assert.Equal(t, "CreatedAt BETWEEN :ca AND :cb", aws.StringValue(dyn.client.(*fakeDynamoDB).lastQuery.FilterExpression))
+
+		// the pushed down bounds only have second precision
+		filter.CreatedAfter = filter.CreatedAfter.Add(500 * time.Millisecond)
+		filter.TaskName = "DLQTaskCreateComment"
+		res, _, err = dyn.FindAllTasks(filter, "", true, 10)
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"3", "4"}, taskUUIDs(res))
 	})
 
-	t.Run("handle GetItem error", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
+	t.Run("created at range is the key condition on the sorted index", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.sortedStateIndex = true
+		filter := &TaskFilter{
+			State:         tasks.StateFailure,
+			CreatedAfter:  time.Date(2020, 12, 10, 7, 2, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2020, 12, 10, 7, 4, 0, 0, time.UTC),
 		}
 
-		pg := monkey.PatchInstanceMethod(reflect.TypeOf(dyn), "FindTaskByUUID", func(*DynamoDB, string) (*TaskWithSignature, error) {
-			return nil, errors.New("gotcha")
-		})
-		defer pg.Unpatch()
+		res, cursor, err := dyn.FindAllTasks(filter, "", false, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"4", "3", "2"}, taskUUIDs(res))
+		assert.Empty(t, cursor)
+		assert.Equal(t, "#st = :st AND CreatedAt BETWEEN :ca AND :cb", aws.StringValue(client.lastQuery.KeyConditionExpression))
+		assert.Nil(t, client.lastQuery.FilterExpression)
+
+		filter.CreatedBefore = time.Time{}
+		filter.TaskName = "DLQTaskCreateComment"
+		res, _, err = dyn.FindAllTasks(filter, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2", "3", "4", "5"}, taskUUIDs(res))
+		assert.Equal(t, "#st = :st AND CreatedAt >= :ca", aws.StringValue(client.lastQuery.KeyConditionExpression))
+		assert.Equal(t, "TaskName = :tn", aws.StringValue(client.lastQuery.FilterExpression))
+
+		filter.CreatedAfter = time.Time{}
+		filter.CreatedBefore = time.Date(2020, 12, 10, 7, 2, 0, 0, time.UTC)
+		res, _, err = dyn.FindAllTasks(filter, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, taskUUIDs(res))
+		assert.Equal(t, "#st = :st AND CreatedAt < :cb", aws.StringValue(client.lastQuery.KeyConditionExpression))
+	})
+
+	t.Run("no matching task", func(t *testing.T) {
+		dyn := newFilteredDynamoDBMock()
+
+		res, cursor, err := dyn.FindAllTasks(&TaskFilter{State: tasks.StateFailure, TaskName: "Unknown"}, "", true, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		assert.Empty(t, cursor)
+	})
+}
+
+func Test_Rerun(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
 
 		err := dyn.RerunTask("3")
-		assert.Error(t, err)
+		assert.NoError(t, err)
+
+		sent := dyn.server.(*machineryServerMock).sent
+		assert.Equal(t, 1, len(sent))
+		assert.Equal(t, "DLQTaskCreateComment", sent[0].Name)
+		assert.Nil(t, sent[0].ETA)
 	})
 
-	t.Run("handle SendTask error", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
-		}
+	t.Run("handle GetItem error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.getItemErr = errors.New("gotcha")
 
-		sig := jsonSignature
-		queryResult := &dynamodb.GetItemOutput{
-			Item: map[string]*dynamodb.AttributeValue{
-				"State":     {S: aws.String("FAILURE")},
-				"Signature": {S: aws.String(sig)},
-				"TaskUUID":  {S: aws.String("3")},
-			},
-		}
-		pg := monkey.PatchInstanceMethod(reflect.TypeOf(dynamodbClient), "GetItem", func(*dynamodbClientMock, *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
-			return queryResult, nil
-		})
-		defer pg.Unpatch()
+		err := dyn.RerunTask("3")
+		assert.Error(t, err)
+		assert.Empty(t, dyn.server.(*machineryServerMock).sent)
+	})
 
-		pg2 := monkey.PatchInstanceMethod(reflect.TypeOf(machineryServer), "SendTask", func(*machineryServerMock, *tasks.Signature) (*result.AsyncResult, error) {
-			return nil, errors.New("gotcha")
-		})
-		defer pg2.Unpatch()
+	t.Run("handle SendTask error", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+		dyn.server = &machineryServerMock{err: errors.New("gotcha")}
 
 		err := dyn.RerunTask("3")
 		assert.Error(t, err)
@@ -176,49 +368,26 @@ func Test_Rerun(t *testing.T) {
 
 func Test_FindTaskByUUID(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
-		}
-
-		sig := jsonSignature
-		queryResult := &dynamodb.GetItemOutput{
-			Item: map[string]*dynamodb.AttributeValue{
-				"State":     {S: aws.String("FAILURE")},
-				"Signature": {S: aws.String(sig)},
-				"TaskUUID":  {S: aws.String("3")},
-			},
-		}
-		pg := monkey.PatchInstanceMethod(reflect.TypeOf(dynamodbClient), "GetItem", func(*dynamodbClientMock, *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
-			return queryResult, nil
-		})
-		defer pg.Unpatch()
+		dyn, _ := newDynamoDBMock()
 
 		res, err := dyn.FindTaskByUUID("3")
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
+		assert.Equal(t, "3", res.TaskUUID)
+		assert.Equal(t, tasks.StateFailure, res.State)
 	})
 
-	t.Run("handle error", func(t *testing.T) {
-		dynamodbClient := &dynamodbClientMock{}
-		machineryServer := &machineryServerMock{}
-		dyn := &DynamoDB{
-			cnf: &config.Config{
-				DynamoDB: &config.DynamoDBConfig{},
-			},
-			client: dynamodbClient,
-			server: machineryServer,
-		}
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
 
-		pg := monkey.PatchInstanceMethod(reflect.TypeOf(dynamodbClient), "GetItem", func(*dynamodbClientMock, *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
-			return nil, errors.New("faild GetItem")
-		})
-		defer pg.Unpatch()
+		res, err := dyn.FindTaskByUUID("99")
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Nil(t, res)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.getItemErr = errors.New("faild GetItem")
 
 		res, err := dyn.FindTaskByUUID("3")
 		assert.Error(t, err)
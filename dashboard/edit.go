@@ -0,0 +1,166 @@
+package dashboard
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// RerunEdit modify the stored signature before the task is resent,
+// nil fields keep the original value
+type RerunEdit struct {
+	// Args replace the original args, it must have the same length and types as the original
+	// and an empty type means the original type
+	Args       []tasks.Arg
+	RoutingKey *string
+	// Headers replace the original headers
+	Headers    tasks.Headers
+	Priority   *uint8
+	RetryCount *int
+	ETA        *time.Time
+	// NewUUID resend the signature as a new task, so the original task and its result are kept.
+	// The new task links back to the original with the HeaderRerunOf header, see NewTaskUUID
+	NewUUID string
+
+	// task is the original task already found by RerunTaskWithEdit, so the result backend isn't read twice
+	task *TaskWithSignature
+}
+
+// apply validate then apply the edit into the signature
+func (e *RerunEdit) apply(sig *tasks.Signature) error {
+	if e.Args != nil {
+		args, err := editArgs(sig.Args, e.Args)
+		if err != nil {
+			return err
+		}
+		sig.Args = args
+	}
+
+	if e.RoutingKey != nil {
+		sig.RoutingKey = *e.RoutingKey
+	}
+
+	if e.Headers != nil {
+		sig.Headers = e.Headers
+	}
+
+	if e.Priority != nil {
+		sig.Priority = *e.Priority
+	}
+
+	if e.RetryCount != nil {
+		if *e.RetryCount < 0 {
+			return fmt.Errorf("%w: retry count must not be negative", ErrInvalidEdit)
+		}
+		sig.RetryCount = *e.RetryCount
+	}
+
+	if e.ETA != nil {
+		eta := e.ETA.UTC()
+		sig.ETA = &eta
+	}
+
+	if e.NewUUID != "" && e.NewUUID != sig.UUID {
+		// the group meta lists the member uuids, a renamed member would never complete the group
+		if sig.GroupUUID != "" {
+			return fmt.Errorf("%w: group member %s must keep its uuid", ErrInvalidEdit, sig.UUID)
+		}
+
+		headers := make(tasks.Headers, len(sig.Headers)+1)
+		for key, val := range sig.Headers {
+			headers[key] = val
+		}
+		headers[HeaderRerunOf] = sig.UUID
+		sig.Headers = headers
+		sig.UUID = e.NewUUID
+	}
+
+	return nil
+}
+
+// Fields list the edited fields, keyed by their json name, NewUUID isn't an edited field
+func (e *RerunEdit) Fields() map[string]interface{} {
+	fields := map[string]interface{}{}
+	if e.Args != nil {
+		fields["args"] = e.Args
+	}
+	if e.RoutingKey != nil {
+		fields["routing_key"] = *e.RoutingKey
+	}
+	if e.Headers != nil {
+		fields["headers"] = e.Headers
+	}
+	if e.Priority != nil {
+		fields["priority"] = *e.Priority
+	}
+	if e.RetryCount != nil {
+		fields["retry_count"] = *e.RetryCount
+	}
+	if e.ETA != nil {
+		fields["eta"] = e.ETA.UTC()
+	}
+	return fields
+}
+
+// RerunTaskWithEdit rerun the task with the edit, nil means resend it as it is, and return the uuid it is resent with.
+// With freshUUID the task is rerun as a new task, but a group member keeps its uuid
+func RerunTaskWithEdit(d Dashboard, uuid string, edit *RerunEdit, freshUUID bool) (string, error) {
+	if freshUUID {
+		task, err := d.FindTaskByUUID(uuid)
+		if err != nil {
+			return "", err
+		}
+
+		sig, err := rerunSignatureOf(task)
+		if err != nil {
+			return "", err
+		}
+
+		if sig.GroupUUID == "" {
+			fresh := RerunEdit{}
+			if edit != nil {
+				fresh = *edit
+			}
+			fresh.NewUUID = NewTaskUUID()
+			fresh.task = task
+			if err = d.EditAndRerunTask(uuid, &fresh); err != nil {
+				return "", err
+			}
+			return fresh.NewUUID, nil
+		}
+	}
+
+	var err error
+	if edit == nil {
+		err = d.RerunTask(uuid)
+	} else {
+		err = d.EditAndRerunTask(uuid, edit)
+	}
+	if err != nil {
+		return "", err
+	}
+	return uuid, nil
+}
+
+// editArgs check the new args against the original types, the original names are kept
+func editArgs(original, args []tasks.Arg) ([]tasks.Arg, error) {
+	if len(args) != len(original) {
+		return nil, fmt.Errorf("%w: expected %d args, got %d", ErrInvalidEdit, len(original), len(args))
+	}
+
+	res := make([]tasks.Arg, len(args))
+	for i, arg := range args {
+		if arg.Type != "" && arg.Type != original[i].Type {
+			return nil, fmt.Errorf("%w: arg %d type must be %s, got %s", ErrInvalidEdit, i, original[i].Type, arg.Type)
+		}
+
+		if _, err := tasks.ReflectValue(original[i].Type, arg.Value); err != nil {
+			return nil, fmt.Errorf("%w: arg %d: %s", ErrInvalidEdit, i, err)
+		}
+
+		res[i] = tasks.Arg{Name: original[i].Name, Type: original[i].Type, Value: arg.Value}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,159 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EditAndRerunTask(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+		routingKey := "dlq-comment-service-v2"
+		priority := uint8(3)
+		retryCount := 5
+		eta := time.Date(2020, 12, 11, 7, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+
+		err := dyn.EditAndRerunTask("3", &RerunEdit{
+			Args:       []tasks.Arg{{Value: json.Number("1607416299930351699")}},
+			RoutingKey: &routingKey,
+			Headers:    tasks.Headers{"trace-id": "abc"},
+			Priority:   &priority,
+			RetryCount: &retryCount,
+			ETA:        &eta,
+		})
+		require.NoError(t, err)
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, []tasks.Arg{{Name: "userID", Type: "int64", Value: json.Number("1607416299930351699")}}, sent[0].Args)
+		assert.Equal(t, routingKey, sent[0].RoutingKey)
+		assert.Equal(t, tasks.Headers{"trace-id": "abc"}, sent[0].Headers)
+		assert.Equal(t, priority, sent[0].Priority)
+		assert.Equal(t, retryCount, sent[0].RetryCount)
+		assert.Equal(t, eta.UTC(), *sent[0].ETA)
+		assert.Equal(t, 8, sent[0].RetryTimeout) // untouched fields are kept
+	})
+
+	t.Run("empty edit", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		require.NoError(t, dyn.EditAndRerunTask("3", &RerunEdit{}))
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, "dlq-comment-service", sent[0].RoutingKey)
+		assert.Nil(t, sent[0].ETA)
+	})
+
+	t.Run("invalid edit", func(t *testing.T) {
+		retryCount := -1
+		edits := map[string]*RerunEdit{
+			"args length":  {Args: []tasks.Arg{}},
+			"arg type":     {Args: []tasks.Arg{{Type: "string", Value: "1"}}},
+			"arg value":    {Args: []tasks.Arg{{Value: "not a number"}}},
+			"retry count":  {RetryCount: &retryCount},
+			"float as int": {Args: []tasks.Arg{{Value: json.Number("1.5")}}},
+		}
+		for name, edit := range edits {
+			t.Run(name, func(t *testing.T) {
+				dyn, _ := newDynamoDBMock()
+
+				err := dyn.EditAndRerunTask("3", edit)
+				assert.True(t, errors.Is(err, ErrInvalidEdit))
+				assert.Empty(t, dyn.server.(*machineryServerMock).sent)
+			})
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		err := dyn.EditAndRerunTask("99", &RerunEdit{})
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func Test_RerunTaskWithEdit(t *testing.T) {
+	t.Run("fresh uuid", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		priority := uint8(3)
+
+		newUUID, err := RerunTaskWithEdit(dyn, "3", &RerunEdit{Headers: tasks.Headers{"trace-id": "abc"}, Priority: &priority}, true)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(newUUID, "task_"))
+		assert.Equal(t, 1, client.getItemCalls, "the loaded task is reused")
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, newUUID, sent[0].UUID)
+		assert.Equal(t, tasks.Headers{"trace-id": "abc", HeaderRerunOf: "3"}, sent[0].Headers)
+		assert.Equal(t, priority, sent[0].Priority)
+
+		rerun := &TaskWithSignature{Signature: `{"UUID":"` + newUUID + `","Headers":{"machinerydash_rerun_of":"3"}}`}
+		assert.Equal(t, "3", rerun.RerunOf())
+		assert.Empty(t, (&TaskWithSignature{Signature: jsonSignature}).RerunOf())
+	})
+
+	t.Run("keep the uuid", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		newUUID, err := RerunTaskWithEdit(dyn, "3", nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, "3", newUUID)
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, "3", sent[0].UUID)
+		assert.Nil(t, sent[0].Headers[HeaderRerunOf])
+	})
+
+	t.Run("group member keep the uuid", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.putTask(taskTable, &TaskWithSignature{
+			TaskUUID:  "7",
+			State:     tasks.StateFailure,
+			Signature: `{"UUID":"7","Name":"Foo","GroupUUID":"group_1"}`,
+		})
+
+		newUUID, err := RerunTaskWithEdit(dyn, "7", nil, true)
+		require.NoError(t, err)
+		assert.Equal(t, "7", newUUID)
+
+		err = dyn.EditAndRerunTask("7", &RerunEdit{NewUUID: NewTaskUUID()})
+		assert.True(t, errors.Is(err, ErrInvalidEdit))
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, "7", sent[0].UUID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, err := RerunTaskWithEdit(dyn, "99", nil, true)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func Test_TaskWithSignature_ScheduledAt(t *testing.T) {
+	future := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	task := &TaskWithSignature{Signature: `{"UUID":"1","ETA":"` + future.Format(time.RFC3339) + `"}`}
+	assert.Equal(t, future, *task.ScheduledAt())
+
+	task = &TaskWithSignature{Signature: jsonSignature} // ETA already passed
+	assert.Nil(t, task.ScheduledAt())
+
+	task = &TaskWithSignature{Signature: `{"UUID":"1"}`}
+	assert.Nil(t, task.ScheduledAt())
+
+	task = &TaskWithSignature{Signature: `invalid`}
+	assert.Nil(t, task.ScheduledAt())
+}
@@ -0,0 +1,126 @@
+package dashboard
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+const (
+	defaultFailureScanLimit = 5000
+	failurePageSize         = 100
+	failureSampleSize       = 5
+)
+
+var (
+	uuidPattern      = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|\s?[+-]\d{2}:?\d{2})?`)
+	numberPattern    = regexp.MustCompile(`\d+(\.\d+)?`)
+)
+
+// FailureGroup failed tasks sharing the same task name & normalized error
+type FailureGroup struct {
+	TaskName       string    `json:"task_name"`
+	ErrorSignature string    `json:"error_signature"`
+	Count          int       `json:"count"`
+	FirstSeen      time.Time `json:"first_seen"`
+	LastSeen       time.Time `json:"last_seen"`
+	SampleUUIDs    []string  `json:"sample_uuids"`
+	// SampleError is the original error of the first sample
+	SampleError string `json:"sample_error"`
+}
+
+// FailureOverview :nodoc:
+type FailureOverview struct {
+	Groups  []*FailureGroup `json:"groups"`
+	Scanned int             `json:"scanned"`
+	// Truncated is true when the scan limit is reached before all failures are grouped
+	Truncated bool `json:"truncated"`
+}
+
+// NormalizeError strip the UUIDs, timestamps & numbers from the error message,
+// so the errors of the same root cause share the same signature
+func NormalizeError(msg string) string {
+	msg = uuidPattern.ReplaceAllString(msg, "<uuid>")
+	msg = timestampPattern.ReplaceAllString(msg, "<time>")
+	return numberPattern.ReplaceAllString(msg, "<n>")
+}
+
+// GroupFailures page through the FAILURE tasks matching the filter, at most limit tasks,
+// and group them by task name & normalized error. The groups are sorted by count descending.
+func GroupFailures(d Dashboard, filter *TaskFilter, limit int) (*FailureOverview, error) {
+	if limit <= 0 {
+		limit = defaultFailureScanLimit
+	}
+
+	f := *filter
+	f.State = tasks.StateFailure
+
+	type groupKey struct {
+		taskName  string
+		signature string
+	}
+	groups := map[groupKey]*FailureGroup{}
+	overview := &FailureOverview{Groups: []*FailureGroup{}}
+
+	var cursor string
+	for {
+		taskStates, next, err := d.FindAllTasks(&f, cursor, true, failurePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ts := range taskStates {
+			if overview.Scanned >= limit {
+				overview.Truncated = true
+				break
+			}
+			overview.Scanned++
+
+			key := groupKey{taskName: ts.TaskName, signature: NormalizeError(ts.Error)}
+			group, ok := groups[key]
+			if !ok {
+				group = &FailureGroup{TaskName: key.taskName, ErrorSignature: key.signature, SampleError: ts.Error}
+				groups[key] = group
+				overview.Groups = append(overview.Groups, group)
+			}
+			group.add(ts)
+		}
+
+		if next == "" || overview.Truncated {
+			break
+		}
+		cursor = next
+	}
+
+	sort.SliceStable(overview.Groups, func(i, j int) bool {
+		gi, gj := overview.Groups[i], overview.Groups[j]
+		if gi.Count != gj.Count {
+			return gi.Count > gj.Count
+		}
+		return gi.LastSeen.After(gj.LastSeen)
+	})
+
+	return overview, nil
+}
+
+func (g *FailureGroup) add(task *TaskWithSignature) {
+	g.Count++
+	if len(g.SampleUUIDs) < failureSampleSize {
+		g.SampleUUIDs = append(g.SampleUUIDs, task.TaskUUID)
+	}
+
+	createdAt, err := ParseCreatedAt(task.CreatedAt)
+	if err != nil {
+		return
+	}
+
+	if g.FirstSeen.IsZero() || createdAt.Before(g.FirstSeen) {
+		g.FirstSeen = createdAt
+	}
+	if createdAt.After(g.LastSeen) {
+		g.LastSeen = createdAt
+	}
+}
@@ -0,0 +1,98 @@
+package dashboard
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeError(t *testing.T) {
+	tests := map[string]string{
+		"comment 3f6b8a8e-5a0b-4c0e-9a51-2f1d6f0b7c1d not found":       "comment <uuid> not found",
+		"user 1607416299930351698 is banned":                           "user <n> is banned",
+		"timeout after 2.5s at 2020-12-10T07:53:14.436882456Z":         "timeout after <n>s at <time>",
+		"deadline 2020-12-10 07:53:14.436882456 +0000 UTC exceeded":    "deadline <time> UTC exceeded",
+		"dial tcp 10.0.0.1:5432: connect: connection refused":          "dial tcp <n>.<n>:<n>: connect: connection refused",
+		"rpc error: code = Unavailable desc = transport is closing":    "rpc error: code = Unavailable desc = transport is closing",
+		"ID 3F6B8A8E-5A0B-4C0E-9A51-2F1D6F0B7C1D and 42 others failed": "ID <uuid> and <n> others failed",
+	}
+
+	for msg, expected := range tests {
+		assert.Equal(t, expected, NormalizeError(msg), msg)
+	}
+}
+
+func Test_GroupFailures(t *testing.T) {
+	newFailuresDynamoDBMock := func() *DynamoDB {
+		dyn, client := newDynamoDBMock()
+		for i := 1; i <= 5; i++ {
+			taskName, err := "DLQTaskCreateComment", fmt.Sprintf("comment %d not found", i)
+			if i > 3 {
+				taskName, err = "DLQTaskDeleteComment", "connection refused"
+			}
+
+			client.putTask(taskTable, &TaskWithSignature{
+				TaskUUID:  fmt.Sprint(i),
+				State:     tasks.StateFailure,
+				TaskName:  taskName,
+				CreatedAt: fmt.Sprintf("2020-12-10T07:%02d:00Z", i),
+				Error:     err,
+			})
+		}
+		return dyn
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		dyn := newFailuresDynamoDBMock()
+
+		overview, err := GroupFailures(dyn, &TaskFilter{}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 5, overview.Scanned)
+		assert.False(t, overview.Truncated)
+		require.Len(t, overview.Groups, 2)
+
+		group := overview.Groups[0]
+		assert.Equal(t, "DLQTaskCreateComment", group.TaskName)
+		assert.Equal(t, "comment <n> not found", group.ErrorSignature)
+		assert.Equal(t, "comment 1 not found", group.SampleError)
+		assert.Equal(t, 3, group.Count)
+		assert.Equal(t, []string{"1", "2", "3"}, group.SampleUUIDs)
+		assert.Equal(t, time.Date(2020, 12, 10, 7, 1, 0, 0, time.UTC), group.FirstSeen)
+		assert.Equal(t, time.Date(2020, 12, 10, 7, 3, 0, 0, time.UTC), group.LastSeen)
+
+		assert.Equal(t, "DLQTaskDeleteComment", overview.Groups[1].TaskName)
+		assert.Equal(t, 2, overview.Groups[1].Count)
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		dyn := newFailuresDynamoDBMock()
+
+		overview, err := GroupFailures(dyn, &TaskFilter{ErrorContains: "refused"}, 0)
+		require.NoError(t, err)
+		require.Len(t, overview.Groups, 1)
+		assert.Equal(t, "connection refused", overview.Groups[0].ErrorSignature)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		dyn := newFailuresDynamoDBMock()
+
+		overview, err := GroupFailures(dyn, &TaskFilter{}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, overview.Scanned)
+		assert.True(t, overview.Truncated)
+		require.Len(t, overview.Groups, 1)
+		assert.Equal(t, 2, overview.Groups[0].Count)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.queryErr = fmt.Errorf("gotcha")
+
+		_, err := GroupFailures(dyn, &TaskFilter{}, 0)
+		assert.Error(t, err)
+	})
+}
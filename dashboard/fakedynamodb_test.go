@@ -0,0 +1,358 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// fakeDynamoDB is an in-memory dynamoDBClient. It keeps machinery's table layout:
+// task items are keyed by TaskUUID, group metas by GroupUUID and tasks.TaskStateIndex is
+// the State hash key index, items on the index are ordered by CreatedAt then TaskUUID.
+// The index only has the CreatedAt range key when sortedStateIndex is true, otherwise
+// the order is not guaranteed by dynamodb, it is kept here to make the tests deterministic.
+type fakeDynamoDB struct {
+	mu    sync.Mutex
+	items map[string]map[string]map[string]*dynamodb.AttributeValue // table -> hash key -> item
+
+	sortedStateIndex bool
+	// lastQuery is the input of the latest Query
+	lastQuery *dynamodb.QueryInput
+	// describeCalls count the DescribeTable calls
+	describeCalls int
+	// getItemCalls count the GetItem calls
+	getItemCalls int
+
+	queryErr         error
+	getItemErr       error
+	updateItemErr    error
+	describeTableErr error
+}
+
+func newFakeDynamoDB() *fakeDynamoDB {
+	return &fakeDynamoDB{
+		items: map[string]map[string]map[string]*dynamodb.AttributeValue{},
+	}
+}
+
+// putTask marshal the given value and store it on the table keyed by TaskUUID
+func (f *fakeDynamoDB) putTask(table string, v interface{}) {
+	f.putItem(table, "TaskUUID", v)
+}
+
+// putItem marshal the given value and store it on the table keyed by the hashKey attribute
+func (f *fakeDynamoDB) putItem(table, hashKey string, v interface{}) {
+	item, err := dynamodbattribute.MarshalMap(v)
+	if err != nil {
+		panic(err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.items[table] == nil {
+		f.items[table] = map[string]map[string]*dynamodb.AttributeValue{}
+	}
+	f.items[table][aws.StringValue(item[hashKey].S)] = item
+}
+
+func (f *fakeDynamoDB) GetItem(in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	if f.getItemErr != nil {
+		return nil, f.getItemErr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getItemCalls++
+
+	if len(in.Key) != 1 {
+		return nil, errors.New("ValidationException: the key must be a single hash key")
+	}
+
+	var key *dynamodb.AttributeValue
+	for _, v := range in.Key {
+		key = v
+	}
+	if key.S == nil {
+		return nil, errors.New("ValidationException: the hash key must be a string")
+	}
+
+	item, ok := f.items[aws.StringValue(in.TableName)][aws.StringValue(key.S)]
+	if !ok {
+		return &dynamodb.GetItemOutput{}, nil
+	}
+
+	return &dynamodb.GetItemOutput{Item: project(item, in.ProjectionExpression, in.ExpressionAttributeNames)}, nil
+}
+
+func (f *fakeDynamoDB) Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if aws.StringValue(in.IndexName) != "StateIndex" {
+		return nil, fmt.Errorf("ValidationException: unknown index %s", aws.StringValue(in.IndexName))
+	}
+	f.lastQuery = in
+
+	// the key condition may only refer the index keys
+	for _, cond := range splitConditions(aws.StringValue(in.KeyConditionExpression)) {
+		attr := resolveName(strings.Fields(cond)[0], in.ExpressionAttributeNames)
+		if attr != "State" && (attr != "CreatedAt" || !f.sortedStateIndex) {
+			return nil, fmt.Errorf("ValidationException: %s is not a key of the index", attr)
+		}
+	}
+
+	var matched []map[string]*dynamodb.AttributeValue
+	for _, item := range f.items[aws.StringValue(in.TableName)] {
+		ok, err := evalCondition(aws.StringValue(in.KeyConditionExpression), item, in.ExpressionAttributeNames, in.ExpressionAttributeValues)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		ci, cj := attrString(matched[i], "CreatedAt"), attrString(matched[j], "CreatedAt")
+		if ci != cj {
+			return ci < cj
+		}
+		return attrString(matched[i], "TaskUUID") < attrString(matched[j], "TaskUUID")
+	})
+	if in.ScanIndexForward != nil && !*in.ScanIndexForward {
+		for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+			matched[i], matched[j] = matched[j], matched[i]
+		}
+	}
+
+	if in.ExclusiveStartKey != nil {
+		if _, ok := in.ExclusiveStartKey["CreatedAt"]; ok != f.sortedStateIndex {
+			return nil, errors.New("ValidationException: the provided starting key is invalid")
+		}
+
+		start := attrString(in.ExclusiveStartKey, "TaskUUID")
+		for i, item := range matched {
+			if attrString(item, "TaskUUID") == start {
+				matched = matched[i+1:]
+				break
+			}
+		}
+	}
+
+	// just like dynamodb, LastEvaluatedKey is returned whenever the Limit is reached
+	// even when there are no more items left
+	out := &dynamodb.QueryOutput{Items: matched}
+	if in.Limit != nil && int64(len(matched)) >= *in.Limit {
+		out.Items = matched[:*in.Limit]
+		last := out.Items[len(out.Items)-1]
+		out.LastEvaluatedKey = map[string]*dynamodb.AttributeValue{
+			"TaskUUID": last["TaskUUID"],
+			"State":    last["State"],
+		}
+		if f.sortedStateIndex {
+			out.LastEvaluatedKey["CreatedAt"] = last["CreatedAt"]
+		}
+	}
+
+	// FilterExpression is applied after the Limit, so a page may be empty while having LastEvaluatedKey
+	if in.FilterExpression != nil {
+		var filtered []map[string]*dynamodb.AttributeValue
+		for _, item := range out.Items {
+			ok, err := evalCondition(aws.StringValue(in.FilterExpression), item, in.ExpressionAttributeNames, in.ExpressionAttributeValues)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				filtered = append(filtered, item)
+			}
+		}
+		out.Items = filtered
+	}
+
+	for i, item := range out.Items {
+		out.Items[i] = project(item, in.ProjectionExpression, in.ExpressionAttributeNames)
+	}
+	out.Count = aws.Int64(int64(len(out.Items)))
+	return out, nil
+}
+
+// DescribeTable only describe the StateIndex key schema
+func (f *fakeDynamoDB) DescribeTable(in *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.describeCalls++
+	if f.describeTableErr != nil {
+		return nil, f.describeTableErr
+	}
+
+	keySchema := []*dynamodb.KeySchemaElement{
+		{AttributeName: aws.String("State"), KeyType: aws.String(dynamodb.KeyTypeHash)},
+	}
+	if f.sortedStateIndex {
+		keySchema = append(keySchema, &dynamodb.KeySchemaElement{AttributeName: aws.String("CreatedAt"), KeyType: aws.String(dynamodb.KeyTypeRange)})
+	}
+
+	return &dynamodb.DescribeTableOutput{
+		Table: &dynamodb.TableDescription{
+			TableName: in.TableName,
+			GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndexDescription{
+				{IndexName: aws.String("StateIndex"), KeySchema: keySchema},
+			},
+		},
+	}, nil
+}
+
+// UpdateItem support "SET a = :a, b = :b" expressions, the item is created when it doesn't exist
+func (f *fakeDynamoDB) UpdateItem(in *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	if f.updateItemErr != nil {
+		return nil, f.updateItemErr
+	}
+
+	expr := aws.StringValue(in.UpdateExpression)
+	if !strings.HasPrefix(expr, "SET ") || len(in.Key) != 1 {
+		return nil, fmt.Errorf("ValidationException: unsupported update %q", expr)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	table := aws.StringValue(in.TableName)
+	if f.items[table] == nil {
+		f.items[table] = map[string]map[string]*dynamodb.AttributeValue{}
+	}
+
+	var item map[string]*dynamodb.AttributeValue
+	for name, key := range in.Key {
+		item = f.items[table][aws.StringValue(key.S)]
+		if item == nil {
+			item = map[string]*dynamodb.AttributeValue{name: key}
+			f.items[table][aws.StringValue(key.S)] = item
+		}
+	}
+
+	for _, set := range strings.Split(strings.TrimPrefix(expr, "SET "), ",") {
+		parts := strings.Fields(set)
+		if len(parts) != 3 || parts[1] != "=" {
+			return nil, fmt.Errorf("ValidationException: unsupported update %q", set)
+		}
+
+		val, ok := in.ExpressionAttributeValues[parts[2]]
+		if !ok {
+			return nil, fmt.Errorf("ValidationException: missing value %s", parts[2])
+		}
+		item[resolveName(parts[0], in.ExpressionAttributeNames)] = val
+	}
+
+	return &dynamodb.UpdateItemOutput{}, nil
+}
+
+// stringComparators are the supported operators on string attributes
+var stringComparators = map[string]func(attr, val string) bool{
+	"=":           func(attr, val string) bool { return attr == val },
+	"<":           func(attr, val string) bool { return attr < val },
+	"<=":          func(attr, val string) bool { return attr <= val },
+	">":           func(attr, val string) bool { return attr > val },
+	">=":          func(attr, val string) bool { return attr >= val },
+	"begins_with": strings.HasPrefix,
+	"contains":    strings.Contains,
+}
+
+// splitConditions split the expression on AND, keeping "a BETWEEN :x AND :y" as a single condition
+func splitConditions(expr string) []string {
+	var conds []string
+	parts := strings.Split(expr, " AND ")
+	for i := 0; i < len(parts); i++ {
+		cond := parts[i]
+		if strings.Contains(cond, " BETWEEN ") && i+1 < len(parts) {
+			i++
+			cond += " AND " + parts[i]
+		}
+		conds = append(conds, cond)
+	}
+	return conds
+}
+
+// evalCondition evaluate simple expressions on string attributes, e.g. "#st = :st AND CreatedAt BETWEEN :ca AND :cb"
+// or "begins_with(TaskName, :tn) AND contains(#err, :ec)"
+func evalCondition(expr string, item map[string]*dynamodb.AttributeValue, names map[string]*string, values map[string]*dynamodb.AttributeValue) (bool, error) {
+	for _, cond := range splitConditions(expr) {
+		// rewrite "a BETWEEN :x AND :y" into "a >= :x AND a <= :y"
+		if parts := strings.Fields(cond); len(parts) == 5 && parts[1] == "BETWEEN" && parts[3] == "AND" {
+			ok, err := evalCondition(fmt.Sprintf("%s >= %s AND %s <= %s", parts[0], parts[2], parts[0], parts[4]), item, names, values)
+			if err != nil || !ok {
+				return false, err
+			}
+			continue
+		}
+
+		var match func(attr, val string) bool
+		// rewrite function e.g. "begins_with(a, :a)" into "a begins_with :a"
+		if i := strings.Index(cond, "("); i > 0 && strings.HasSuffix(cond, ")") {
+			args := strings.Split(cond[i+1:len(cond)-1], ",")
+			if len(args) != 2 {
+				return false, fmt.Errorf("ValidationException: unsupported condition %q", cond)
+			}
+			cond = strings.TrimSpace(args[0]) + " " + cond[:i] + " " + strings.TrimSpace(args[1])
+		}
+
+		parts := strings.Fields(cond)
+		if len(parts) == 3 {
+			match = stringComparators[parts[1]]
+		}
+		if match == nil {
+			return false, fmt.Errorf("ValidationException: unsupported condition %q", cond)
+		}
+
+		val, ok := values[parts[2]]
+		if !ok {
+			return false, fmt.Errorf("ValidationException: missing value %s", parts[2])
+		}
+
+		attr, ok := item[resolveName(parts[0], names)]
+		if !ok || !match(aws.StringValue(attr.S), aws.StringValue(val.S)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func project(item map[string]*dynamodb.AttributeValue, projection *string, names map[string]*string) map[string]*dynamodb.AttributeValue {
+	if projection == nil {
+		return item
+	}
+
+	res := map[string]*dynamodb.AttributeValue{}
+	for _, attr := range strings.Split(*projection, ",") {
+		name := resolveName(strings.TrimSpace(attr), names)
+		if val, ok := item[name]; ok {
+			res[name] = val
+		}
+	}
+	return res
+}
+
+func resolveName(name string, names map[string]*string) string {
+	if strings.HasPrefix(name, "#") {
+		return aws.StringValue(names[name])
+	}
+	return name
+}
+
+func attrString(item map[string]*dynamodb.AttributeValue, name string) string {
+	if val, ok := item[name]; ok {
+		return aws.StringValue(val.S)
+	}
+	return ""
+}
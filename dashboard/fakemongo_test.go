@@ -0,0 +1,214 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// fakeMongoCollection keep the documents in memory and evaluate the filters built by the Mongo dashboard,
+// only the operators used by buildMongoTaskFilter & buildMongoCountPipeline are supported
+type fakeMongoCollection struct {
+	docs []bson.M
+	err  error
+}
+
+type fakeMongoCursor struct {
+	docs []bson.M
+}
+
+type fakeMongoSingleResult struct {
+	doc bson.M
+	err error
+}
+
+func (f *fakeMongoCollection) insert(doc bson.M) {
+	f.docs = append(f.docs, doc)
+}
+
+func (f *fakeMongoCollection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (mongoResultCursor, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	var docs []bson.M
+	for _, doc := range f.docs {
+		if matchFakeMongo(doc, filter.(bson.M)) {
+			docs = append(docs, doc)
+		}
+	}
+
+	for _, opt := range opts {
+		if opt.Sort != nil {
+			keys := opt.Sort.(bson.D)
+			sort.SliceStable(docs, func(i, j int) bool {
+				for _, key := range keys {
+					if c := compareFakeMongo(docs[i][key.Key], docs[j][key.Key]); c != 0 {
+						return c*key.Value.(int) < 0
+					}
+				}
+				return false
+			})
+		}
+		if opt.Limit != nil && int64(len(docs)) > *opt.Limit {
+			docs = docs[:*opt.Limit]
+		}
+	}
+
+	return &fakeMongoCursor{docs: docs}, nil
+}
+
+func (f *fakeMongoCollection) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) mongoSingleResult {
+	if f.err != nil {
+		return &fakeMongoSingleResult{err: f.err}
+	}
+
+	for _, doc := range f.docs {
+		if matchFakeMongo(doc, filter.(bson.M)) {
+			return &fakeMongoSingleResult{doc: doc}
+		}
+	}
+	return &fakeMongoSingleResult{err: mongo.ErrNoDocuments}
+}
+
+// Aggregate only support the $match then $group by state & task name pipeline
+func (f *fakeMongoCollection) Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (mongoResultCursor, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	match := pipeline.(bson.A)[0].(bson.M)["$match"].(bson.M)
+	counts := map[[2]string]int64{}
+	var keys [][2]string
+	for _, doc := range f.docs {
+		if !matchFakeMongo(doc, match) {
+			continue
+		}
+
+		key := [2]string{fmt.Sprint(doc["state"]), fmt.Sprint(doc["task_name"])}
+		if _, ok := counts[key]; !ok {
+			keys = append(keys, key)
+		}
+		counts[key]++
+	}
+
+	cur := &fakeMongoCursor{}
+	for _, key := range keys {
+		cur.docs = append(cur.docs, bson.M{
+			"_id":   bson.M{"state": key[0], "task_name": key[1]},
+			"count": counts[key],
+		})
+	}
+	return cur, nil
+}
+
+// All decode the documents through bson, results must be a pointer to a slice of pointers
+func (c *fakeMongoCursor) All(ctx context.Context, results interface{}) error {
+	slice := reflect.ValueOf(results).Elem()
+	for _, doc := range c.docs {
+		elem := reflect.New(slice.Type().Elem().Elem())
+		if err := decodeFakeMongo(doc, elem.Interface()); err != nil {
+			return err
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+	return nil
+}
+
+func (c *fakeMongoCursor) Close(ctx context.Context) error {
+	return nil
+}
+
+func (r *fakeMongoSingleResult) Decode(v interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return decodeFakeMongo(r.doc, v)
+}
+
+func decodeFakeMongo(doc bson.M, v interface{}) error {
+	bt, err := bson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(bt, v)
+}
+
+func matchFakeMongo(doc bson.M, filter bson.M) bool {
+	for key, cond := range filter {
+		if key == "$or" {
+			matched := false
+			for _, sub := range cond.(bson.A) {
+				matched = matched || matchFakeMongo(doc, sub.(bson.M))
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+
+		val := doc[key]
+		ops, ok := cond.(bson.M)
+		if !ok {
+			if compareFakeMongo(val, cond) != 0 {
+				return false
+			}
+			continue
+		}
+
+		for op, arg := range ops {
+			if !matchFakeMongoOp(val, op, arg) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchFakeMongoOp(val interface{}, op string, arg interface{}) bool {
+	switch op {
+	case "$regex":
+		str, _ := val.(string)
+		return regexp.MustCompile(arg.(string)).MatchString(str)
+	case "$in":
+		for _, item := range arg.([]string) {
+			if compareFakeMongo(val, item) == 0 {
+				return true
+			}
+		}
+		return false
+	case "$gte":
+		return val != nil && compareFakeMongo(val, arg) >= 0
+	case "$gt":
+		return val != nil && compareFakeMongo(val, arg) > 0
+	case "$lte":
+		return val != nil && compareFakeMongo(val, arg) <= 0
+	case "$lt":
+		return val != nil && compareFakeMongo(val, arg) < 0
+	default:
+		panic("unsupported operator " + op)
+	}
+}
+
+func compareFakeMongo(a, b interface{}) int {
+	if at, ok := a.(time.Time); ok {
+		bt := b.(time.Time)
+		switch {
+		case at.Before(bt):
+			return -1
+		case at.After(bt):
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
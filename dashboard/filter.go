@@ -0,0 +1,71 @@
+package dashboard
+
+import (
+	"strings"
+	"time"
+)
+
+// TaskFilter select the tasks to list, zero values are ignored except State
+type TaskFilter struct {
+	State    string
+	TaskName string
+	// TaskNamePrefix match the tasks whose name starts with TaskName instead of the exact name
+	TaskNamePrefix bool
+	// CreatedAfter & CreatedBefore are inclusive
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// ErrorContains match the tasks whose error contains the substring, case sensitive
+	ErrorContains string
+}
+
+// MatchTaskName check whether the task name matches the filter
+func (f *TaskFilter) MatchTaskName(name string) bool {
+	switch {
+	case f.TaskName == "":
+		return true
+	case f.TaskNamePrefix:
+		return strings.HasPrefix(name, f.TaskName)
+	default:
+		return name == f.TaskName
+	}
+}
+
+// MatchCreatedAt check whether the task creation time is within the filter range
+func (f *TaskFilter) MatchCreatedAt(createdAt string) bool {
+	if f.CreatedAfter.IsZero() && f.CreatedBefore.IsZero() {
+		return true
+	}
+
+	t, err := ParseCreatedAt(createdAt)
+	if err != nil {
+		return false // unknown creation time never matches a time range
+	}
+
+	if !f.CreatedAfter.IsZero() && t.Before(f.CreatedAfter) {
+		return false
+	}
+
+	if !f.CreatedBefore.IsZero() && t.After(f.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// MatchError check whether the task error contains the filter substring
+func (f *TaskFilter) MatchError(err string) bool {
+	return strings.Contains(err, f.ErrorContains)
+}
+
+// Match check whether the task matches the filter
+func (f *TaskFilter) Match(task *TaskWithSignature) bool {
+	return task.State == f.State &&
+		f.MatchTaskName(task.TaskName) &&
+		f.MatchCreatedAt(task.CreatedAt) &&
+		f.MatchError(task.Error)
+}
+
+// ParseCreatedAt parse TaskWithSignature.CreatedAt
+func ParseCreatedAt(createdAt string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, createdAt)
+}
@@ -0,0 +1,182 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// Group is the group meta written by machinery with the state of its members
+type Group struct {
+	GroupUUID      string
+	TaskUUIDs      []string
+	ChordTriggered bool
+	Lock           bool
+	CreatedAt      time.Time
+	Tasks          []*GroupTask
+	// ChordCallback is taken from the members signature, nil when the group isn't a chord
+	ChordCallback *tasks.Signature
+}
+
+// GroupTask is a member of a group, Task is nil when the member state doesn't exist anymore
+type GroupTask struct {
+	TaskUUID string
+	Task     *TaskWithSignature
+	Error    string
+}
+
+// IsChord :nodoc:
+func (g *Group) IsChord() bool {
+	return g.ChordCallback != nil
+}
+
+// StateCounts count the members by state, missing members are counted as empty state
+func (g *Group) StateCounts() map[string]int {
+	counts := map[string]int{}
+	for _, gt := range g.Tasks {
+		if gt.Task == nil {
+			counts[""]++
+			continue
+		}
+		counts[gt.Task.State]++
+	}
+	return counts
+}
+
+// newGroup load the state of each group member, a member failing to load doesn't fail the whole group
+func newGroup(d Dashboard, meta *tasks.GroupMeta) *Group {
+	group := &Group{
+		GroupUUID:      meta.GroupUUID,
+		TaskUUIDs:      meta.TaskUUIDs,
+		ChordTriggered: meta.ChordTriggered,
+		Lock:           meta.Lock,
+		CreatedAt:      meta.CreatedAt,
+	}
+
+	for _, uuid := range meta.TaskUUIDs {
+		gt := &GroupTask{TaskUUID: uuid}
+		group.Tasks = append(group.Tasks, gt)
+
+		task, err := d.FindTaskByUUID(uuid)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			continue
+		case err != nil:
+			gt.Error = err.Error()
+			continue
+		}
+		gt.Task = task
+
+		if group.ChordCallback != nil {
+			continue
+		}
+
+		sig := &tasks.Signature{}
+		if err := task.UnmarshalSignature(sig); err == nil && sig.ChordCallback != nil {
+			group.ChordCallback = sig.ChordCallback
+		}
+	}
+
+	return group
+}
+
+// GroupRerunReport :nodoc:
+type GroupRerunReport struct {
+	GroupUUID string             `json:"group_uuid"`
+	Results   []*BulkRerunResult `json:"results"`
+	// ChordReset is true when the chord is marked as not triggered so the worker trigger it again
+	ChordReset bool `json:"chord_reset"`
+	// ChordCallbackSent is true when every member already succeeded but the chord callback was never sent
+	ChordCallbackSent bool `json:"chord_callback_sent"`
+}
+
+// chordStore update the group meta on the result backend
+type chordStore interface {
+	setChordTriggered(groupUUID string, triggered bool) error
+}
+
+// rerunGroup rerun the failed members of the group, or every member when all is true.
+// The members keep their GroupUUID & ChordCallback so the worker trigger the chord once they succeed,
+// the chord is reset beforehand since the worker skip an already triggered chord.
+// When there is nothing to rerun but the chord was never triggered, the chord callback is sent
+// with the members results just like the worker does.
+func rerunGroup(d Dashboard, srv machineryServer, store chordStore, groupUUID string, all bool) (*GroupRerunReport, error) {
+	group, err := d.FindGroupByUUID(groupUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &GroupRerunReport{GroupUUID: groupUUID, Results: []*BulkRerunResult{}}
+
+	var uuids []string
+	for _, gt := range group.Tasks {
+		if all || gt.Task == nil || gt.Task.State == tasks.StateFailure {
+			uuids = append(uuids, gt.TaskUUID)
+		}
+	}
+
+	if len(uuids) == 0 {
+		if !group.IsChord() || group.ChordTriggered {
+			return report, nil
+		}
+
+		err = sendChordCallback(srv, store, group)
+		if err != nil {
+			return nil, err
+		}
+		report.ChordCallbackSent = true
+		return report, nil
+	}
+
+	if group.IsChord() {
+		err = store.setChordTriggered(groupUUID, false)
+		if err != nil {
+			return nil, err
+		}
+		report.ChordReset = true
+	}
+
+	// the members keep their uuid, the group meta lists them
+	for _, uuid := range uuids {
+		res := &BulkRerunResult{UUID: uuid, Success: true}
+		if err := d.RerunTask(uuid); err != nil {
+			res.Success = false
+			res.Error = err.Error()
+		}
+		report.Results = append(report.Results, res)
+	}
+
+	return report, nil
+}
+
+// sendChordCallback mark the chord as triggered then send the callback with the members results
+// appended as args unless the callback is immutable, the same way the worker does
+func sendChordCallback(srv machineryServer, store chordStore, group *Group) error {
+	callback := *group.ChordCallback
+	callback.Args = append([]tasks.Arg{}, callback.Args...)
+	for _, gt := range group.Tasks {
+		if gt.Task == nil || gt.Task.State != tasks.StateSuccess {
+			return fmt.Errorf("group %s member %s hasn't succeeded", group.GroupUUID, gt.TaskUUID)
+		}
+
+		if callback.Immutable {
+			continue
+		}
+		for _, res := range gt.Task.Results {
+			callback.Args = append(callback.Args, tasks.Arg{Type: res.Type, Value: res.Value})
+		}
+	}
+
+	err := store.setChordTriggered(group.GroupUUID, true)
+	if err != nil {
+		return err
+	}
+
+	_, err = srv.SendTask(&callback)
+	if err != nil {
+		return fmt.Errorf("failed to send chord callback: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindGroupByUUID(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.putTask(taskTable, &TaskWithSignature{
+			TaskUUID:  "7",
+			State:     tasks.StateSuccess,
+			TaskName:  "Foo",
+			Signature: `{"UUID":"7","Name":"Foo","GroupUUID":"group_1","ChordCallback":{"UUID":"8","Name":"Bar"}}`,
+		})
+		client.putItem(groupTable, "GroupUUID", &tasks.GroupMeta{
+			GroupUUID:      "group_1",
+			TaskUUIDs:      []string{"6", "7", "99"},
+			ChordTriggered: true,
+			CreatedAt:      time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC),
+		})
+
+		group, err := dyn.FindGroupByUUID("group_1")
+		require.NoError(t, err)
+		assert.Equal(t, "group_1", group.GroupUUID)
+		assert.True(t, group.ChordTriggered)
+		assert.Equal(t, time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC), group.CreatedAt)
+		require.Len(t, group.Tasks, 3)
+		assert.Equal(t, tasks.StateSuccess, group.Tasks[0].Task.State)
+		assert.Equal(t, "7", group.Tasks[1].Task.TaskUUID)
+		assert.Equal(t, "99", group.Tasks[2].TaskUUID)
+		assert.Nil(t, group.Tasks[2].Task)
+		assert.True(t, group.IsChord())
+		assert.Equal(t, "8", group.ChordCallback.UUID)
+		assert.Equal(t, map[string]int{tasks.StateSuccess: 2, "": 1}, group.StateCounts())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, err := dyn.FindGroupByUUID("group_99")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("handle GetItem error", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.getItemErr = errors.New("gotcha")
+
+		_, err := dyn.FindGroupByUUID("group_1")
+		assert.Error(t, err)
+	})
+}
+
+func Test_Redis_FindGroupByUUID(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := newRedisMock()
+
+		group, err := r.FindGroupByUUID("group_1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"task_1", "task_2"}, group.TaskUUIDs)
+		require.Len(t, group.Tasks, 2)
+		assert.Equal(t, tasks.StateFailure, group.Tasks[0].Task.State)
+		assert.Equal(t, tasks.StateSuccess, group.Tasks[1].Task.State)
+		assert.False(t, group.IsChord())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r := newRedisMock()
+
+		_, err := r.FindGroupByUUID("group_99")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+// newChordDynamoDBMock add a chord of task 1 (FAILURE), 6 (SUCCESS) and 7 (SUCCESS) with Bar as the callback
+func newChordDynamoDBMock(chordTriggered bool) (*DynamoDB, *fakeDynamoDB) {
+	dyn, client := newDynamoDBMock()
+	client.putTask(taskTable, &TaskWithSignature{
+		TaskUUID:  "7",
+		State:     tasks.StateSuccess,
+		TaskName:  "Foo",
+		Signature: `{"UUID":"7","Name":"Foo","GroupUUID":"group_1","GroupTaskCount":3,"ChordCallback":{"UUID":"8","Name":"Bar","Args":[{"Type":"string","Value":"x"}]}}`,
+		Results:   []*tasks.TaskResult{{Type: "int64", Value: "7"}},
+	})
+	client.putItem(groupTable, "GroupUUID", &tasks.GroupMeta{
+		GroupUUID:      "group_1",
+		TaskUUIDs:      []string{"1", "6", "7"},
+		ChordTriggered: chordTriggered,
+		Lock:           true,
+	})
+	return dyn, client
+}
+
+func Test_RerunGroup(t *testing.T) {
+	t.Run("failed members", func(t *testing.T) {
+		dyn, client := newChordDynamoDBMock(false)
+
+		report, err := dyn.RerunGroup("group_1", false)
+		require.NoError(t, err)
+		assert.True(t, report.ChordReset)
+		assert.False(t, report.ChordCallbackSent)
+		assert.Equal(t, []*BulkRerunResult{{UUID: "1", Success: true}}, report.Results)
+
+		group, err := dyn.FindGroupByUUID("group_1")
+		require.NoError(t, err)
+		assert.False(t, group.ChordTriggered)
+		assert.False(t, group.Lock)
+		assert.Len(t, client.items[groupTable], 1)
+	})
+
+	t.Run("all members", func(t *testing.T) {
+		dyn, _ := newChordDynamoDBMock(true)
+
+		report, err := dyn.RerunGroup("group_1", true)
+		require.NoError(t, err)
+		assert.True(t, report.ChordReset)
+		assert.Len(t, report.Results, 3)
+		assert.Len(t, dyn.server.(*machineryServerMock).sent, 3)
+
+		group, err := dyn.FindGroupByUUID("group_1")
+		require.NoError(t, err)
+		assert.False(t, group.ChordTriggered)
+	})
+
+	t.Run("send chord callback", func(t *testing.T) {
+		dyn, client := newChordDynamoDBMock(false)
+		client.putTask(taskTable, &TaskWithSignature{
+			TaskUUID:  "1",
+			State:     tasks.StateSuccess,
+			Signature: jsonSignature,
+			Results:   []*tasks.TaskResult{{Type: "int64", Value: "1"}},
+		})
+
+		report, err := dyn.RerunGroup("group_1", false)
+		require.NoError(t, err)
+		assert.True(t, report.ChordCallbackSent)
+		assert.Empty(t, report.Results)
+
+		sent := dyn.server.(*machineryServerMock).sent
+		require.Len(t, sent, 1)
+		assert.Equal(t, "8", sent[0].UUID)
+		assert.Equal(t, []tasks.Arg{
+			{Type: "string", Value: "x"},
+			{Type: "int64", Value: "1"},
+			{Type: "int64", Value: "7"},
+		}, sent[0].Args)
+
+		group, err := dyn.FindGroupByUUID("group_1")
+		require.NoError(t, err)
+		assert.True(t, group.ChordTriggered)
+	})
+
+	t.Run("nothing to rerun", func(t *testing.T) {
+		dyn, client := newChordDynamoDBMock(true)
+		client.putTask(taskTable, &TaskWithSignature{TaskUUID: "1", State: tasks.StateSuccess, Signature: jsonSignature})
+
+		report, err := dyn.RerunGroup("group_1", false)
+		require.NoError(t, err)
+		assert.False(t, report.ChordReset)
+		assert.False(t, report.ChordCallbackSent)
+		assert.Empty(t, dyn.server.(*machineryServerMock).sent)
+	})
+
+	t.Run("not a chord", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.putItem(groupTable, "GroupUUID", &tasks.GroupMeta{GroupUUID: "group_2", TaskUUIDs: []string{"1", "2", "6"}})
+
+		report, err := dyn.RerunGroup("group_2", false)
+		require.NoError(t, err)
+		assert.False(t, report.ChordReset)
+		assert.Len(t, report.Results, 2)
+	})
+
+	t.Run("handle UpdateItem error", func(t *testing.T) {
+		dyn, client := newChordDynamoDBMock(false)
+		client.updateItemErr = errors.New("gotcha")
+
+		_, err := dyn.RerunGroup("group_1", false)
+		assert.Error(t, err)
+		assert.Empty(t, dyn.server.(*machineryServerMock).sent)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, err := dyn.RerunGroup("group_99", false)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
+
+func Test_Redis_setChordTriggered(t *testing.T) {
+	r := newRedisMock()
+	client := r.client.(*redisClientMock)
+	client.items["group_1"] = `{"GroupUUID":"group_1","TaskUUIDs":["task_1"],"ChordTriggered":true,"Lock":true}`
+	client.ttls = map[string]time.Duration{"group_1": time.Hour}
+
+	require.NoError(t, r.setChordTriggered("group_1", false))
+
+	group, err := r.FindGroupByUUID("group_1")
+	require.NoError(t, err)
+	assert.False(t, group.ChordTriggered)
+	assert.False(t, group.Lock)
+	assert.Equal(t, time.Hour, client.ttls["group_1"])
+}
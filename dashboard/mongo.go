@@ -0,0 +1,321 @@
+package dashboard
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/log"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Mongo monitor tasks stored by machinery mongodb result backend.
+// The backend doesn't store the task signatures, so the tasks can't be rerun, see ErrRerunUnsupported
+type Mongo struct {
+	cnf        *config.Config
+	tasks      mongoCollection
+	groupMetas mongoCollection
+}
+
+// mongoTaskState is the task document written by machinery, it has no signature
+type mongoTaskState struct {
+	TaskUUID  string              `bson:"_id"`
+	TaskName  string              `bson:"task_name"`
+	State     string              `bson:"state"`
+	Error     string              `bson:"error"`
+	CreatedAt time.Time           `bson:"created_at"`
+	Results   []*tasks.TaskResult `bson:"results"`
+}
+
+// mongoDriverCollection adapt the driver collection into mongoCollection
+type mongoDriverCollection struct {
+	*mongo.Collection
+}
+
+// mongoTaskCount is the result of buildMongoCountPipeline
+type mongoTaskCount struct {
+	ID struct {
+		State    string `bson:"state"`
+		TaskName string `bson:"task_name"`
+	} `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+// mongoCursor is the position of the last returned task, sorted by created_at then _id
+type mongoCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	TaskUUID  string    `json:"task_uuid"`
+}
+
+// NewMongo :nodoc:
+func NewMongo(cnf *config.Config) (Dashboard, error) {
+	if cnf.MongoDB == nil || cnf.MongoDB.Client == nil {
+		return nil, errors.New("mongodb client is not configured")
+	}
+
+	database := cnf.MongoDB.Database
+	if database == "" {
+		database = "machinery" // same default as machinery
+	}
+
+	db := cnf.MongoDB.Client.Database(database)
+	return &Mongo{
+		cnf:        cnf,
+		tasks:      &mongoDriverCollection{db.Collection("tasks")},
+		groupMetas: &mongoDriverCollection{db.Collection("group_metas")},
+	}, nil
+}
+
+// FindAllTasksByState :nodoc:
+func (m *Mongo) FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
+	return m.FindAllTasks(&TaskFilter{State: state}, cursor, asc, size)
+}
+
+// FindAllTasks :nodoc:
+// cursor is base64 encoded position of the last task on the previous page
+func (m *Mongo) FindAllTasks(filter *TaskFilter, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
+	if size <= 0 {
+		size = 10
+	}
+
+	var after *mongoCursor
+	if cursor != "" {
+		after, err = decodeB64MongoCursor(cursor)
+		if err != nil {
+			log.ERROR.Println(err)
+			return nil, next, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+		}
+	}
+
+	sortOrder := -1
+	if asc {
+		sortOrder = 1
+	}
+
+	// fetch one more task to know whether there is a next page
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}}).
+		SetLimit(size + 1)
+
+	ctx := context.Background()
+	cur, err := m.tasks.Find(ctx, buildMongoTaskFilter(filter, after, asc), opts)
+	if err != nil {
+		log.ERROR.Print(err)
+		return nil, next, err
+	}
+	defer cur.Close(ctx)
+
+	var docs []*mongoTaskState
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		log.ERROR.Print(err)
+		return nil, next, err
+	}
+
+	if int64(len(docs)) > size {
+		docs = docs[:size]
+		last := docs[len(docs)-1]
+		next, err = encodeB64MongoCursor(&mongoCursor{CreatedAt: last.CreatedAt, TaskUUID: last.TaskUUID})
+		if err != nil {
+			log.ERROR.Println(err)
+			return nil, "", err
+		}
+	}
+
+	for _, doc := range docs {
+		taskStates = append(taskStates, doc.toTaskWithSignature())
+	}
+
+	return
+}
+
+// CountTasks :nodoc:
+func (m *Mongo) CountTasks(states []string) ([]*TaskCount, error) {
+	ctx := context.Background()
+	cur, err := m.tasks.Aggregate(ctx, buildMongoCountPipeline(states))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	defer cur.Close(ctx)
+
+	var docs []*mongoTaskCount
+	err = cur.All(ctx, &docs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode task counts: %w", err)
+	}
+
+	counts, byState := newTaskCounts(states)
+	for _, doc := range docs {
+		if count, ok := byState[doc.ID.State]; ok {
+			count.add(doc.ID.TaskName, doc.Count)
+		}
+	}
+
+	return counts, nil
+}
+
+// FindTaskByUUID :nodoc:
+func (m *Mongo) FindTaskByUUID(uuid string) (*TaskWithSignature, error) {
+	doc := &mongoTaskState{}
+	err := m.tasks.FindOne(context.Background(), bson.M{"_id": uuid}).Decode(doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("task %s %w", uuid, ErrNotFound)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get item %s: %w", uuid, err)
+		return nil, err
+	}
+
+	return doc.toTaskWithSignature(), nil
+}
+
+// FindGroupByUUID :nodoc:
+func (m *Mongo) FindGroupByUUID(groupUUID string) (*Group, error) {
+	meta := &tasks.GroupMeta{}
+	err := m.groupMetas.FindOne(context.Background(), bson.M{"_id": groupUUID}).Decode(meta)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("group %s %w", groupUUID, ErrNotFound)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get group %s: %w", groupUUID, err)
+		return nil, err
+	}
+
+	return newGroup(m, meta), nil
+}
+
+// RerunGroup :nodoc:
+func (m *Mongo) RerunGroup(groupUUID string, all bool) (*GroupRerunReport, error) {
+	return nil, ErrRerunUnsupported
+}
+
+// RerunTask :nodoc:
+func (m *Mongo) RerunTask(uuid string) error {
+	return ErrRerunUnsupported
+}
+
+// EditAndRerunTask :nodoc:
+func (m *Mongo) EditAndRerunTask(uuid string, edit *RerunEdit) error {
+	return ErrRerunUnsupported
+}
+
+// BulkRerunTasks :nodoc:
+func (m *Mongo) BulkRerunTasks(req *BulkRerunRequest) (*BulkRerunReport, error) {
+	return nil, ErrRerunUnsupported
+}
+
+// Find :nodoc:
+func (c *mongoDriverCollection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (mongoResultCursor, error) {
+	return c.Collection.Find(ctx, filter, opts...)
+}
+
+// FindOne :nodoc:
+func (c *mongoDriverCollection) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) mongoSingleResult {
+	return c.Collection.FindOne(ctx, filter, opts...)
+}
+
+// Aggregate :nodoc:
+func (c *mongoDriverCollection) Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (mongoResultCursor, error) {
+	return c.Collection.Aggregate(ctx, pipeline, opts...)
+}
+
+func (t *mongoTaskState) toTaskWithSignature() *TaskWithSignature {
+	task := &TaskWithSignature{
+		TaskUUID: t.TaskUUID,
+		State:    t.State,
+		TaskName: t.TaskName,
+		Error:    t.Error,
+		Results:  t.Results,
+	}
+
+	if !t.CreatedAt.IsZero() {
+		task.CreatedAt = t.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return task
+}
+
+func buildMongoTaskFilter(f *TaskFilter, after *mongoCursor, asc bool) bson.M {
+	filter := bson.M{"state": f.State}
+	switch {
+	case f.TaskName == "":
+	case f.TaskNamePrefix:
+		// anchored prefix regex is able to use the task_name index
+		filter["task_name"] = bson.M{"$regex": "^" + regexp.QuoteMeta(f.TaskName)}
+	default:
+		filter["task_name"] = f.TaskName
+	}
+
+	if f.ErrorContains != "" {
+		filter["error"] = bson.M{"$regex": regexp.QuoteMeta(f.ErrorContains)}
+	}
+
+	createdAt := bson.M{}
+	if !f.CreatedAfter.IsZero() {
+		createdAt["$gte"] = f.CreatedAfter
+	}
+	if !f.CreatedBefore.IsZero() {
+		createdAt["$lte"] = f.CreatedBefore
+	}
+	if len(createdAt) > 0 {
+		filter["created_at"] = createdAt
+	}
+
+	if after == nil {
+		return filter
+	}
+
+	op := "$lt"
+	if asc {
+		op = "$gt"
+	}
+
+	filter["$or"] = bson.A{
+		bson.M{"created_at": bson.M{op: after.CreatedAt}},
+		bson.M{"created_at": after.CreatedAt, "_id": bson.M{op: after.TaskUUID}},
+	}
+	return filter
+}
+
+// buildMongoCountPipeline count the tasks on the states grouped by state & task name
+func buildMongoCountPipeline(states []string) bson.A {
+	return bson.A{
+		bson.M{"$match": bson.M{"state": bson.M{"$in": states}}},
+		bson.M{"$group": bson.M{
+			"_id":   bson.M{"state": "$state", "task_name": "$task_name"},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+}
+
+func decodeB64MongoCursor(cursor string) (*mongoCursor, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	c := &mongoCursor{}
+	err = json.Unmarshal(decoded, c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal decoded cursor: %s: %w", decoded, err)
+	}
+
+	return c, nil
+}
+
+func encodeB64MongoCursor(c *mongoCursor) (string, error) {
+	bt, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(bt), nil
+}
@@ -0,0 +1,199 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// newMongoMock create Mongo dashboard backed by fakeMongoCollection, seeded with the documents
+// machinery writes: 5 FAILURE tasks (1-5) and 1 SUCCESS task (6) created one minute apart
+func newMongoMock() (*Mongo, *fakeMongoCollection) {
+	taskCollection := &fakeMongoCollection{}
+	for i := 1; i <= 6; i++ {
+		doc := bson.M{
+			"_id":        fmt.Sprint(i),
+			"state":      tasks.StateFailure,
+			"task_name":  "DLQTaskCreateComment",
+			"created_at": time.Date(2020, 12, 10, 7, i, 0, 0, time.UTC),
+			"error":      "gotcha",
+		}
+		if i == 6 {
+			doc["state"] = tasks.StateSuccess
+			doc["task_name"] = "DLQTaskCreateLike"
+			doc["results"] = bson.A{bson.M{"type": "int64", "value": int64(3)}}
+			delete(doc, "error")
+		}
+		taskCollection.insert(doc)
+	}
+
+	groupCollection := &fakeMongoCollection{}
+	groupCollection.insert(bson.M{
+		"_id":             "group_1",
+		"task_uuids":      bson.A{"1", "6"},
+		"chord_triggered": false,
+		"lock":            false,
+		"created_at":      time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC),
+	})
+
+	return &Mongo{tasks: taskCollection, groupMetas: groupCollection}, taskCollection
+}
+
+func Test_Mongo_FindAllTasks(t *testing.T) {
+	t.Run("newest first", func(t *testing.T) {
+		m, _ := newMongoMock()
+
+		res, next, err := m.FindAllTasks(&TaskFilter{State: tasks.StateFailure}, "", false, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"5", "4"}, taskUUIDs(res))
+		assert.Equal(t, "2020-12-10T07:05:00Z", res[0].CreatedAt)
+		assert.Equal(t, "gotcha", res[0].Error)
+		assert.Empty(t, res[0].Signature, "machinery doesn't store the signature")
+		require.NotEmpty(t, next)
+
+		res, next, err = m.FindAllTasks(&TaskFilter{State: tasks.StateFailure}, next, false, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"3", "2"}, taskUUIDs(res))
+
+		res, next, err = m.FindAllTasks(&TaskFilter{State: tasks.StateFailure}, next, false, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1"}, taskUUIDs(res))
+		assert.Empty(t, next)
+	})
+
+	t.Run("oldest first with filter", func(t *testing.T) {
+		m, _ := newMongoMock()
+
+		res, next, err := m.FindAllTasks(&TaskFilter{
+			State:          tasks.StateFailure,
+			TaskName:       "DLQTask",
+			TaskNamePrefix: true,
+			CreatedAfter:   time.Date(2020, 12, 10, 7, 2, 0, 0, time.UTC),
+			CreatedBefore:  time.Date(2020, 12, 10, 7, 4, 0, 0, time.UTC),
+		}, "", true, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2", "3", "4"}, taskUUIDs(res))
+		assert.Empty(t, next)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		m, _ := newMongoMock()
+
+		_, _, err := m.FindAllTasks(&TaskFilter{State: tasks.StateFailure}, "invalid", true, 10)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		m, collection := newMongoMock()
+		collection.err = errors.New("gotcha")
+
+		_, _, err := m.FindAllTasks(&TaskFilter{State: tasks.StateFailure}, "", true, 10)
+		assert.Error(t, err)
+	})
+}
+
+func Test_Mongo_FindTaskByUUID(t *testing.T) {
+	m, _ := newMongoMock()
+
+	task, err := m.FindTaskByUUID("6")
+	require.NoError(t, err)
+	assert.Equal(t, tasks.StateSuccess, task.State)
+	assert.Equal(t, "DLQTaskCreateLike", task.TaskName)
+	assert.Equal(t, "2020-12-10T07:06:00Z", task.CreatedAt)
+	assert.Equal(t, []*tasks.TaskResult{{Type: "int64", Value: int64(3)}}, task.Results)
+	assert.True(t, errors.Is(task.UnmarshalSignature(&tasks.Signature{}), ErrNoSignature))
+
+	_, err = m.FindTaskByUUID("99")
+	assert.True(t, errors.Is(err, ErrNotFound))
+
+	group, err := m.FindGroupByUUID("group_1")
+	require.NoError(t, err)
+	require.Len(t, group.Tasks, 2)
+	assert.Equal(t, tasks.StateSuccess, group.Tasks[1].Task.State)
+}
+
+func Test_Mongo_CountTasks(t *testing.T) {
+	m, _ := newMongoMock()
+
+	counts, err := m.CountTasks([]string{tasks.StateFailure, tasks.StateSuccess, tasks.StatePending})
+	require.NoError(t, err)
+	require.Len(t, counts, 3)
+	assert.Equal(t, int64(5), counts[0].Total)
+	assert.Equal(t, map[string]int64{"DLQTaskCreateComment": 5}, counts[0].ByTaskName)
+	assert.Equal(t, int64(1), counts[1].Total)
+	assert.Equal(t, int64(0), counts[2].Total)
+}
+
+func Test_Mongo_Rerun(t *testing.T) {
+	m, _ := newMongoMock()
+
+	assert.True(t, errors.Is(m.RerunTask("1"), ErrRerunUnsupported))
+	assert.True(t, errors.Is(m.EditAndRerunTask("1", &RerunEdit{}), ErrRerunUnsupported))
+
+	_, err := m.BulkRerunTasks(&BulkRerunRequest{UUIDs: []string{"1"}})
+	assert.True(t, errors.Is(err, ErrRerunUnsupported))
+
+	_, err = m.RerunGroup("group_1", false)
+	assert.True(t, errors.Is(err, ErrRerunUnsupported))
+
+	_, err = RerunTaskWithEdit(m, "1", nil, true)
+	assert.True(t, errors.Is(err, ErrRerunUnsupported))
+
+	_, err = BuildWorkflowGraph(m, "1", "")
+	assert.True(t, errors.Is(err, ErrNoSignature))
+}
+
+func Test_buildMongoTaskFilter(t *testing.T) {
+	t.Run("first page", func(t *testing.T) {
+		filter := buildMongoTaskFilter(&TaskFilter{State: tasks.StateFailure}, nil, true)
+		assert.Equal(t, bson.M{"state": tasks.StateFailure}, filter)
+	})
+
+	t.Run("exact task name", func(t *testing.T) {
+		filter := buildMongoTaskFilter(&TaskFilter{State: tasks.StateFailure, TaskName: "DLQTaskCreateComment"}, nil, true)
+		assert.Equal(t, bson.M{"state": tasks.StateFailure, "task_name": "DLQTaskCreateComment"}, filter)
+	})
+
+	t.Run("task name prefix", func(t *testing.T) {
+		filter := buildMongoTaskFilter(&TaskFilter{State: tasks.StateFailure, TaskName: "DLQ.Task", TaskNamePrefix: true}, nil, true)
+		assert.Equal(t, bson.M{"$regex": `^DLQ\.Task`}, filter["task_name"])
+	})
+
+	t.Run("created at range", func(t *testing.T) {
+		from := time.Date(2020, 12, 10, 0, 0, 0, 0, time.UTC)
+		to := from.Add(time.Hour)
+
+		filter := buildMongoTaskFilter(&TaskFilter{State: tasks.StateFailure, CreatedAfter: from, CreatedBefore: to}, nil, true)
+		assert.Equal(t, bson.M{"$gte": from, "$lte": to}, filter["created_at"])
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		after := &mongoCursor{CreatedAt: time.Now(), TaskUUID: "3"}
+
+		filter := buildMongoTaskFilter(&TaskFilter{State: tasks.StateFailure}, after, false)
+		assert.Equal(t, bson.A{
+			bson.M{"created_at": bson.M{"$lt": after.CreatedAt}},
+			bson.M{"created_at": after.CreatedAt, "_id": bson.M{"$lt": "3"}},
+		}, filter["$or"])
+	})
+}
+
+func Test_mongoCursor(t *testing.T) {
+	c := &mongoCursor{CreatedAt: time.Date(2020, 12, 10, 7, 53, 14, 0, time.UTC), TaskUUID: "3"}
+
+	encoded, err := encodeB64MongoCursor(c)
+	assert.NoError(t, err)
+
+	decoded, err := decodeB64MongoCursor(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, c, decoded)
+
+	_, err = decodeB64MongoCursor("invalid")
+	assert.Error(t, err)
+}
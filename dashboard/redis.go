@@ -0,0 +1,377 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/RichardKnop/machinery/v1"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/log"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// redisScanCount is the SCAN count hint, much larger than a page so sparse states take few round trips
+	redisScanCount = 1000
+	// maxRedisScans bound the SCAN iterations made to fill a single page,
+	// the page may contain less than size tasks when the matching tasks are sparse
+	maxRedisScans = 10
+	// redisCursorSeparator separate the SCAN cursor from the last key returned from its keys
+	redisCursorSeparator = ":"
+)
+
+// Redis monitor tasks stored by machinery redis result backend
+type Redis struct {
+	cnf    *config.Config
+	client redisClient
+	server machineryServer
+}
+
+// NewRedis :nodoc:
+func NewRedis(cnf *config.Config, srv machineryServer) (Dashboard, error) {
+	opt, err := parseRedisResultBackend(cnf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redis{
+		cnf:    cnf,
+		client: redis.NewUniversalClient(opt),
+		server: srv,
+	}, nil
+}
+
+// parseRedisResultBackend build redis options the same way machinery does,
+// e.g. redis://pwd@host/db or redis://pwd@host1,host2 for sentinel, redis cluster is rejected
+func parseRedisResultBackend(cnf *config.Config) (*redis.UniversalOptions, error) {
+	scheme := "redis://"
+	if strings.HasPrefix(cnf.ResultBackend, "rediss://") {
+		scheme = "rediss://"
+	}
+
+	parts := strings.SplitN(cnf.ResultBackend, scheme, 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid redis result backend: %s", cnf.ResultBackend)
+	}
+
+	opt := &redis.UniversalOptions{}
+	if cnf.Redis != nil {
+		opt.MasterName = cnf.Redis.MasterName
+	}
+	if scheme == "rediss://" {
+		opt.TLSConfig = cnf.TLSConfig
+	}
+
+	addrs := strings.Split(parts[1], ",")
+	if len(addrs) > 1 {
+		// without a master name the universal client is a cluster client,
+		// SCAN only walks a single node there and MGET fails on keys of different slots
+		if opt.MasterName == "" {
+			return nil, errors.New("redis cluster result backend is not supported, use a single address or a sentinel master name")
+		}
+
+		auth := strings.Split(addrs[0], "@")
+		if len(auth) == 2 {
+			opt.Password = auth[0]
+			addrs[0] = auth[1]
+		}
+		opt.Addrs = addrs
+		return opt, nil
+	}
+
+	host, password, db, err := machinery.ParseRedisURL(cnf.ResultBackend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis url: %w", err)
+	}
+
+	opt.Addrs = []string{host}
+	opt.Password = password
+	opt.DB = db
+	return opt, nil
+}
+
+// FindAllTasksByState :nodoc:
+func (r *Redis) FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
+	return r.FindAllTasks(&TaskFilter{State: state}, cursor, asc, size)
+}
+
+// FindAllTasks :nodoc:
+// machinery stores each task state in its own key, so the keys are iterated using SCAN, up to maxRedisScans per page.
+// The cursor is the SCAN cursor, followed by the last key returned when the page ends within its keys.
+// The keys are not sorted, so the tasks can't be listed newest first
+func (r *Redis) FindAllTasks(filter *TaskFilter, cursor string, asc bool, size int64) (taskStates []*TaskWithSignature, next string, err error) {
+	if size <= 0 {
+		size = 10
+	}
+
+	if !asc {
+		return nil, next, ErrSortUnsupported
+	}
+
+	scanCursor, after, err := parseRedisCursor(cursor)
+	if err != nil {
+		log.ERROR.Println(err)
+		return nil, next, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+
+	ctx := context.Background()
+	for i := 0; i < maxRedisScans; i++ {
+		keys, nextCursor, err := r.client.Scan(ctx, scanCursor, "*", redisScanCount).Result()
+		if err != nil {
+			log.ERROR.Print(err)
+			return nil, "", err
+		}
+
+		states, err := r.findTaskStatesByKeys(ctx, keysAfter(keys, after))
+		if err != nil {
+			log.ERROR.Print(err)
+			return nil, "", err
+		}
+
+		for j, ts := range states {
+			if ts.State != filter.State {
+				continue
+			}
+
+			task, err := newTaskWithSignature(ts)
+			if err != nil {
+				log.ERROR.Print(err)
+				return nil, "", err
+			}
+			if !filter.Match(task) {
+				continue
+			}
+			taskStates = append(taskStates, task)
+
+			if int64(len(taskStates)) >= size && j < len(states)-1 {
+				// machinery keys the task states by UUID, the next page continue after it on the same keys
+				return taskStates, strconv.FormatUint(scanCursor, 10) + redisCursorSeparator + ts.TaskUUID, nil
+			}
+		}
+
+		scanCursor, after = nextCursor, ""
+		if scanCursor == 0 {
+			return taskStates, "", nil
+		}
+
+		if int64(len(taskStates)) >= size {
+			break
+		}
+	}
+
+	return taskStates, strconv.FormatUint(scanCursor, 10), nil
+}
+
+// parseRedisCursor split the cursor into the SCAN cursor and the last key returned from its keys, if any
+func parseRedisCursor(cursor string) (scanCursor uint64, after string, err error) {
+	if cursor == "" {
+		return 0, "", nil
+	}
+
+	parts := strings.SplitN(cursor, redisCursorSeparator, 2)
+	scanCursor, err = strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(parts) == 2 {
+		after = parts[1]
+	}
+	return scanCursor, after, nil
+}
+
+// keysAfter drop the keys up to the given key. SCAN returns the same keys for the same cursor unless the keyspace
+// changed, so the keys are kept as they are when the key is gone e.g. expired
+func keysAfter(keys []string, after string) []string {
+	if after == "" {
+		return keys
+	}
+
+	for i, key := range keys {
+		if key == after {
+			return keys[i+1:]
+		}
+	}
+	return keys
+}
+
+// CountTasks :nodoc:
+// every key is scanned, so the counts should be cached, see NewCountCache
+func (r *Redis) CountTasks(states []string) ([]*TaskCount, error) {
+	counts, byState := newTaskCounts(states)
+
+	ctx := context.Background()
+	var cursor uint64
+	for {
+		keys, next, err := r.client.Scan(ctx, cursor, "*", redisScanCount).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan: %w", err)
+		}
+
+		taskStates, err := r.findTaskStatesByKeys(ctx, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ts := range taskStates {
+			count, ok := byState[ts.State]
+			if !ok {
+				continue
+			}
+
+			taskName := ts.TaskName
+			if taskName == "" && ts.Signature != nil {
+				taskName = ts.Signature.Name
+			}
+			count.add(taskName, 1)
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return counts, nil
+		}
+	}
+}
+
+// FindTaskByUUID :nodoc:
+func (r *Redis) FindTaskByUUID(uuid string) (*TaskWithSignature, error) {
+	bt, err := r.client.Get(context.Background(), uuid).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("task %s %w", uuid, ErrNotFound)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get item %s: %w", uuid, err)
+		return nil, err
+	}
+
+	state, err := decodeRedisTaskState(bt)
+	if err != nil {
+		err = fmt.Errorf("failed to unmarshal: %w", err)
+		return nil, err
+	}
+
+	return newTaskWithSignature(state)
+}
+
+// FindGroupByUUID :nodoc:
+func (r *Redis) FindGroupByUUID(groupUUID string) (*Group, error) {
+	bt, err := r.client.Get(context.Background(), groupUUID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("group %s %w", groupUUID, ErrNotFound)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to get group %s: %w", groupUUID, err)
+		return nil, err
+	}
+
+	meta := &tasks.GroupMeta{}
+	err = json.Unmarshal(bt, meta)
+	if err != nil {
+		err = fmt.Errorf("failed to unmarshal: %w", err)
+		return nil, err
+	}
+
+	return newGroup(r, meta), nil
+}
+
+// RerunGroup :nodoc:
+func (r *Redis) RerunGroup(groupUUID string, all bool) (*GroupRerunReport, error) {
+	return rerunGroup(r, r.server, r, groupUUID, all)
+}
+
+// setChordTriggered rewrite the group meta keeping its expiration like machinery does
+func (r *Redis) setChordTriggered(groupUUID string, triggered bool) error {
+	ctx := context.Background()
+	bt, err := r.client.Get(ctx, groupUUID).Bytes()
+	if err != nil {
+		return fmt.Errorf("failed to get group %s: %w", groupUUID, err)
+	}
+
+	meta := &tasks.GroupMeta{}
+	err = json.Unmarshal(bt, meta)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal: %w", err)
+	}
+	meta.ChordTriggered = triggered
+	meta.Lock = false
+
+	bt, err = json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	ttl, err := r.client.TTL(ctx, groupUUID).Result()
+	if err != nil {
+		return fmt.Errorf("failed to get ttl of group %s: %w", groupUUID, err)
+	}
+	if ttl < 0 {
+		ttl = 0 // no expiration
+	}
+
+	err = r.client.Set(ctx, groupUUID, bt, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to update group %s: %w", groupUUID, err)
+	}
+	return nil
+}
+
+// RerunTask :nodoc:
+func (r *Redis) RerunTask(uuid string) error {
+	return rerunTask(r, r.server, uuid)
+}
+
+// EditAndRerunTask :nodoc:
+func (r *Redis) EditAndRerunTask(uuid string, edit *RerunEdit) error {
+	return editAndRerunTask(r, r.server, uuid, edit)
+}
+
+// BulkRerunTasks :nodoc:
+func (r *Redis) BulkRerunTasks(req *BulkRerunRequest) (*BulkRerunReport, error) {
+	return bulkRerunTasks(r, req)
+}
+
+// findTaskStatesByKeys fetch the keys at once, skipping the ones which are not task states
+// e.g. broker queues or group metas
+func (r *Redis) findTaskStatesByKeys(ctx context.Context, keys []string) ([]*tasks.TaskState, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to get items: %w", err)
+	}
+
+	var states []*tasks.TaskState
+	for _, val := range values {
+		str, ok := val.(string)
+		if !ok {
+			continue // non string keys & expired keys are returned as nil
+		}
+
+		state, err := decodeRedisTaskState([]byte(str))
+		if err != nil || state.TaskUUID == "" {
+			continue
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+func decodeRedisTaskState(bt []byte) (*tasks.TaskState, error) {
+	state := &tasks.TaskState{}
+	dec := json.NewDecoder(bytes.NewReader(bt))
+	dec.UseNumber()
+	err := dec.Decode(state)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
@@ -0,0 +1,191 @@
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRedisMock() *Redis {
+	return &Redis{
+		cnf: &config.Config{},
+		client: &redisClientMock{
+			items: map[string]string{
+				"task_1":           `{"TaskUUID":"task_1","TaskName":"Foo","State":"FAILURE","Error":"gotcha","Signature":` + jsonSignature + `}`,
+				"task_2":           `{"TaskUUID":"task_2","TaskName":"Foo","State":"SUCCESS"}`,
+				"task_3":           `{"TaskUUID":"task_3","TaskName":"Bar","State":"FAILURE","CreatedAt":"2020-12-10T07:53:14.436882456Z"}`,
+				"task_4":           `{"TaskUUID":"task_4","TaskName":"Bar","State":"FAILURE"}`,
+				"group_1":          `{"GroupUUID":"group_1","TaskUUIDs":["task_1","task_2"]}`,
+				"worker-namespace": `not a json`,
+			},
+		},
+		server: &machineryServerMock{},
+	}
+}
+
+func Test_Redis_FindAllTasksByState(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, cursor, err := r.FindAllTasksByState(tasks.StateFailure, "", true, 2)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, cursor)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "task_1", res[0].TaskUUID)
+		assert.Equal(t, "gotcha", res[0].Error)
+		assert.NotEmpty(t, res[0].Signature)
+		assert.Equal(t, "task_3", res[1].TaskUUID)
+		assert.Equal(t, "2020-12-10T07:53:14.436882456Z", res[1].CreatedAt)
+
+		res, cursor, err = r.FindAllTasksByState(tasks.StateFailure, cursor, true, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, cursor)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, "task_4", res[0].TaskUUID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, cursor, err := r.FindAllTasksByState(tasks.StatePending, "", true, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		assert.Empty(t, cursor)
+	})
+
+	t.Run("bound the scans of a page", func(t *testing.T) {
+		r := newRedisMock()
+		client := r.client.(*redisClientMock)
+		for i := 0; i < maxRedisScans*redisScanCount; i++ {
+			uuid := fmt.Sprintf("task_0%05d", i)
+			client.items[uuid] = `{"TaskUUID":"` + uuid + `","TaskName":"Foo","State":"SUCCESS"}`
+		}
+
+		res, cursor, err := r.FindAllTasksByState(tasks.StateFailure, "", true, 2)
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		assert.NotEmpty(t, cursor, "the rest of the keys are scanned by the next page")
+		assert.Equal(t, maxRedisScans, client.scans)
+
+		res, cursor, err = r.FindAllTasksByState(tasks.StateFailure, cursor, true, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"task_1", "task_3"}, taskUUIDs(res))
+		assert.Equal(t, "10000:task_3", cursor, "the next page continue on the same keys")
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		r := newRedisMock()
+
+		_, _, err := r.FindAllTasksByState(tasks.StateFailure, "abc", true, 10)
+		assert.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+
+	t.Run("descending is not supported", func(t *testing.T) {
+		r := newRedisMock()
+
+		_, _, err := r.FindAllTasksByState(tasks.StateFailure, "", false, 10)
+		assert.True(t, errors.Is(err, ErrSortUnsupported))
+	})
+}
+
+func Test_Redis_FindAllTasks(t *testing.T) {
+	t.Run("exact task name", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, _, err := r.FindAllTasks(&TaskFilter{State: tasks.StateFailure, TaskName: "Bar"}, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"task_3", "task_4"}, taskUUIDs(res))
+	})
+
+	t.Run("task name prefix", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, _, err := r.FindAllTasks(&TaskFilter{State: tasks.StateFailure, TaskName: "F", TaskNamePrefix: true}, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"task_1"}, taskUUIDs(res))
+	})
+
+	t.Run("created at range", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, _, err := r.FindAllTasks(&TaskFilter{State: tasks.StateFailure, CreatedAfter: time.Date(2020, 12, 10, 0, 0, 0, 0, time.UTC)}, "", true, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"task_3"}, taskUUIDs(res))
+	})
+}
+
+func Test_Redis_FindTaskByUUID(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, err := r.FindTaskByUUID("task_1")
+		assert.NoError(t, err)
+		assert.Equal(t, "task_1", res.TaskUUID)
+		assert.Equal(t, tasks.StateFailure, res.State)
+
+		sig := &tasks.Signature{}
+		assert.NoError(t, res.UnmarshalSignature(sig))
+		assert.Equal(t, "DLQTaskCreateComment", sig.Name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		r := newRedisMock()
+
+		res, err := r.FindTaskByUUID("task_99")
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Nil(t, res)
+	})
+}
+
+func Test_Redis_Rerun(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := newRedisMock()
+
+		err := r.RerunTask("task_1")
+		assert.NoError(t, err)
+
+		sent := r.server.(*machineryServerMock).sent
+		assert.Equal(t, 1, len(sent))
+		assert.Equal(t, "3", sent[0].UUID)
+		assert.Nil(t, sent[0].ETA)
+	})
+
+	t.Run("handle SendTask error", func(t *testing.T) {
+		r := newRedisMock()
+		r.server = &machineryServerMock{err: errors.New("gotcha")}
+
+		err := r.RerunTask("task_1")
+		assert.Error(t, err)
+	})
+}
+
+func Test_parseRedisResultBackend(t *testing.T) {
+	t.Run("single host", func(t *testing.T) {
+		opt, err := parseRedisResultBackend(&config.Config{ResultBackend: "redis://pwd@localhost:6379/4"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"localhost:6379"}, opt.Addrs)
+		assert.Equal(t, "pwd", opt.Password)
+		assert.Equal(t, 4, opt.DB)
+	})
+
+	t.Run("sentinel", func(t *testing.T) {
+		opt, err := parseRedisResultBackend(&config.Config{
+			ResultBackend: "redis://pwd@host1:26379,host2:26379",
+			Redis:         &config.RedisConfig{MasterName: "master"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"host1:26379", "host2:26379"}, opt.Addrs)
+		assert.Equal(t, "pwd", opt.Password)
+		assert.Equal(t, "master", opt.MasterName)
+	})
+
+	t.Run("cluster is not supported", func(t *testing.T) {
+		_, err := parseRedisResultBackend(&config.Config{ResultBackend: "redis://pwd@host1:6379,host2:6379"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,170 @@
+package dashboard
+
+import (
+	"errors"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+)
+
+// maxWorkflowNodes limit the tasks fetched to build a single workflow graph
+const maxWorkflowNodes = 100
+
+// list of relations between a workflow node and the viewed task
+const (
+	RelationSelf          = "self"
+	RelationParent        = "parent"
+	RelationOnSuccess     = "on_success"
+	RelationOnError       = "on_error"
+	RelationSibling       = "sibling"
+	RelationChordCallback = "chord_callback"
+)
+
+// WorkflowGraph is the workflow around a task, the callbacks are nested on the nodes
+type WorkflowGraph struct {
+	Root *WorkflowNode `json:"root"`
+	// Parent is only known when the task is reached from its parent since signatures don't link back
+	Parent        *WorkflowNode   `json:"parent,omitempty"`
+	GroupUUID     string          `json:"group_uuid,omitempty"`
+	Siblings      []*WorkflowNode `json:"siblings,omitempty"`
+	ChordCallback *WorkflowNode   `json:"chord_callback,omitempty"`
+}
+
+// WorkflowNode state is empty when the task hasn't been sent yet
+type WorkflowNode struct {
+	TaskUUID string `json:"task_uuid"`
+	TaskName string `json:"task_name"`
+	State    string `json:"state"`
+	Relation string `json:"relation"`
+	// ParentUUID is the task owning the callback
+	ParentUUID string          `json:"parent_uuid,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Truncated  bool            `json:"truncated,omitempty"`
+	Children   []*WorkflowNode `json:"children,omitempty"`
+}
+
+// StoppedAt follow the on success callbacks from the root and return the first task which hasn't succeeded,
+// nil when the whole chain succeeded
+func (g *WorkflowGraph) StoppedAt() *WorkflowNode {
+	node := g.Root
+	for node != nil {
+		if node.State != tasks.StateSuccess {
+			return node
+		}
+
+		var next *WorkflowNode
+		for _, child := range node.Children {
+			if child.Relation == RelationOnSuccess {
+				next = child
+				break
+			}
+		}
+		node = next
+	}
+	return nil
+}
+
+type workflowBuilder struct {
+	d     Dashboard
+	count int
+}
+
+// BuildWorkflowGraph build the workflow around the task, the state of each node is fetched
+// through the dashboard, parentUUID is optional
+func BuildWorkflowGraph(d Dashboard, uuid, parentUUID string) (*WorkflowGraph, error) {
+	task, err := d.FindTaskByUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &tasks.Signature{}
+	err = task.UnmarshalSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &workflowBuilder{d: d}
+	graph := &WorkflowGraph{
+		Root:      b.node(sig, RelationSelf),
+		GroupUUID: sig.GroupUUID,
+	}
+
+	if parentUUID != "" {
+		graph.Parent = &WorkflowNode{TaskUUID: parentUUID, Relation: RelationParent}
+		b.load(graph.Parent)
+	}
+
+	chordCallback := sig.ChordCallback
+	if sig.GroupUUID != "" {
+		group, err := d.FindGroupByUUID(sig.GroupUUID)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			// the group meta may have expired, the graph is built without the siblings
+		case err != nil:
+			return nil, err
+		default:
+			for _, gt := range group.Tasks {
+				if gt.TaskUUID == uuid {
+					continue
+				}
+
+				sibling := &WorkflowNode{TaskUUID: gt.TaskUUID, Relation: RelationSibling, Error: gt.Error}
+				if gt.Task != nil {
+					sibling.TaskName = gt.Task.TaskName
+					sibling.State = gt.Task.State
+				}
+				graph.Siblings = append(graph.Siblings, sibling)
+			}
+
+			if chordCallback == nil {
+				chordCallback = group.ChordCallback
+			}
+		}
+	}
+
+	if chordCallback != nil {
+		graph.ChordCallback = b.node(chordCallback, RelationChordCallback)
+	}
+
+	return graph, nil
+}
+
+// node build the node of the signature with its callbacks
+func (b *workflowBuilder) node(sig *tasks.Signature, relation string) *WorkflowNode {
+	n := &WorkflowNode{TaskUUID: sig.UUID, TaskName: sig.Name, Relation: relation}
+	if b.count >= maxWorkflowNodes {
+		n.Truncated = true
+		return n
+	}
+	b.load(n)
+
+	for _, cb := range sig.OnSuccess {
+		child := b.node(cb, RelationOnSuccess)
+		child.ParentUUID = sig.UUID
+		n.Children = append(n.Children, child)
+	}
+	for _, cb := range sig.OnError {
+		child := b.node(cb, RelationOnError)
+		child.ParentUUID = sig.UUID
+		n.Children = append(n.Children, child)
+	}
+	return n
+}
+
+// load fill the node with the current task state
+func (b *workflowBuilder) load(n *WorkflowNode) {
+	b.count++
+
+	task, err := b.d.FindTaskByUUID(n.TaskUUID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return
+	case err != nil:
+		n.Error = err.Error()
+		return
+	}
+
+	n.State = task.State
+	if task.TaskName != "" {
+		n.TaskName = task.TaskName
+	}
+}
@@ -0,0 +1,92 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newChainDynamoDBMock add the chain A (10) -> B (11) -> C (12) with E (13) as the error callback of A,
+// A succeeded, B failed, C & E are never sent
+func newChainDynamoDBMock() (*DynamoDB, *fakeDynamoDB) {
+	dyn, client := newDynamoDBMock()
+	client.putTask(taskTable, &TaskWithSignature{
+		TaskUUID:  "10",
+		State:     tasks.StateSuccess,
+		TaskName:  "A",
+		Signature: `{"UUID":"10","Name":"A","OnSuccess":[{"UUID":"11","Name":"B","OnSuccess":[{"UUID":"12","Name":"C"}]}],"OnError":[{"UUID":"13","Name":"E"}]}`,
+	})
+	client.putTask(taskTable, &TaskWithSignature{
+		TaskUUID:  "11",
+		State:     tasks.StateFailure,
+		TaskName:  "B",
+		Signature: `{"UUID":"11","Name":"B","OnSuccess":[{"UUID":"12","Name":"C"}]}`,
+	})
+	return dyn, client
+}
+
+func Test_BuildWorkflowGraph(t *testing.T) {
+	t.Run("chain", func(t *testing.T) {
+		dyn, _ := newChainDynamoDBMock()
+
+		graph, err := BuildWorkflowGraph(dyn, "10", "")
+		require.NoError(t, err)
+		assert.Nil(t, graph.Parent)
+		assert.Empty(t, graph.Siblings)
+		assert.Nil(t, graph.ChordCallback)
+
+		root := graph.Root
+		assert.Equal(t, &WorkflowNode{TaskUUID: "10", TaskName: "A", State: tasks.StateSuccess, Relation: RelationSelf, Children: root.Children}, root)
+		require.Len(t, root.Children, 2)
+		assert.Equal(t, "11", root.Children[0].TaskUUID)
+		assert.Equal(t, tasks.StateFailure, root.Children[0].State)
+		assert.Equal(t, RelationOnSuccess, root.Children[0].Relation)
+		assert.Equal(t, "10", root.Children[0].ParentUUID)
+		assert.Equal(t, &WorkflowNode{TaskUUID: "12", TaskName: "C", Relation: RelationOnSuccess, ParentUUID: "11"}, root.Children[0].Children[0])
+		assert.Equal(t, &WorkflowNode{TaskUUID: "13", TaskName: "E", Relation: RelationOnError, ParentUUID: "10"}, root.Children[1])
+
+		assert.Equal(t, "11", graph.StoppedAt().TaskUUID)
+	})
+
+	t.Run("with parent", func(t *testing.T) {
+		dyn, _ := newChainDynamoDBMock()
+
+		graph, err := BuildWorkflowGraph(dyn, "11", "10")
+		require.NoError(t, err)
+		assert.Equal(t, &WorkflowNode{TaskUUID: "10", TaskName: "A", State: tasks.StateSuccess, Relation: RelationParent}, graph.Parent)
+		assert.Equal(t, "11", graph.StoppedAt().TaskUUID)
+	})
+
+	t.Run("chord", func(t *testing.T) {
+		dyn, _ := newChordDynamoDBMock(false)
+
+		graph, err := BuildWorkflowGraph(dyn, "7", "")
+		require.NoError(t, err)
+		assert.Equal(t, "group_1", graph.GroupUUID)
+		require.Len(t, graph.Siblings, 2)
+		assert.Equal(t, &WorkflowNode{TaskUUID: "1", TaskName: "DLQTaskCreateComment", State: tasks.StateFailure, Relation: RelationSibling}, graph.Siblings[0])
+		assert.Equal(t, &WorkflowNode{TaskUUID: "8", TaskName: "Bar", Relation: RelationChordCallback}, graph.ChordCallback)
+		assert.Nil(t, graph.StoppedAt())
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		dyn, client := newDynamoDBMock()
+		client.putTask(taskTable, &TaskWithSignature{TaskUUID: "10", State: tasks.StateSuccess, Signature: `{"UUID":"10","Name":"A"}`})
+
+		b := &workflowBuilder{d: dyn, count: maxWorkflowNodes}
+		node := b.node(&tasks.Signature{UUID: "10", OnSuccess: []*tasks.Signature{{UUID: "11"}}}, RelationSelf)
+		assert.True(t, node.Truncated)
+		assert.Empty(t, node.State)
+		assert.Empty(t, node.Children)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		dyn, _ := newDynamoDBMock()
+
+		_, err := BuildWorkflowGraph(dyn, "99", "")
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+}
@@ -0,0 +1,378 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultMigrationPollInterval = 5 * time.Second
+	defaultMigrationTimeout      = 30 * time.Minute
+	ttlAttributeName             = "TTL"
+)
+
+type dynamoDBMigrationClient interface {
+	DescribeTable(*dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+	CreateTable(*dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
+	UpdateTable(*dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error)
+	DescribeTimeToLive(*dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error)
+	UpdateTimeToLive(*dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error)
+}
+
+// indexSpec global secondary index needed by the dashboard, the attributes are strings
+type indexSpec struct {
+	name     string
+	hashKey  string
+	rangeKey string
+}
+
+var (
+	// stateIndex list the tasks by state, sorted by created at
+	stateIndex = &indexSpec{name: tasks.TaskStateIndex, hashKey: "State", rangeKey: "CreatedAt"}
+
+	taskTableKey  = &indexSpec{hashKey: "TaskUUID"}
+	groupTableKey = &indexSpec{hashKey: "GroupUUID"}
+	// auditTableKey see audit.DynamoDBStore
+	auditTableKey = &indexSpec{hashKey: "Log", rangeKey: "SortKey"}
+	// rerunLogTableKey see rerunlog.DynamoDBStore
+	rerunLogTableKey = &indexSpec{hashKey: "UUID", rangeKey: "SortKey"}
+)
+
+// MigrationStep a single schema change, the table is waited to be ACTIVE after it is applied
+type MigrationStep struct {
+	Description string
+	table       string
+	apply       func() error
+}
+
+// DynamoDBMigration create the task & group tables machinery expects,
+// with the indexes & TTL needed by the dashboard. An empty table name is skipped
+type DynamoDBMigration struct {
+	client     dynamoDBMigrationClient
+	taskTable  string
+	groupTable string
+
+	// AuditTable is optional, it is created without TTL since the audit entries are kept
+	AuditTable string
+	// RerunLogTable is optional, the records expire by TTL
+	RerunLogTable string
+
+	// PollInterval & Timeout of waiting for the table & indexes to be ACTIVE
+	PollInterval time.Duration
+	Timeout      time.Duration
+}
+
+// NewDynamoDBMigration :nodoc:
+func NewDynamoDBMigration(client dynamoDBMigrationClient, taskTable, groupTable string) *DynamoDBMigration {
+	return &DynamoDBMigration{
+		client:       client,
+		taskTable:    taskTable,
+		groupTable:   groupTable,
+		PollInterval: defaultMigrationPollInterval,
+		Timeout:      defaultMigrationTimeout,
+	}
+}
+
+// Plan describe the tables and list the steps to migrate them, nothing is changed
+func (m *DynamoDBMigration) Plan() ([]*MigrationStep, error) {
+	var steps []*MigrationStep
+	if m.taskTable != "" {
+		taskSteps, err := m.planTable(m.taskTable, taskTableKey, true, stateIndex)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, taskSteps...)
+	}
+
+	if m.groupTable != "" {
+		groupSteps, err := m.planTable(m.groupTable, groupTableKey, true)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, groupSteps...)
+	}
+
+	if m.AuditTable != "" {
+		auditSteps, err := m.planTable(m.AuditTable, auditTableKey, false)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, auditSteps...)
+	}
+
+	if m.RerunLogTable != "" {
+		rerunLogSteps, err := m.planTable(m.RerunLogTable, rerunLogTableKey, true)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, rerunLogSteps...)
+	}
+	return steps, nil
+}
+
+// Apply the steps in order, waiting for the table & indexes to be ACTIVE after each step
+func (m *DynamoDBMigration) Apply(steps []*MigrationStep) error {
+	for _, step := range steps {
+		logrus.Info(step.Description)
+		if err := step.apply(); err != nil {
+			return fmt.Errorf("failed to %s: %w", step.Description, err)
+		}
+
+		if err := m.waitActive(step.table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planTable the table key is only used to create the table, the key of an existing table can't be changed
+func (m *DynamoDBMigration) planTable(table string, key *indexSpec, ttl bool, indexes ...*indexSpec) ([]*MigrationStep, error) {
+	desc, err := m.describeTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	if desc == nil {
+		steps := []*MigrationStep{m.createTableStep(table, key, indexes)}
+		if ttl {
+			steps = append(steps, m.enableTTLStep(table))
+		}
+		return steps, nil
+	}
+
+	var steps []*MigrationStep
+	for _, index := range indexes {
+		steps = append(steps, m.planIndex(desc, index)...)
+	}
+
+	if !ttl {
+		return steps, nil
+	}
+
+	ttlDesc, err := m.client.DescribeTimeToLive(&dynamodb.DescribeTimeToLiveInput{TableName: aws.String(table)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe ttl of table %s: %w", table, err)
+	}
+
+	switch aws.StringValue(ttlDesc.TimeToLiveDescription.TimeToLiveStatus) {
+	case dynamodb.TimeToLiveStatusEnabled, dynamodb.TimeToLiveStatusEnabling:
+	default:
+		steps = append(steps, m.enableTTLStep(table))
+	}
+
+	return steps, nil
+}
+
+// planIndex create the missing index, an index with different keys is deleted first since its keys can't be updated
+func (m *DynamoDBMigration) planIndex(desc *dynamodb.TableDescription, index *indexSpec) []*MigrationStep {
+	table := aws.StringValue(desc.TableName)
+	for _, gsi := range desc.GlobalSecondaryIndexes {
+		if aws.StringValue(gsi.IndexName) != index.name {
+			continue
+		}
+
+		if index.matchKeySchema(gsi.KeySchema) {
+			return nil
+		}
+
+		return []*MigrationStep{m.deleteIndexStep(table, index), m.createIndexStep(desc, index)}
+	}
+
+	return []*MigrationStep{m.createIndexStep(desc, index)}
+}
+
+// describeTable return nil when the table doesn't exist
+func (m *DynamoDBMigration) describeTable(table string) (*dynamodb.TableDescription, error) {
+	out, err := m.client.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String(table)})
+	var awsErr awserr.Error
+	switch {
+	case errors.As(err, &awsErr) && awsErr.Code() == dynamodb.ErrCodeResourceNotFoundException:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to describe table %s: %w", table, err)
+	}
+
+	return out.Table, nil
+}
+
+func (m *DynamoDBMigration) createTableStep(table string, key *indexSpec, indexes []*indexSpec) *MigrationStep {
+	input := &dynamodb.CreateTableInput{
+		TableName:            aws.String(table),
+		BillingMode:          aws.String(dynamodb.BillingModePayPerRequest),
+		AttributeDefinitions: key.attributeDefinitions(),
+		KeySchema:            key.keySchema(),
+	}
+
+	description := fmt.Sprintf("create table %s with %s hash key", table, key.hashKey)
+	if key.rangeKey != "" {
+		description += fmt.Sprintf(" and %s range key", key.rangeKey)
+	}
+	for _, index := range indexes {
+		input.AttributeDefinitions = append(input.AttributeDefinitions, index.attributeDefinitions()...)
+		input.GlobalSecondaryIndexes = append(input.GlobalSecondaryIndexes, &dynamodb.GlobalSecondaryIndex{
+			IndexName:  aws.String(index.name),
+			KeySchema:  index.keySchema(),
+			Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
+		})
+		description += fmt.Sprintf(" and %s", index)
+	}
+
+	return &MigrationStep{
+		Description: description,
+		table:       table,
+		apply: func() error {
+			_, err := m.client.CreateTable(input)
+			return err
+		},
+	}
+}
+
+func (m *DynamoDBMigration) createIndexStep(desc *dynamodb.TableDescription, index *indexSpec) *MigrationStep {
+	action := &dynamodb.CreateGlobalSecondaryIndexAction{
+		IndexName:  aws.String(index.name),
+		KeySchema:  index.keySchema(),
+		Projection: &dynamodb.Projection{ProjectionType: aws.String(dynamodb.ProjectionTypeAll)},
+	}
+
+	// the index of a provisioned table needs its own throughput, copied from the table
+	if throughput := desc.ProvisionedThroughput; throughput != nil && aws.Int64Value(throughput.ReadCapacityUnits) > 0 {
+		action.ProvisionedThroughput = &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  throughput.ReadCapacityUnits,
+			WriteCapacityUnits: throughput.WriteCapacityUnits,
+		}
+	}
+
+	table := aws.StringValue(desc.TableName)
+	return &MigrationStep{
+		Description: fmt.Sprintf("create %s on table %s", index, table),
+		table:       table,
+		apply: func() error {
+			_, err := m.client.UpdateTable(&dynamodb.UpdateTableInput{
+				TableName:                   aws.String(table),
+				AttributeDefinitions:        index.attributeDefinitions(),
+				GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{{Create: action}},
+			})
+			return err
+		},
+	}
+}
+
+func (m *DynamoDBMigration) deleteIndexStep(table string, index *indexSpec) *MigrationStep {
+	return &MigrationStep{
+		Description: fmt.Sprintf("delete index %s on table %s to recreate it with different keys", index.name, table),
+		table:       table,
+		apply: func() error {
+			_, err := m.client.UpdateTable(&dynamodb.UpdateTableInput{
+				TableName: aws.String(table),
+				GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+					{Delete: &dynamodb.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(index.name)}},
+				},
+			})
+			return err
+		},
+	}
+}
+
+func (m *DynamoDBMigration) enableTTLStep(table string) *MigrationStep {
+	return &MigrationStep{
+		Description: fmt.Sprintf("enable ttl on table %s using %s attribute", table, ttlAttributeName),
+		table:       table,
+		apply: func() error {
+			_, err := m.client.UpdateTimeToLive(&dynamodb.UpdateTimeToLiveInput{
+				TableName: aws.String(table),
+				TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+					Enabled:       aws.Bool(true),
+					AttributeName: aws.String(ttlAttributeName),
+				},
+			})
+			return err
+		},
+	}
+}
+
+// waitActive poll the table until the table and all its indexes are ACTIVE,
+// a deleted index is listed as DELETING until it is gone
+func (m *DynamoDBMigration) waitActive(table string) error {
+	deadline := time.Now().Add(m.Timeout)
+	for {
+		desc, err := m.describeTable(table)
+		if err != nil {
+			return err
+		}
+
+		if desc != nil && isTableActive(desc) {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for table %s to be ACTIVE", table)
+		}
+		time.Sleep(m.PollInterval)
+	}
+}
+
+func isTableActive(desc *dynamodb.TableDescription) bool {
+	if aws.StringValue(desc.TableStatus) != dynamodb.TableStatusActive {
+		return false
+	}
+
+	for _, gsi := range desc.GlobalSecondaryIndexes {
+		if aws.StringValue(gsi.IndexStatus) != dynamodb.IndexStatusActive {
+			return false
+		}
+	}
+	return true
+}
+
+func (i *indexSpec) String() string {
+	if i.rangeKey == "" {
+		return fmt.Sprintf("index %s (%s)", i.name, i.hashKey)
+	}
+	return fmt.Sprintf("index %s (%s, %s)", i.name, i.hashKey, i.rangeKey)
+}
+
+func (i *indexSpec) keySchema() []*dynamodb.KeySchemaElement {
+	keys := []*dynamodb.KeySchemaElement{keySchemaElement(i.hashKey, dynamodb.KeyTypeHash)}
+	if i.rangeKey != "" {
+		keys = append(keys, keySchemaElement(i.rangeKey, dynamodb.KeyTypeRange))
+	}
+	return keys
+}
+
+func (i *indexSpec) attributeDefinitions() []*dynamodb.AttributeDefinition {
+	attrs := []*dynamodb.AttributeDefinition{stringAttribute(i.hashKey)}
+	if i.rangeKey != "" {
+		attrs = append(attrs, stringAttribute(i.rangeKey))
+	}
+	return attrs
+}
+
+func (i *indexSpec) matchKeySchema(keys []*dynamodb.KeySchemaElement) bool {
+	expected := i.keySchema()
+	if len(keys) != len(expected) {
+		return false
+	}
+
+	for n, key := range keys {
+		if aws.StringValue(key.AttributeName) != aws.StringValue(expected[n].AttributeName) ||
+			aws.StringValue(key.KeyType) != aws.StringValue(expected[n].KeyType) {
+			return false
+		}
+	}
+	return true
+}
+
+func keySchemaElement(name, keyType string) *dynamodb.KeySchemaElement {
+	return &dynamodb.KeySchemaElement{AttributeName: aws.String(name), KeyType: aws.String(keyType)}
+}
+
+func stringAttribute(name string) *dynamodb.AttributeDefinition {
+	return &dynamodb.AttributeDefinition{AttributeName: aws.String(name), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)}
+}
@@ -0,0 +1,245 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMigrationClient keep the table descriptions in memory, a changed table is UPDATING
+// for the next pendingPolls DescribeTable calls
+type fakeMigrationClient struct {
+	tables       map[string]*dynamodb.TableDescription
+	ttl          map[string]string
+	pendingPolls int
+	polls        map[string]int
+	describeErr  error
+	updates      []*dynamodb.UpdateTableInput
+}
+
+func newFakeMigrationClient() *fakeMigrationClient {
+	return &fakeMigrationClient{
+		tables: map[string]*dynamodb.TableDescription{},
+		ttl:    map[string]string{},
+		polls:  map[string]int{},
+	}
+}
+
+func (f *fakeMigrationClient) DescribeTable(in *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+	if f.describeErr != nil {
+		return nil, f.describeErr
+	}
+
+	name := aws.StringValue(in.TableName)
+	table, ok := f.tables[name]
+	if !ok {
+		return nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "table not found", nil)
+	}
+
+	if f.polls[name] > 0 {
+		f.polls[name]--
+		updating := *table
+		updating.TableStatus = aws.String(dynamodb.TableStatusUpdating)
+		return &dynamodb.DescribeTableOutput{Table: &updating}, nil
+	}
+	return &dynamodb.DescribeTableOutput{Table: table}, nil
+}
+
+func (f *fakeMigrationClient) CreateTable(in *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	table := &dynamodb.TableDescription{
+		TableName:   in.TableName,
+		TableStatus: aws.String(dynamodb.TableStatusActive),
+		KeySchema:   in.KeySchema,
+	}
+	for _, gsi := range in.GlobalSecondaryIndexes {
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, &dynamodb.GlobalSecondaryIndexDescription{
+			IndexName:   gsi.IndexName,
+			KeySchema:   gsi.KeySchema,
+			IndexStatus: aws.String(dynamodb.IndexStatusActive),
+		})
+	}
+
+	f.tables[aws.StringValue(in.TableName)] = table
+	f.polls[aws.StringValue(in.TableName)] = f.pendingPolls
+	return &dynamodb.CreateTableOutput{TableDescription: table}, nil
+}
+
+func (f *fakeMigrationClient) UpdateTable(in *dynamodb.UpdateTableInput) (*dynamodb.UpdateTableOutput, error) {
+	f.updates = append(f.updates, in)
+
+	table := f.tables[aws.StringValue(in.TableName)]
+	for _, update := range in.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, &dynamodb.GlobalSecondaryIndexDescription{
+				IndexName:   update.Create.IndexName,
+				KeySchema:   update.Create.KeySchema,
+				IndexStatus: aws.String(dynamodb.IndexStatusActive),
+			})
+		case update.Delete != nil:
+			var indexes []*dynamodb.GlobalSecondaryIndexDescription
+			for _, gsi := range table.GlobalSecondaryIndexes {
+				if aws.StringValue(gsi.IndexName) != aws.StringValue(update.Delete.IndexName) {
+					indexes = append(indexes, gsi)
+				}
+			}
+			table.GlobalSecondaryIndexes = indexes
+		}
+	}
+
+	f.polls[aws.StringValue(in.TableName)] = f.pendingPolls
+	return &dynamodb.UpdateTableOutput{TableDescription: table}, nil
+}
+
+func (f *fakeMigrationClient) DescribeTimeToLive(in *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error) {
+	status, ok := f.ttl[aws.StringValue(in.TableName)]
+	if !ok {
+		status = dynamodb.TimeToLiveStatusDisabled
+	}
+	return &dynamodb.DescribeTimeToLiveOutput{
+		TimeToLiveDescription: &dynamodb.TimeToLiveDescription{TimeToLiveStatus: aws.String(status)},
+	}, nil
+}
+
+func (f *fakeMigrationClient) UpdateTimeToLive(in *dynamodb.UpdateTimeToLiveInput) (*dynamodb.UpdateTimeToLiveOutput, error) {
+	f.ttl[aws.StringValue(in.TableName)] = dynamodb.TimeToLiveStatusEnabled
+	return &dynamodb.UpdateTimeToLiveOutput{TimeToLiveSpecification: in.TimeToLiveSpecification}, nil
+}
+
+func newMigrationMock(client *fakeMigrationClient) *DynamoDBMigration {
+	m := NewDynamoDBMigration(client, "task_table", "group_table")
+	m.PollInterval = time.Millisecond
+	m.Timeout = time.Second
+	return m
+}
+
+func descriptions(steps []*MigrationStep) []string {
+	var res []string
+	for _, step := range steps {
+		res = append(res, step.Description)
+	}
+	return res
+}
+
+func Test_DynamoDBMigration(t *testing.T) {
+	t.Run("create tables", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		client.pendingPolls = 2
+		m := newMigrationMock(client)
+
+		steps, err := m.Plan()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create table task_table with TaskUUID hash key and index StateIndex (State, CreatedAt)",
+			"enable ttl on table task_table using TTL attribute",
+			"create table group_table with GroupUUID hash key",
+			"enable ttl on table group_table using TTL attribute",
+		}, descriptions(steps))
+
+		require.NoError(t, m.Apply(steps))
+		assert.True(t, stateIndex.matchKeySchema(client.tables["task_table"].GlobalSecondaryIndexes[0].KeySchema))
+		assert.Equal(t, dynamodb.TimeToLiveStatusEnabled, client.ttl["group_table"])
+
+		steps, err = m.Plan()
+		require.NoError(t, err)
+		assert.Empty(t, steps)
+	})
+
+	t.Run("recreate the unsorted state index", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		client.tables["task_table"] = &dynamodb.TableDescription{
+			TableName:             aws.String("task_table"),
+			TableStatus:           aws.String(dynamodb.TableStatusActive),
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughputDescription{ReadCapacityUnits: aws.Int64(5), WriteCapacityUnits: aws.Int64(3)},
+			GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndexDescription{
+				{
+					IndexName:   aws.String("StateIndex"),
+					IndexStatus: aws.String(dynamodb.IndexStatusActive),
+					KeySchema:   []*dynamodb.KeySchemaElement{keySchemaElement("State", dynamodb.KeyTypeHash)},
+				},
+			},
+		}
+		client.tables["group_table"] = &dynamodb.TableDescription{TableName: aws.String("group_table"), TableStatus: aws.String(dynamodb.TableStatusActive)}
+		client.ttl["task_table"] = dynamodb.TimeToLiveStatusEnabled
+		client.ttl["group_table"] = dynamodb.TimeToLiveStatusEnabling
+		m := newMigrationMock(client)
+
+		steps, err := m.Plan()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"delete index StateIndex on table task_table to recreate it with different keys",
+			"create index StateIndex (State, CreatedAt) on table task_table",
+		}, descriptions(steps))
+
+		require.NoError(t, m.Apply(steps))
+		require.Len(t, client.updates, 2)
+		created := client.updates[1].GlobalSecondaryIndexUpdates[0].Create
+		require.NotNil(t, created)
+		assert.Equal(t, int64(3), aws.Int64Value(created.ProvisionedThroughput.WriteCapacityUnits))
+		assert.True(t, stateIndex.matchKeySchema(client.tables["task_table"].GlobalSecondaryIndexes[0].KeySchema))
+	})
+
+	t.Run("create only the audit table", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		m := NewDynamoDBMigration(client, "", "")
+		m.AuditTable = "audit_table"
+		m.PollInterval = time.Millisecond
+
+		steps, err := m.Plan()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"create table audit_table with Log hash key and SortKey range key"}, descriptions(steps))
+
+		require.NoError(t, m.Apply(steps))
+		assert.True(t, auditTableKey.matchKeySchema(client.tables["audit_table"].KeySchema))
+
+		steps, err = m.Plan()
+		require.NoError(t, err)
+		assert.Empty(t, steps, "the audit table has no ttl")
+	})
+
+	t.Run("create only the rerun log table", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		m := NewDynamoDBMigration(client, "", "")
+		m.RerunLogTable = "rerun_log_table"
+		m.PollInterval = time.Millisecond
+
+		steps, err := m.Plan()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"create table rerun_log_table with UUID hash key and SortKey range key",
+			"enable ttl on table rerun_log_table using TTL attribute",
+		}, descriptions(steps))
+
+		require.NoError(t, m.Apply(steps))
+		assert.True(t, rerunLogTableKey.matchKeySchema(client.tables["rerun_log_table"].KeySchema))
+
+		steps, err = m.Plan()
+		require.NoError(t, err)
+		assert.Empty(t, steps)
+	})
+
+	t.Run("timeout waiting for ACTIVE", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		client.pendingPolls = 1000
+		m := newMigrationMock(client)
+		m.Timeout = 10 * time.Millisecond
+
+		steps, err := m.Plan()
+		require.NoError(t, err)
+		assert.Error(t, m.Apply(steps))
+	})
+
+	t.Run("handle DescribeTable error", func(t *testing.T) {
+		client := newFakeMigrationClient()
+		client.describeErr = errors.New("gotcha")
+
+		_, err := newMigrationMock(client).Plan()
+		assert.Error(t, err)
+	})
+}
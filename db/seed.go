@@ -0,0 +1,385 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+const (
+	// dynamoDBBatchWriteSize is the maximum items of a BatchWriteItem
+	dynamoDBBatchWriteSize = 25
+	maxSeedWriteAttempts   = 5
+	seedTTL                = 30 * 24 * time.Hour
+	seedCreatedAtRange     = 7 * 24 * time.Hour
+)
+
+type dynamoDBSeedClient interface {
+	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+}
+
+// seedTaskState is a task item as machinery stores it on DynamoDB, the signature is a JSON string
+type seedTaskState struct {
+	TaskUUID  string
+	TaskName  string
+	State     string
+	Signature string
+	Results   []*seedTaskResult `dynamodbav:",omitempty"`
+	Error     string            `dynamodbav:",omitempty"`
+	CreatedAt string
+	TTL       int64
+}
+
+type seedTaskResult struct {
+	Type  string
+	Value string
+}
+
+// seedTaskKind is a fake task with its args & the errors it usually fails with
+type seedTaskKind struct {
+	name       string
+	routingKey string
+	args       func(r *rand.Rand) []tasks.Arg
+	errors     []func(r *rand.Rand) string
+}
+
+var seedTaskKinds = []*seedTaskKind{
+	{
+		name:       "SendEmail",
+		routingKey: "notification-service",
+		args: func(r *rand.Rand) []tasks.Arg {
+			return []tasks.Arg{
+				{Name: "userID", Type: "int64", Value: seedID(r)},
+				{Name: "template", Type: "string", Value: []string{"welcome", "reset_password", "weekly_digest"}[r.Intn(3)]},
+			}
+		},
+		errors: []func(r *rand.Rand) string{
+			func(r *rand.Rand) string { return fmt.Sprintf("user %d has no verified email", seedID(r)) },
+			func(r *rand.Rand) string { return "smtp: 421 service not available, closing transmission channel" },
+		},
+	},
+	{
+		name:       "ProcessPayment",
+		routingKey: "payment-service",
+		args: func(r *rand.Rand) []tasks.Arg {
+			return []tasks.Arg{
+				{Name: "paymentID", Type: "string", Value: seedUUID(r)},
+				{Name: "amount", Type: "float64", Value: float64(r.Intn(1000000)) / 100},
+				{Name: "capture", Type: "bool", Value: r.Intn(2) == 0},
+			}
+		},
+		errors: []func(r *rand.Rand) string{
+			func(r *rand.Rand) string { return fmt.Sprintf("payment %s declined: insufficient funds", seedUUID(r)) },
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("dial tcp 10.0.%d.%d:5432: connect: connection refused", r.Intn(10), r.Intn(255))
+			},
+		},
+	},
+	{
+		name:       "GenerateThumbnail",
+		routingKey: "media-service",
+		args: func(r *rand.Rand) []tasks.Arg {
+			return []tasks.Arg{
+				{Name: "mediaID", Type: "int64", Value: seedID(r)},
+				{Name: "sizes", Type: "[]int", Value: []int{64, 256, 1024}},
+			}
+		},
+		errors: []func(r *rand.Rand) string{
+			func(r *rand.Rand) string { return fmt.Sprintf("media %d not found", seedID(r)) },
+			func(r *rand.Rand) string { return "image: unknown format" },
+		},
+	},
+	{
+		name:       "SyncInventory",
+		routingKey: "inventory-service",
+		args: func(r *rand.Rand) []tasks.Arg {
+			return []tasks.Arg{
+				{Name: "warehouseIDs", Type: "[]string", Value: []string{seedUUID(r), seedUUID(r)}},
+			}
+		},
+		errors: []func(r *rand.Rand) string{
+			func(r *rand.Rand) string { return "context deadline exceeded" },
+			func(r *rand.Rand) string { return "rpc error: code = Unavailable desc = transport is closing" },
+		},
+	},
+	{
+		name:       "DLQTaskCreateComment",
+		routingKey: "comment-service",
+		args: func(r *rand.Rand) []tasks.Arg {
+			return []tasks.Arg{
+				{Name: "commentID", Type: "int64", Value: seedID(r)},
+				{Name: "storyID", Type: "int64", Value: seedID(r)},
+			}
+		},
+		errors: []func(r *rand.Rand) string{
+			func(r *rand.Rand) string { return fmt.Sprintf("story %d is deleted", seedID(r)) },
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("timeout after 30s at %s", time.Unix(1607500000+int64(r.Intn(600000)), 0).UTC().Format(time.RFC3339))
+			},
+		},
+	},
+}
+
+// seedStates are weighted so most tasks are either FAILURE or SUCCESS
+var seedStates = []struct {
+	state  string
+	weight int
+}{
+	{tasks.StateFailure, 35},
+	{tasks.StateSuccess, 40},
+	{tasks.StatePending, 10},
+	{tasks.StateReceived, 3},
+	{tasks.StateStarted, 4},
+	{tasks.StateRetry, 8},
+}
+
+// SeedReport number of items written by the seeder
+type SeedReport struct {
+	Tasks  int
+	Groups int
+}
+
+// DynamoDBSeeder write fake tasks & groups, used to develop the dashboard without real workers
+type DynamoDBSeeder struct {
+	client     dynamoDBSeedClient
+	taskTable  string
+	groupTable string
+	rand       *rand.Rand
+	now        time.Time
+}
+
+// NewDynamoDBSeeder the same seed generates the same tasks
+func NewDynamoDBSeeder(client dynamoDBSeedClient, taskTable, groupTable string, seed int64) *DynamoDBSeeder {
+	return &DynamoDBSeeder{
+		client:     client,
+		taskTable:  taskTable,
+		groupTable: groupTable,
+		rand:       rand.New(rand.NewSource(seed)),
+		now:        time.Now().UTC(),
+	}
+}
+
+// Seed write at least n tasks, as standalone tasks, chains, groups & chords
+func (s *DynamoDBSeeder) Seed(n int) (*SeedReport, error) {
+	g := &seedGenerator{rand: s.rand, now: s.now}
+	for len(g.tasks) < n {
+		g.generateWorkflow()
+	}
+
+	err := s.batchWrite(s.taskTable, g.tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.batchWrite(s.groupTable, g.groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeedReport{Tasks: len(g.tasks), Groups: len(g.groups)}, nil
+}
+
+func (s *DynamoDBSeeder) batchWrite(table string, items []interface{}) error {
+	for start := 0; start < len(items); start += dynamoDBBatchWriteSize {
+		end := start + dynamoDBBatchWriteSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		var requests []*dynamodb.WriteRequest
+		for _, item := range items[start:end] {
+			av, err := dynamodbattribute.MarshalMap(item)
+			if err != nil {
+				return fmt.Errorf("failed to marshal item: %w", err)
+			}
+			requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: av}})
+		}
+
+		err := s.writeRequests(table, requests)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRequests retry the unprocessed items, they are returned when the table is throttled
+func (s *DynamoDBSeeder) writeRequests(table string, requests []*dynamodb.WriteRequest) error {
+	for attempt := 1; len(requests) > 0; attempt++ {
+		if attempt > maxSeedWriteAttempts {
+			return fmt.Errorf("failed to write %d items to table %s: too many unprocessed items", len(requests), table)
+		}
+
+		out, err := s.client.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{table: requests},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write items to table %s: %w", table, err)
+		}
+
+		requests = out.UnprocessedItems[table]
+		if len(requests) > 0 {
+			time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+type seedGenerator struct {
+	rand   *rand.Rand
+	now    time.Time
+	tasks  []interface{}
+	groups []interface{}
+}
+
+func (g *seedGenerator) generateWorkflow() {
+	createdAt := g.now.Add(-time.Duration(g.rand.Int63n(int64(seedCreatedAtRange))))
+
+	switch p := g.rand.Intn(100); {
+	case p < 60:
+		sig := g.newSignature(createdAt)
+		if g.rand.Intn(5) == 0 {
+			sig.OnError = []*tasks.Signature{g.newSignature(createdAt)}
+		}
+		g.addTask(sig, g.randomState(), createdAt)
+	case p < 75:
+		g.generateChain(createdAt)
+	case p < 90:
+		g.generateGroup(createdAt, false)
+	default:
+		g.generateGroup(createdAt, true)
+	}
+}
+
+// generateChain the next task only exists once the previous one succeeded
+func (g *seedGenerator) generateChain(createdAt time.Time) {
+	sigs := []*tasks.Signature{g.newSignature(createdAt), g.newSignature(createdAt), g.newSignature(createdAt)}
+	for i := len(sigs) - 2; i >= 0; i-- {
+		sigs[i].OnSuccess = []*tasks.Signature{sigs[i+1]}
+	}
+
+	for _, sig := range sigs {
+		state := g.randomState()
+		g.addTask(sig, state, createdAt)
+		if state != tasks.StateSuccess {
+			return
+		}
+		createdAt = createdAt.Add(time.Duration(1+g.rand.Intn(30)) * time.Second)
+	}
+}
+
+// generateGroup the chord callback only exists once all the group tasks succeeded
+func (g *seedGenerator) generateGroup(createdAt time.Time, chord bool) {
+	meta := &tasks.GroupMeta{
+		GroupUUID: "group_" + seedUUID(g.rand),
+		CreatedAt: createdAt,
+		TTL:       g.now.Add(seedTTL).Unix(),
+	}
+
+	var callback *tasks.Signature
+	if chord {
+		callback = g.newSignature(createdAt)
+	}
+
+	count := 2 + g.rand.Intn(4)
+	allSucceeded := true
+	for i := 0; i < count; i++ {
+		sig := g.newSignature(createdAt)
+		sig.GroupUUID = meta.GroupUUID
+		sig.GroupTaskCount = count
+		sig.ChordCallback = callback
+
+		state := g.randomState()
+		allSucceeded = allSucceeded && state == tasks.StateSuccess
+		g.addTask(sig, state, createdAt)
+		meta.TaskUUIDs = append(meta.TaskUUIDs, sig.UUID)
+	}
+
+	if chord && allSucceeded {
+		meta.ChordTriggered = true
+		g.addTask(callback, g.randomState(), createdAt.Add(time.Minute))
+	}
+	g.groups = append(g.groups, meta)
+}
+
+func (g *seedGenerator) newSignature(createdAt time.Time) *tasks.Signature {
+	kind := seedTaskKinds[g.rand.Intn(len(seedTaskKinds))]
+	sig := &tasks.Signature{
+		UUID:       "task_" + seedUUID(g.rand),
+		Name:       kind.name,
+		RoutingKey: kind.routingKey,
+		Args:       kind.args(g.rand),
+		Headers:    tasks.Headers{"trace-id": fmt.Sprintf("%016x", g.rand.Uint64())},
+		RetryCount: g.rand.Intn(4),
+	}
+
+	if g.rand.Intn(10) == 0 {
+		eta := createdAt.Add(time.Duration(1+g.rand.Intn(48)) * time.Hour)
+		sig.ETA = &eta
+	}
+	return sig
+}
+
+func (g *seedGenerator) addTask(sig *tasks.Signature, state string, createdAt time.Time) {
+	signature, err := json.Marshal(sig)
+	if err != nil {
+		// the signature only has marshalable values
+		panic(err)
+	}
+
+	task := &seedTaskState{
+		TaskUUID:  sig.UUID,
+		TaskName:  sig.Name,
+		State:     state,
+		Signature: string(signature),
+		CreatedAt: createdAt.UTC().Format(time.RFC3339Nano),
+		TTL:       g.now.Add(seedTTL).Unix(),
+	}
+
+	switch state {
+	case tasks.StateFailure:
+		task.Error = g.randomError(sig.Name)
+	case tasks.StateSuccess:
+		task.Results = []*seedTaskResult{{Type: "bool", Value: "true"}}
+	}
+
+	g.tasks = append(g.tasks, task)
+}
+
+func (g *seedGenerator) randomState() string {
+	total := 0
+	for _, s := range seedStates {
+		total += s.weight
+	}
+
+	n := g.rand.Intn(total)
+	for _, s := range seedStates {
+		if n < s.weight {
+			return s.state
+		}
+		n -= s.weight
+	}
+	return tasks.StateFailure
+}
+
+func (g *seedGenerator) randomError(taskName string) string {
+	for _, kind := range seedTaskKinds {
+		if kind.name == taskName {
+			return kind.errors[g.rand.Intn(len(kind.errors))](g.rand)
+		}
+	}
+	return "unknown task"
+}
+
+func seedID(r *rand.Rand) int64 {
+	return 1600000000000000000 + r.Int63n(100000000000000000)
+}
+
+func seedUUID(r *rand.Rand) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x", r.Uint32(), r.Intn(0x10000), r.Intn(0x10000), r.Intn(0x10000), r.Int63n(1<<48))
+}
@@ -0,0 +1,118 @@
+package db
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSeedClient keep the written items per table, the last item of the first batch is left unprocessed once
+type fakeSeedClient struct {
+	items       map[string][]map[string]*dynamodb.AttributeValue
+	calls       int
+	unprocessed bool
+	err         error
+}
+
+func (f *fakeSeedClient) BatchWriteItem(in *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.calls++
+
+	out := &dynamodb.BatchWriteItemOutput{UnprocessedItems: map[string][]*dynamodb.WriteRequest{}}
+	for table, requests := range in.RequestItems {
+		if len(requests) > dynamoDBBatchWriteSize {
+			return nil, errors.New("ValidationException: too many items")
+		}
+
+		if f.unprocessed {
+			f.unprocessed = false
+			out.UnprocessedItems[table] = requests[len(requests)-1:]
+			requests = requests[:len(requests)-1]
+		}
+
+		for _, r := range requests {
+			f.items[table] = append(f.items[table], r.PutRequest.Item)
+		}
+	}
+	return out, nil
+}
+
+func newSeederMock(client *fakeSeedClient, seed int64) *DynamoDBSeeder {
+	s := NewDynamoDBSeeder(client, "task_table", "group_table", seed)
+	s.now = time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC)
+	return s
+}
+
+func Test_DynamoDBSeeder(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		client := &fakeSeedClient{items: map[string][]map[string]*dynamodb.AttributeValue{}, unprocessed: true}
+
+		report, err := newSeederMock(client, 1).Seed(200)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, report.Tasks, 200)
+		assert.Len(t, client.items["task_table"], report.Tasks)
+		assert.Len(t, client.items["group_table"], report.Groups)
+		assert.NotZero(t, report.Groups)
+
+		states := map[string]int{}
+		var chained, grouped, chords bool
+		for _, item := range client.items["task_table"] {
+			task := &seedTaskState{}
+			require.NoError(t, dynamodbattribute.UnmarshalMap(item, task))
+			states[task.State]++
+
+			_, err := time.Parse(time.RFC3339Nano, task.CreatedAt)
+			assert.NoError(t, err)
+			assert.Equal(t, task.State == tasks.StateFailure, task.Error != "")
+
+			sig := &tasks.Signature{}
+			require.NoError(t, json.Unmarshal([]byte(task.Signature), sig))
+			assert.Equal(t, task.TaskUUID, sig.UUID)
+			assert.NotEmpty(t, sig.Args)
+			chained = chained || len(sig.OnSuccess) > 0
+			grouped = grouped || sig.GroupUUID != ""
+			chords = chords || sig.ChordCallback != nil
+		}
+
+		for _, s := range seedStates {
+			assert.NotZero(t, states[s.state], s.state)
+		}
+		assert.True(t, chained)
+		assert.True(t, grouped)
+		assert.True(t, chords)
+
+		meta := &tasks.GroupMeta{}
+		require.NoError(t, dynamodbattribute.UnmarshalMap(client.items["group_table"][0], meta))
+		assert.NotEmpty(t, meta.TaskUUIDs)
+	})
+
+	t.Run("the same seed generates the same tasks", func(t *testing.T) {
+		first := &fakeSeedClient{items: map[string][]map[string]*dynamodb.AttributeValue{}}
+		second := &fakeSeedClient{items: map[string][]map[string]*dynamodb.AttributeValue{}}
+
+		_, err := newSeederMock(first, 42).Seed(30)
+		require.NoError(t, err)
+		_, err = newSeederMock(second, 42).Seed(30)
+		require.NoError(t, err)
+
+		assert.Equal(t, aws.StringValue(first.items["task_table"][0]["TaskUUID"].S), aws.StringValue(second.items["task_table"][0]["TaskUUID"].S))
+		assert.Equal(t, first.items, second.items)
+	})
+
+	t.Run("handle BatchWriteItem error", func(t *testing.T) {
+		client := &fakeSeedClient{err: errors.New("gotcha")}
+
+		_, err := newSeederMock(client, 1).Seed(10)
+		assert.Error(t, err)
+	})
+}
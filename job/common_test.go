@@ -0,0 +1,104 @@
+package job
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kumparan/machinerydash/dashboard"
+)
+
+// dashboardMock keep the tasks in memory, every listing returns a single page
+type dashboardMock struct {
+	mu    sync.Mutex
+	tasks []*dashboard.TaskWithSignature
+	err   error
+	// wait block the listing until closed
+	wait   chan struct{}
+	reruns []string
+	edits  []*dashboard.RerunEdit
+}
+
+func (d *dashboardMock) FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*dashboard.TaskWithSignature, next string, err error) {
+	return d.FindAllTasks(&dashboard.TaskFilter{State: state}, cursor, asc, size)
+}
+
+func (d *dashboardMock) FindAllTasks(filter *dashboard.TaskFilter, cursor string, asc bool, size int64) (taskStates []*dashboard.TaskWithSignature, next string, err error) {
+	if d.wait != nil {
+		<-d.wait
+	}
+	if d.err != nil {
+		return nil, "", d.err
+	}
+
+	for _, t := range d.tasks {
+		if filter.Match(t) {
+			taskStates = append(taskStates, t)
+		}
+	}
+	return taskStates, "", nil
+}
+
+func (d *dashboardMock) FindTaskByUUID(uuid string) (*dashboard.TaskWithSignature, error) {
+	for _, t := range d.tasks {
+		if t.TaskUUID == uuid {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("task %s %w", uuid, dashboard.ErrNotFound)
+}
+
+func (d *dashboardMock) RerunTask(uuid string) error {
+	if _, err := d.FindTaskByUUID(uuid); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.reruns = append(d.reruns, uuid)
+	return nil
+}
+
+func (d *dashboardMock) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	if err := d.RerunTask(uuid); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.edits = append(d.edits, edit)
+	return nil
+}
+
+func (d *dashboardMock) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (d *dashboardMock) FindGroupByUUID(groupUUID string) (*dashboard.Group, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (d *dashboardMock) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (d *dashboardMock) CountTasks(states []string) ([]*dashboard.TaskCount, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (d *dashboardMock) rerunCount() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.reruns)
+}
+
+func newDashboardMock() *dashboardMock {
+	d := &dashboardMock{}
+	for i := 1; i <= 5; i++ {
+		d.tasks = append(d.tasks, &dashboard.TaskWithSignature{})
+		d.tasks[i-1].TaskUUID = fmt.Sprintf("task_%d", i)
+		d.tasks[i-1].TaskName = "send_email"
+		d.tasks[i-1].State = "FAILURE"
+	}
+	d.tasks[4].State = "SUCCESS"
+	return d
+}
@@ -0,0 +1,260 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+)
+
+// Status of a rerun job
+type Status string
+
+// list of job statuses
+const (
+	StatusPending   Status = "PENDING" // resolving the tasks to rerun
+	StatusRunning   Status = "RUNNING"
+	StatusPaused    Status = "PAUSED"
+	StatusCancelled Status = "CANCELLED"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED" // unable to resolve the tasks to rerun
+)
+
+// maxJobErrors limit the failed reruns kept on a job
+const maxJobErrors = 100
+
+// Job rerun tasks in background at a fixed rate
+type Job struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	id         string
+	rate       float64
+	status     Status
+	err        string
+	uuids      []string
+	filter     *dashboard.BulkRerunFilter
+	eta        *time.Time
+	freshUUID  bool
+	done       int
+	failed     int
+	errors     []*dashboard.BulkRerunResult
+	createdAt  time.Time
+	finishedAt time.Time
+}
+
+// Progress is the snapshot of a job
+type Progress struct {
+	ID         string                       `json:"id"`
+	Status     Status                       `json:"status"`
+	Error      string                       `json:"error,omitempty"`
+	Rate       float64                      `json:"rate"`
+	Total      int                          `json:"total"`
+	Done       int                          `json:"done"`
+	Failed     int                          `json:"failed"`
+	Remaining  int                          `json:"remaining"`
+	Errors     []*dashboard.BulkRerunResult `json:"errors"`
+	ETA        *time.Time                   `json:"eta,omitempty"`
+	FreshUUID  bool                         `json:"fresh_uuid"`
+	CreatedAt  time.Time                    `json:"created_at"`
+	FinishedAt *time.Time                   `json:"finished_at,omitempty"`
+}
+
+// Percent of the processed tasks
+func (p *Progress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return (p.Done + p.Failed) * 100 / p.Total
+}
+
+// IsActive whether the job is still processing tasks
+func (p *Progress) IsActive() bool {
+	return p.Status == StatusPending || p.Status == StatusRunning || p.Status == StatusPaused
+}
+
+func newJob(id string, rate float64, uuids []string, filter *dashboard.BulkRerunFilter, eta *time.Time, freshUUID bool) *Job {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &Job{
+		ctx:       ctx,
+		cancel:    cancel,
+		id:        id,
+		rate:      rate,
+		status:    StatusPending,
+		uuids:     uuids,
+		filter:    filter,
+		eta:       eta,
+		freshUUID: freshUUID,
+		createdAt: time.Now(),
+	}
+	j.cond = sync.NewCond(&j.mu)
+	return j
+}
+
+// Progress :nodoc:
+func (j *Job) Progress() *Progress {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	p := &Progress{
+		ID:        j.id,
+		Status:    j.status,
+		Error:     j.err,
+		Rate:      j.rate,
+		Total:     len(j.uuids),
+		Done:      j.done,
+		Failed:    j.failed,
+		Remaining: len(j.uuids) - j.done - j.failed,
+		Errors:    append([]*dashboard.BulkRerunResult{}, j.errors...),
+		ETA:       j.eta,
+		FreshUUID: j.freshUUID,
+		CreatedAt: j.createdAt,
+	}
+	if !j.finishedAt.IsZero() {
+		finishedAt := j.finishedAt
+		p.FinishedAt = &finishedAt
+	}
+
+	return p
+}
+
+func (j *Job) pause() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.status != StatusRunning && j.status != StatusPending {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusPaused
+	return nil
+}
+
+func (j *Job) resume() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.status != StatusPaused {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusRunning
+	j.cond.Broadcast()
+	return nil
+}
+
+func (j *Job) stop() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.isFinished() {
+		return ErrInvalidTransition
+	}
+
+	j.status = StatusCancelled
+	j.finishedAt = time.Now()
+	j.cancel()
+	j.cond.Broadcast()
+	return nil
+}
+
+// finishedTime return the zero time while the job is still active
+func (j *Job) finishedTime() time.Time {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.finishedAt
+}
+
+func (j *Job) isFinished() bool {
+	return j.status == StatusCancelled || j.status == StatusCompleted || j.status == StatusFailed
+}
+
+// run resolve the tasks when the job is created by filter, then rerun them one by one
+// honouring the rate, pause & cancellation
+func (j *Job) run(d dashboard.Dashboard) {
+	if j.filter != nil {
+		uuids, err := dashboard.FindTaskUUIDsByFilter(d, j.filter)
+		j.mu.Lock()
+		if j.status == StatusCancelled {
+			// keep the job cancelled when the resolution is interrupted by the cancellation
+			j.mu.Unlock()
+			return
+		}
+		if err != nil {
+			j.status = StatusFailed
+			j.err = err.Error()
+			j.finishedAt = time.Now()
+			j.mu.Unlock()
+			return
+		}
+		j.uuids = uuids
+		j.mu.Unlock()
+	}
+
+	j.mu.Lock()
+	if j.status == StatusPending {
+		j.status = StatusRunning
+	}
+	j.mu.Unlock()
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / j.rate))
+	defer ticker.Stop()
+
+	for _, uuid := range j.uuids {
+		if !j.waitRunnable() {
+			return
+		}
+
+		select {
+		case <-j.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// the job may be paused or cancelled while waiting for the ticker
+		if !j.waitRunnable() {
+			return
+		}
+
+		_, err := dashboard.ScheduleRerunTask(d, uuid, j.eta, j.freshUUID)
+		j.record(uuid, err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.isFinished() {
+		j.status = StatusCompleted
+		j.finishedAt = time.Now()
+	}
+}
+
+// waitRunnable block while the job is paused, return false when the job is cancelled
+func (j *Job) waitRunnable() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for j.status == StatusPaused {
+		j.cond.Wait()
+	}
+
+	return j.status != StatusCancelled
+}
+
+func (j *Job) record(uuid string, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err == nil {
+		j.done++
+		return
+	}
+
+	j.failed++
+	if len(j.errors) < maxJobErrors {
+		j.errors = append(j.errors, &dashboard.BulkRerunResult{UUID: uuid, Error: err.Error()})
+	}
+}
@@ -0,0 +1,207 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kumparan/machinerydash/dashboard"
+)
+
+var (
+	// ErrNotFound returned when the job doesn't exist
+	ErrNotFound = errors.New("job not found")
+	// ErrInvalidTransition returned when the job can't be paused, resumed or cancelled on its current status
+	ErrInvalidTransition = errors.New("invalid job status transition")
+	// ErrEmptyJob returned when the job has neither uuids nor filter
+	ErrEmptyJob = errors.New("either uuids or filter is required")
+)
+
+const (
+	// defaultRetention how long a finished job is kept
+	defaultRetention = 24 * time.Hour
+	// defaultMaxHistory limit the finished jobs kept regardless of the retention
+	defaultMaxHistory = 100
+)
+
+// Request :nodoc:
+type Request struct {
+	UUIDs  []string
+	Filter *dashboard.BulkRerunFilter
+	// Rate is the number of tasks rerun per second
+	Rate float64
+	// ETA schedule the reruns, nil means rerun immediately
+	ETA *time.Time
+	// FreshUUID rerun the tasks as new tasks, see dashboard.ScheduleRerunTask
+	FreshUUID bool
+	// Actor who submit the job, the reruns are attributed to the actor via the job
+	Actor string
+}
+
+// Manager keep track of the rerun jobs running in background
+type Manager struct {
+	mu          sync.RWMutex
+	dash        dashboard.Dashboard
+	jobs        map[string]*Job
+	defaultRate float64
+	maxRate     float64
+	retention   time.Duration
+	maxHistory  int
+}
+
+// NewManager :nodoc:
+func NewManager(dash dashboard.Dashboard, defaultRate, maxRate float64) *Manager {
+	return &Manager{
+		dash:        dash,
+		jobs:        map[string]*Job{},
+		defaultRate: defaultRate,
+		maxRate:     maxRate,
+		retention:   defaultRetention,
+		maxHistory:  defaultMaxHistory,
+	}
+}
+
+// SetHistory change how long the finished jobs are kept and how many of them at most,
+// zero means no limit
+func (m *Manager) SetHistory(retention time.Duration, maxHistory int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retention = retention
+	m.maxHistory = maxHistory
+	m.evict()
+}
+
+// Submit create a job and start it in background
+func (m *Manager) Submit(req *Request) (*Progress, error) {
+	if len(req.UUIDs) == 0 && req.Filter == nil {
+		return nil, ErrEmptyJob
+	}
+
+	rate := req.Rate
+	switch {
+	case rate <= 0:
+		rate = m.defaultRate
+	case m.maxRate > 0 && rate > m.maxRate:
+		rate = m.maxRate
+	}
+
+	j := newJob(uuid.New().String(), rate, req.UUIDs, req.Filter, req.ETA, req.FreshUUID)
+
+	m.mu.Lock()
+	m.evict()
+	m.jobs[j.id] = j
+	m.mu.Unlock()
+
+	actor := fmt.Sprintf("job %s", j.id)
+	if req.Actor != "" {
+		actor = fmt.Sprintf("%s via job %s", req.Actor, j.id)
+	}
+	go j.run(dashboard.WithActor(m.dash, actor))
+
+	return j.Progress(), nil
+}
+
+// FindByID :nodoc:
+func (m *Manager) FindByID(id string) (*Progress, error) {
+	j, err := m.findJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.Progress(), nil
+}
+
+// FindAll return all jobs, the latest first
+func (m *Manager) FindAll() []*Progress {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.evict()
+
+	res := make([]*Progress, 0, len(m.jobs))
+	for _, j := range m.jobs {
+		res = append(res, j.Progress())
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt.After(res[j].CreatedAt)
+	})
+	return res
+}
+
+// Pause :nodoc:
+func (m *Manager) Pause(id string) error {
+	j, err := m.findJob(id)
+	if err != nil {
+		return err
+	}
+
+	return j.pause()
+}
+
+// Resume :nodoc:
+func (m *Manager) Resume(id string) error {
+	j, err := m.findJob(id)
+	if err != nil {
+		return err
+	}
+
+	return j.resume()
+}
+
+// Cancel :nodoc:
+func (m *Manager) Cancel(id string) error {
+	j, err := m.findJob(id)
+	if err != nil {
+		return err
+	}
+
+	return j.stop()
+}
+
+func (m *Manager) findJob(id string) (*Job, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	j, ok := m.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return j, nil
+}
+
+// evict drop the finished jobs past the retention, then the oldest finished jobs beyond the max history,
+// the active jobs are always kept. m.mu must be held
+func (m *Manager) evict() {
+	now := time.Now()
+	var finished []*Job
+	finishedAt := map[string]time.Time{}
+	for id, j := range m.jobs {
+		at := j.finishedTime()
+		if at.IsZero() {
+			continue
+		}
+		if m.retention > 0 && now.Sub(at) > m.retention {
+			delete(m.jobs, id)
+			continue
+		}
+		finished = append(finished, j)
+		finishedAt[id] = at
+	}
+
+	if m.maxHistory <= 0 || len(finished) <= m.maxHistory {
+		return
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finishedAt[finished[i].id].After(finishedAt[finished[j].id])
+	})
+	for _, j := range finished[m.maxHistory:] {
+		delete(m.jobs, j.id)
+	}
+}
@@ -0,0 +1,209 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitStatus(t *testing.T, m *Manager, id string, status Status) *Progress {
+	var p *Progress
+	assert.Eventually(t, func() bool {
+		var err error
+		p, err = m.FindByID(id)
+		require.NoError(t, err)
+		return p.Status == status
+	}, 2*time.Second, 5*time.Millisecond)
+	return p
+}
+
+func TestManager_Submit(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		m := NewManager(newDashboardMock(), 1000, 1000)
+		_, err := m.Submit(&Request{})
+		assert.True(t, errors.Is(err, ErrEmptyJob))
+	})
+
+	t.Run("by uuids", func(t *testing.T) {
+		d := newDashboardMock()
+		m := NewManager(d, 1000, 1000)
+		p, err := m.Submit(&Request{UUIDs: []string{"task_1", "task_2", "unknown"}})
+		require.NoError(t, err)
+		assert.Equal(t, float64(1000), p.Rate)
+
+		p = waitStatus(t, m, p.ID, StatusCompleted)
+		assert.Equal(t, 3, p.Total)
+		assert.Equal(t, 2, p.Done)
+		assert.Equal(t, 1, p.Failed)
+		assert.Equal(t, 0, p.Remaining)
+		assert.Equal(t, 100, p.Percent())
+		require.Len(t, p.Errors, 1)
+		assert.Equal(t, "unknown", p.Errors[0].UUID)
+		assert.NotNil(t, p.FinishedAt)
+		assert.Equal(t, 2, d.rerunCount())
+	})
+
+	t.Run("by filter", func(t *testing.T) {
+		d := newDashboardMock()
+		m := NewManager(d, 1000, 1000)
+		p, err := m.Submit(&Request{Filter: &dashboard.BulkRerunFilter{State: "FAILURE"}})
+		require.NoError(t, err)
+
+		p = waitStatus(t, m, p.ID, StatusCompleted)
+		assert.Equal(t, 4, p.Total)
+		assert.Equal(t, 4, p.Done)
+		assert.Equal(t, 4, d.rerunCount())
+	})
+
+	t.Run("scheduled", func(t *testing.T) {
+		d := newDashboardMock()
+		m := NewManager(d, 1000, 1000)
+		eta := time.Date(2020, 12, 11, 7, 0, 0, 0, time.UTC)
+		p, err := m.Submit(&Request{UUIDs: []string{"task_1"}, ETA: &eta})
+		require.NoError(t, err)
+		assert.Equal(t, &eta, p.ETA)
+
+		waitStatus(t, m, p.ID, StatusCompleted)
+		require.Len(t, d.edits, 1)
+		assert.Equal(t, &eta, d.edits[0].ETA)
+	})
+
+	t.Run("filter error", func(t *testing.T) {
+		d := newDashboardMock()
+		d.err = errors.New("boom")
+		m := NewManager(d, 1000, 1000)
+		p, err := m.Submit(&Request{Filter: &dashboard.BulkRerunFilter{}})
+		require.NoError(t, err)
+
+		p = waitStatus(t, m, p.ID, StatusFailed)
+		assert.Equal(t, "boom", p.Error)
+	})
+
+	t.Run("cancelled while resolving the filter", func(t *testing.T) {
+		d := newDashboardMock()
+		d.err = errors.New("gotcha")
+		d.wait = make(chan struct{})
+		m := NewManager(d, 1000, 1000)
+		p, err := m.Submit(&Request{Filter: &dashboard.BulkRerunFilter{}})
+		require.NoError(t, err)
+
+		require.NoError(t, m.Cancel(p.ID))
+		close(d.wait)
+
+		time.Sleep(50 * time.Millisecond)
+		p, err = m.FindByID(p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, StatusCancelled, p.Status)
+		assert.Empty(t, p.Error)
+	})
+
+	t.Run("rate is capped", func(t *testing.T) {
+		m := NewManager(newDashboardMock(), 10, 100)
+		p, err := m.Submit(&Request{UUIDs: []string{"task_1"}, Rate: 500})
+		require.NoError(t, err)
+		assert.Equal(t, float64(100), p.Rate)
+
+		p, err = m.Submit(&Request{UUIDs: []string{"task_1"}})
+		require.NoError(t, err)
+		assert.Equal(t, float64(10), p.Rate)
+	})
+}
+
+func TestManager_PauseResumeCancel(t *testing.T) {
+	d := newDashboardMock()
+	m := NewManager(d, 20, 20)
+	p, err := m.Submit(&Request{UUIDs: []string{"task_1", "task_2", "task_3", "task_4"}})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Pause(p.ID))
+	assert.True(t, errors.Is(m.Pause(p.ID), ErrInvalidTransition))
+
+	// nothing is rerun while paused
+	time.Sleep(100 * time.Millisecond)
+	p, err = m.FindByID(p.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPaused, p.Status)
+	assert.Equal(t, 0, d.rerunCount())
+
+	require.NoError(t, m.Resume(p.ID))
+	assert.True(t, errors.Is(m.Resume(p.ID), ErrInvalidTransition))
+	assert.Eventually(t, func() bool { return d.rerunCount() > 0 }, 2*time.Second, 5*time.Millisecond)
+
+	require.NoError(t, m.Cancel(p.ID))
+	assert.True(t, errors.Is(m.Cancel(p.ID), ErrInvalidTransition))
+
+	p = waitStatus(t, m, p.ID, StatusCancelled)
+	assert.Less(t, p.Done, 4)
+	assert.False(t, p.IsActive())
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := m.FindByID("unknown")
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.True(t, errors.Is(m.Pause("unknown"), ErrNotFound))
+		assert.True(t, errors.Is(m.Resume("unknown"), ErrNotFound))
+		assert.True(t, errors.Is(m.Cancel("unknown"), ErrNotFound))
+	})
+}
+
+func TestManager_FindAll(t *testing.T) {
+	m := NewManager(newDashboardMock(), 1000, 1000)
+	first, err := m.Submit(&Request{UUIDs: []string{"task_1"}})
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	second, err := m.Submit(&Request{UUIDs: []string{"task_2"}})
+	require.NoError(t, err)
+
+	res := m.FindAll()
+	require.Len(t, res, 2)
+	assert.Equal(t, second.ID, res[0].ID)
+	assert.Equal(t, first.ID, res[1].ID)
+}
+
+func TestManager_SetHistory(t *testing.T) {
+	t.Run("evict past the retention", func(t *testing.T) {
+		m := NewManager(newDashboardMock(), 1000, 1000)
+		p, err := m.Submit(&Request{UUIDs: []string{"task_1"}})
+		require.NoError(t, err)
+		waitStatus(t, m, p.ID, StatusCompleted)
+
+		m.SetHistory(time.Nanosecond, 0)
+		_, err = m.FindByID(p.ID)
+		assert.True(t, errors.Is(err, ErrNotFound))
+		assert.Empty(t, m.FindAll())
+	})
+
+	t.Run("keep the latest finished jobs", func(t *testing.T) {
+		m := NewManager(newDashboardMock(), 1000, 1000)
+		var ids []string
+		for i := 0; i < 3; i++ {
+			p, err := m.Submit(&Request{UUIDs: []string{"task_1"}})
+			require.NoError(t, err)
+			waitStatus(t, m, p.ID, StatusCompleted)
+			ids = append(ids, p.ID)
+			time.Sleep(time.Millisecond)
+		}
+
+		m.SetHistory(time.Hour, 2)
+		res := m.FindAll()
+		require.Len(t, res, 2)
+		assert.Equal(t, ids[2], res[0].ID)
+		assert.Equal(t, ids[1], res[1].ID)
+	})
+
+	t.Run("keep the active jobs", func(t *testing.T) {
+		d := newDashboardMock()
+		d.wait = make(chan struct{})
+		defer close(d.wait)
+		m := NewManager(d, 1000, 1000)
+		p, err := m.Submit(&Request{Filter: &dashboard.BulkRerunFilter{}})
+		require.NoError(t, err)
+
+		m.SetHistory(time.Nanosecond, 1)
+		_, err = m.FindByID(p.ID)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/sirupsen/logrus"
+)
+
+// instrumentedDashboard count the reruns of the wrapped Dashboard by outcome
+type instrumentedDashboard struct {
+	dashboard.Dashboard
+}
+
+// InstrumentDashboard wrap the Dashboard counting the reruns, the other methods are passed through
+func InstrumentDashboard(d dashboard.Dashboard) dashboard.Dashboard {
+	return &instrumentedDashboard{Dashboard: d}
+}
+
+// RerunTask :nodoc:
+func (d *instrumentedDashboard) RerunTask(uuid string) error {
+	err := d.Dashboard.RerunTask(uuid)
+	reruns.WithLabelValues(outcomeOf(err)).Inc()
+	return err
+}
+
+// EditAndRerunTask :nodoc:
+func (d *instrumentedDashboard) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	err := d.Dashboard.EditAndRerunTask(uuid, edit)
+	reruns.WithLabelValues(outcomeOf(err)).Inc()
+	return err
+}
+
+// BulkRerunTasks :nodoc:
+func (d *instrumentedDashboard) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	report, err := d.Dashboard.BulkRerunTasks(req)
+	if report != nil {
+		observeRerunResults(report.Results)
+	}
+	return report, err
+}
+
+// RerunGroup :nodoc:
+func (d *instrumentedDashboard) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	report, err := d.Dashboard.RerunGroup(groupUUID, all)
+	if report != nil {
+		observeRerunResults(report.Results)
+	}
+	return report, err
+}
+
+func observeRerunResults(results []*dashboard.BulkRerunResult) {
+	for _, res := range results {
+		if res.Success {
+			reruns.WithLabelValues(outcomeSuccess).Inc()
+			continue
+		}
+		reruns.WithLabelValues(outcomeFailure).Inc()
+	}
+}
+
+// TaskCountRefresher periodically set the task count gauges from the result backend
+type TaskCountRefresher struct {
+	dashboard dashboard.Dashboard
+	states    []string
+	interval  time.Duration
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewTaskCountRefresher the dashboard should cache the counts, see dashboard.NewCountCache.
+// The refreshes keep the cached counts warm, so the pages don't wait for the counts
+func NewTaskCountRefresher(d dashboard.Dashboard, states []string, interval time.Duration) *TaskCountRefresher {
+	return &TaskCountRefresher{
+		dashboard: d,
+		states:    states,
+		interval:  interval,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Start refresh the gauges right away and then every interval until Stop is called
+func (r *TaskCountRefresher) Start() {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			r.Refresh()
+
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop :nodoc:
+func (r *TaskCountRefresher) Stop() {
+	close(r.stop)
+	r.wg.Wait()
+}
+
+// Refresh replace the gauges with the current counts, so the task names which no longer have tasks are dropped.
+// The gauges are kept when counting failed
+func (r *TaskCountRefresher) Refresh() {
+	counts, err := r.dashboard.CountTasks(r.states)
+	if err != nil {
+		logrus.Error(err)
+		taskCountRefreshErrors.Inc()
+		return
+	}
+
+	taskCount.Reset()
+	for _, count := range counts {
+		for taskName, n := range count.ByTaskName {
+			taskCount.WithLabelValues(count.State, taskName).Set(float64(n))
+		}
+	}
+}
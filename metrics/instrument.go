@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/backends/result"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type taskSender interface {
+	SendTask(signature *tasks.Signature) (*result.AsyncResult, error)
+}
+
+// InstrumentedServer observe the SendTask latency of the wrapped machinery server
+type InstrumentedServer struct {
+	server taskSender
+}
+
+// InstrumentServer :nodoc:
+func InstrumentServer(srv taskSender) *InstrumentedServer {
+	return &InstrumentedServer{server: srv}
+}
+
+// SendTask :nodoc:
+func (s *InstrumentedServer) SendTask(signature *tasks.Signature) (*result.AsyncResult, error) {
+	start := time.Now()
+	res, err := s.server.SendTask(signature)
+	sendTaskDuration.WithLabelValues(outcomeOf(err)).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+// InstrumentDynamoDBClient observe the latency of every request made by the client, labeled by operation e.g. Query & GetItem
+func InstrumentDynamoDBClient(client *dynamodb.DynamoDB) {
+	client.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "machinerydash.metrics",
+		Fn:   observeDynamoDBRequest,
+	})
+}
+
+func observeDynamoDBRequest(r *request.Request) {
+	dynamoDBRequestDuration.WithLabelValues(r.Operation.Name).Observe(time.Since(r.Time).Seconds())
+}
@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "machinerydash"
+
+// rerun outcomes
+const (
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	taskCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tasks",
+		Help:      "Number of tasks on the result backend by state and task name.",
+	}, []string{"state", "task_name"})
+
+	taskCountRefreshErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "task_count_refresh_errors_total",
+		Help:      "Number of failed task count refreshes.",
+	})
+
+	reruns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reruns_total",
+		Help:      "Number of rerun tasks by outcome.",
+	}, []string{"outcome"})
+
+	dynamoDBRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "dynamodb_request_duration_seconds",
+		Help:      "Latency of the DynamoDB requests by operation, retries included.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	sendTaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "send_task_duration_seconds",
+		Help:      "Latency of sending the tasks to the broker by outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"outcome"})
+)
+
+func init() {
+	registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		taskCount,
+		taskCountRefreshErrors,
+		reruns,
+		dynamoDBRequestDuration,
+		sendTaskDuration,
+	)
+}
+
+// Handler serve the metrics on the prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+func outcomeOf(err error) string {
+	if err != nil {
+		return outcomeFailure
+	}
+	return outcomeSuccess
+}
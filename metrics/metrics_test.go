@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/backends/result"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardStub only implement the rerun & count methods
+type dashboardStub struct {
+	dashboard.Dashboard
+	err    error
+	counts []*dashboard.TaskCount
+}
+
+func (d *dashboardStub) RerunTask(uuid string) error {
+	return d.err
+}
+
+func (d *dashboardStub) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	return &dashboard.BulkRerunReport{
+		Results: []*dashboard.BulkRerunResult{{UUID: "1", Success: true}, {UUID: "2", Error: "gotcha"}, {UUID: "3", Success: true}},
+	}, nil
+}
+
+func (d *dashboardStub) CountTasks(states []string) ([]*dashboard.TaskCount, error) {
+	return d.counts, d.err
+}
+
+type taskSenderStub struct {
+	err error
+}
+
+func (s *taskSenderStub) SendTask(signature *tasks.Signature) (*result.AsyncResult, error) {
+	return nil, s.err
+}
+
+func Test_InstrumentDashboard(t *testing.T) {
+	reruns.Reset()
+	stub := &dashboardStub{}
+	d := InstrumentDashboard(stub)
+
+	assert.NoError(t, d.RerunTask("1"))
+	stub.err = errors.New("gotcha")
+	assert.Error(t, d.RerunTask("2"))
+
+	_, err := d.BulkRerunTasks(&dashboard.BulkRerunRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(reruns.WithLabelValues(outcomeSuccess)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(reruns.WithLabelValues(outcomeFailure)))
+}
+
+func Test_TaskCountRefresher(t *testing.T) {
+	taskCount.Reset()
+	stub := &dashboardStub{counts: []*dashboard.TaskCount{
+		{State: tasks.StateFailure, Total: 3, ByTaskName: map[string]int64{"Foo": 2, "Bar": 1}},
+	}}
+	r := NewTaskCountRefresher(stub, []string{tasks.StateFailure}, 0)
+
+	r.Refresh()
+	assert.Equal(t, float64(2), testutil.ToFloat64(taskCount.WithLabelValues(tasks.StateFailure, "Foo")))
+	assert.Equal(t, 2, testutil.CollectAndCount(taskCount))
+
+	t.Run("drop the task names without tasks", func(t *testing.T) {
+		stub.counts = []*dashboard.TaskCount{{State: tasks.StateFailure, Total: 1, ByTaskName: map[string]int64{"Bar": 1}}}
+
+		r.Refresh()
+		assert.Equal(t, 1, testutil.CollectAndCount(taskCount))
+	})
+
+	t.Run("keep the gauges when counting failed", func(t *testing.T) {
+		stub.err = errors.New("gotcha")
+
+		r.Refresh()
+		assert.Equal(t, 1, testutil.CollectAndCount(taskCount))
+		assert.Equal(t, float64(1), testutil.ToFloat64(taskCountRefreshErrors))
+	})
+}
+
+func Test_InstrumentServer(t *testing.T) {
+	sendTaskDuration.Reset()
+	srv := InstrumentServer(&taskSenderStub{err: errors.New("gotcha")})
+
+	_, err := srv.SendTask(&tasks.Signature{UUID: "1"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, testutil.CollectAndCount(sendTaskDuration))
+}
+
+func Test_observeDynamoDBRequest(t *testing.T) {
+	dynamoDBRequestDuration.Reset()
+
+	observeDynamoDBRequest(&request.Request{Operation: &request.Operation{Name: "Query"}, Time: time.Now()})
+	observeDynamoDBRequest(&request.Request{Operation: &request.Operation{Name: "GetItem"}, Time: time.Now()})
+	assert.Equal(t, 2, testutil.CollectAndCount(dynamoDBRequestDuration))
+}
+
+func Test_Handler(t *testing.T) {
+	reruns.WithLabelValues(outcomeSuccess).Inc()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `machinerydash_reruns_total{outcome="success"}`)
+}
@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbd6993ab3a9230fc5726ea6bdf7b8bc5ae3a3edfca7619dbc7e0eb95e589890eb6b2c16c6df00213fddfdf90d804088cabeaf4bc4f3c9e89bea70c2929954aa5721199fff364381faefff4f37f9e7646b03f297fa9aefd7c38d99e7c949d675b56f786a31f434df6f70068681c9f7e3e3d6f7cfde83fdbc6c979beb8c7c373e85ef4e8d99295528b3f9e26b6e71e83bfe560fff4b3c5107f3c71b2ad3ffd7cb265c379fae369e8aa4f3f9f9efe785acbc79d1e94c6deb996ecec9e15a3dacdd275cbd0373065e540dd3ffdfc3f4f7f3dfdf71f4fab40b6f4a79fc1f1a4273f96baecbbced3cf27c70dfecb70fc40b62c5dfb2fe514fc977c960d4b562cfdbf0ce7bf94936169ffa5caea5e7ffae389714786a5fba05f30a5bf76eed31f4fde61a76be0cfff4e0904016e92e759751ddfb540bf08ac2d1f0f8a1ce8fe33e8f7d8f812fcd77076cfb66e83c187ba0747564e1f06404c0903dd7ffae349b5dc1340d0dd593aec66e73eabaeed9d02fdd9d603599303b906cc90edba374ea01f1dd97a76bdc0701dd9ba0577d68fbee13a3560de49f14f4ae3cb67d933ce643348369a66f8c1d1504ec1ad21f326bebad7b59305690ec873d47dfff9c392031d7db08b0c0ffd1d590644db7502192cedb365f841f240bfc2bf8ea117b8d91fcf72bc28f10fd5f0f6f188f16f0d7da9f972fe43578b3f35aadb257b9507f97c74ed221f35bf0c66598617186afe646fcbc8afacf95176b45360589857fe490920df262f6cad9bff00ed905f6a07f9814ec0dfcb64e117d57d29fcee9214f2bb3464602174ba76895ef1d7b37730ae4f7f3c01d656645f7ff6ff653d6b47e30c69ad3baaab19ce0ef9f359f61d12fd0d5abd740a4f0c473e86e813d53fa33ff7fa15fd69fa90f5b2df9e6ea33faf3620ad7e3cba4730950f4b06f87cd84171cb2f0d752f1fb55f8eeb3d5bee6e17a35d0390c99767b8515a403d2bb27ad01dcd7f96ed7f79f7b6d14247b65d4db9b79d2e5744dbed46c687aceaf736b2753b15def7b5731d28dcef6ae49c2cebde36475d33fcfb1bf927ab81518aad8eee011c9bf72c70d2e4ae754adb1c8fade79334d9a95e26ffef69771747246deea277d2c6ff57db16aa6bdb70d7b703763e8c96bb19ecfd9690473d8062aa0d6c20fb87b6730b8eb2da287d8ebae6878e5a04902f3ef8df9fbe76f873e7823f6fbd07ffd38fc73660c9f9d40ca75a86ee042dc15085e806bc7bd4f7b2a3597a99df71c0475dd39dc090adbb609f75953aba960e1eddd9d0d13cd77082fb5b7a4757d57dfffe867ed0bad1edc5557dfb268ca67fc8272bb83da0ae52ad973525dced5e8ffabf4eba7f9bb17cdd4f14df1b70c60ee88fe74e2364a684198ed10ed0d70e86db1ad406c65d5be044d76b077c3ab645782f1f75add5eae66d82a3e1ecda02878efaec1bceced23f2c63b76f5e43ef689ce540071b237055b759e09481a11608ff735356619b1ebd66795a69f4af935e96fead9ac4ffbd1fc9e3ad2d506971b5e1ff6e0ee5ebc7b3a1ea354ae60de0ec0f3988ed40fd73cd312a464dd38a92500f07fe7747c737f6000207fe87e958919d4836a00d0cf488e3c9fff3787202c3d6fffc708fb61c04652d4f39cada871144cf3b37d5a06b5469553f06c687f1bc7393bfcaaf7d4f3eeacfd7eb3e76cc20efb4dd31f40fa0e99f47ddd1f4e8ec9e4a53d5cfb2e5ed5dc59013c4ffe9eb4e45bdf9f01d37303ec2ec8fe2eb9d1ec4ad9e8ff25977fe2c2bf6707ccd4874c3e7f38f1baf3341d216ee19cc3d9077ade13db72c669a80c1c66a0d5ddd763bf74f3f90d5c333fc6ff99d72faf8902df779af1ff5f23be8afdb1ddd93177388753c61412086cae9038f2a0644fd7307d7e959d37df56878817bbcd12a083ddd6f03f32c3b65f6c0c369a7a31c54ceed1a60ddf68276dd825de707b2ed61a17d47f6bc4a47b6ab9d2c1d2ee6cec55930b1630baca46a7bcf6ab5f3d26bd44ff6f1d11af8c392cb476c13f4c9517104ac6d7096ad5385c720f4e96468b817b267f8cf3bf90a58e54c95208eb2b7ff73e77a7b6862825fffb2aa7bbf06ea3973ccb401cea680b3ff6eb7d2afbafa9936cf9eac1ecaa2bb65d3a3eebbd65956cafee796cd7dddd2d5402fafcacdc618f5e476235fddebb67c6fabb36c191a6e0b37b43cbabea7e3b8b6ae4dc52caf8504e67389d6e0583054f7e83def55abe115fc9f5cd6b3aa209e7cf4cbec80813a4212dd02f355d9715a800547d575ceb7c002f7a03b4d40504fbe3d0108d60235088719d4b4814212ec81004aff2e421c74cbd7c33d300bdc8b7e7cd69d33ce5173b0e493efb97ef09cc70a7cfd26ccfef4f141dc84c21d0318b0c80fb45640f9a6c0e96159786ae7fe09d403bfe675257a5525ca1d81ae1a58f01fc5958fe589d5402badc0808a5ce6174b5662b54757f76ec5eacedeeea0447f565dabac8394412aa2c0967786ea3ab271045a8007d4e3b28e7257b4afaa829521b265b665af79a42474d806e659b795b2a06f0844b681f303cdade01704900321a5abe752f6def0e5a0ac6bd946a0ee75cbdac38907c7931a9ccab4723d6003401726fa774523a8877b8ee379ed602bdce0e996a507867e0493085cbb24f0bdc30eab73f8c6f1e4f9ba93d83fa597de07493fcb1ffad1ad7d515d94f8a55a395092e7ae7294712fcc8b6c05faf1a2cbc15e3fdab28303f2923856e5c5d9f0cafced07475dd62e7288094df827c5f58dc07ddeb9815e3e5eceb215ca96fcac84810eec13fd58359852980fd90f02ddf692382e0271d576cfbe7a946dcc63e84df28e3ac4cafd0b468334e52ff7b88b23437fc6b1c467258ef1dd0281ff515d2d56f3da00c75175bf2df8f1d2161258216d60bda3611b8171be05ac9f13e77a030c6a2f37806531b75b30cfeda813c3021e535d47d58fb7562a87f78efa472be0cbd108f476bd27f67f03447e43a209ea0ad7e7da0e2a61bce3ad55bc26f3c942e4ed819f654d038ac69d8d4eb1ded5be85ea3a4ebc2fef6b97ba10da50b6d8f02683951ac01ff012c267dbb564ec527ba05ac8f7cf0f894bb46f14b89e6bb9bbf0be56a9fddebec5c538eab6eefbf22e615d70bcaabae39ffcbf0c17f3a82464f0efc026442e7f54e06c3d381a6af24f1a2e6a04f28eae7652d32d5304f4acd3ce709e5d759778f1cb00c0063f1d55dc0c9ffd400efcda178db38d21ce867ec1bdcde450e9716a9e625f348e1743001557de217c880382ff05f8214305baa503628600cc0d74eb59f68c67d53d1e75abd41d1e7467b90a8a591314662278f0b67087f36d88dcb3d5041733543ba8e7a3be33fcc411de04ee0650a9bc05865d3c3c688951f04027c7888560eab6709fe14dcaf84fcd8da789d8fb2e6ad4bbb9e50edda2505a5c9fd3fb73aaad37bc02470b8c45d4c078ca41fba0ca2ff5ab97de5c2b3c7774604ac3ab7ccf6a70dd0781870301cfe17f76a764d762002a83662f9ef794daf0d24bfcf2e5d786e6c8d8e799b8336cddd78f89d95906cb571279e18293b98269fc34f1bad6bc2cec98ea6bf35241227b53592b18a1d58f47185cc0bef4755bf6f6a9665378eb23d10ec7973ff4bd2e6b096795004f8e712d3f876bedebeae9a83f2b86661c4fd5294398e0283bfe877bb4b16f4f8e01347ed845238093f6002fa722701531840508c0e19fec701c809bab3eb8d7fec9f3dc63f0ac9ce055965a38385908999d6665b09deec06807ea9c074d65c7710339576cdab433643bb9c3d80a3cb9cb7b478ba3a7c283f2d41e27603a0141812752d6228bf87c18baa5fdd396fd03be412d1d016e59dcba063f00a3c8eac18de337750096eca835ab5a8080f75d5b80815f96d21a10fef34f4bf967ddcae0dae4fa4133f8d13d39dad1550c3c77c7c0f0d2aee5c63fff99fd6c44086ccb06b2034b445703e36cc47ea81aa8e295af9b40cf7272d9a51d64b364686e02ad2fc3f9703fd316ccfe33edf6b2a3f97bf9d0c48d6d5aa7f71d3ed34bb2a50127ec54af5d0fc8997713f6be35713323180b5a30541a21f2b83a1e0eee2bb7a1a3db786724bc297310c8789f5aaeacb5014fb7661b58e8f46b01a8ee815269452d400b719e1bb0ad090a7f657ae56dd0f4fe6c0bd0f422c70dd038ce8bb8335a827bb2ef07fba37bda35b0681ea18df7646b02369dbc45c0d05765abcd443305a51ef6a0eb9e6ca53e4d2c087a4f140fe1e94d9c97d2af1ea225a972fbbff675130103192fde32e5a4283b924f955ac35f8c239e88197c7ec344f7d5e4ab9696d0ae5733731c7850bbbbaad0d91c34fde35c23ed1a5a25fe285f6f3b99ac65ea73b9a7c9cd15c9db6461a316c05029f5ebb6090eded2c1a2dc019e85185a80a7378a5ac0ee74c768bbd286edb55d5e5bf6ee2087779477365e3a5481db738a1fb45e40344a500b5daf10642047fdc3d2d5207e90fcb8ab05e280aa6f125f338d1f643753dbc2df5cc4e4a65e7649d0c35b2625f883e35e9c67d909ef014faf03ded306de0abca701dc9cc060bca75176a33069e41d767f19ceb3e1187f25464ef224946deb2f784d2eb90302fe799681d94fa74f9fd5a39afff88091d77d0083d5e09f6724949a6b6361a0cbd60e7da47a27f4e7871d247b2cdfcdeed1754b4f72d6ce1e5a72141ef59d7ef5d0a78e1ea4ceabfc3b643ff57422d768a1e6807cd25a6c9230b46514e753fa32a0ac8bc00b70714cbaf22af127e54a89ee07b1a208831686fb6cb889e2163f4e3eb700ff3c2bc62eff131e81f0efe480b30de8f604ff3cdb272b303c1952143ef8d7c90d740ddeec4aae4e3850f6a44e44e44ff89f947a85870962d933c42a80ee42fd1aa47205fc3e1dc11b788fc3f5d33b8c6efc1d8b1cbf7a3ec5eedde49615bc78054e88e4772e75b2258eff78f64327900125138190fff5acc21871fa4bd395d30ef99d9bb8816b436f7ae54d42f2ca733ff491c79e77743f90df29c5123eceafbde55f9e24ca3b744f66c3e70a6cec57ccbcdcb10b51566008397f90efafc2efd8550e9ec6d4489c87f95fcfa7e0837c29fefe017ffaf207803beb8ee61e9f712e71752fab7b9922da4179ae159234d1bd010dff01a2a12d5cfa097613f0e978d6d30fda1be0f607eda319a2fa2d7b03f08d1983cda0393ef85f1eb2ac032c470a6ec27947f71ade00cc6304755049a8a0eef5d13dd52e941ffa893cc7bdadf196d782a24ef35aa092effc261c74a1fff71f4f6bdd0fb21c1dcec9b2e24759568ef8110bafd33ffdfc9fa73b3299b0b2e1a41944eece9bc2b8acabddd1e41946ca35d8729b1c8a3f9fc8bfc8ced3bffffdef3f9e3ee219b648f3f2f3d93b2996a10260e8f1fbf93f4f9a1ec88605db3b717e9604e68f27df88f4a79fbd973f9e6c205d7e5264e7b5f3a3437648f8e49f50fafc7ca2088af893a4fe24e835d9fd49befe24a8bfe8d71ef9d2a17b3ffe41bcfe24802c31fc7f6a805231d18078fdf93f4f43fdfcf4937c797d7da52802ae850e7eff787dfde389b30ce7f0f493fee369e2b84f3f3b3f5e7efce891dd3f9e3686f6f4b34b907f3c31e02fd070a9818e883f9efe96b57faa3bf79fc4d3cfff43fc01ffffbfff787a835a91a7c389afc03fe40bf1d2fb4177a8ee1f4f9c0f9e747b54a743fee8bdfefb8f27b61e9e4ae1b319fefb8fa7c19df07de318ecebdad0bd4edaa6d3f941755f0912b459658bd1b75cf5e0c3e9f6ad43bc481d02bc1941030abc607407fe3b831f5cc13f17c99f802eff0d1967af1f1f897f1e897f1e897f1e897f1e897f1e897f1e897f1e897f1e897f1e897f6ec03e12ff3c12ff3c12ff3c12ff3c12ffb4077e24fe7924fe7924fe7924fe7924fe7924fe7924fe7924fe7924fe7924fec9601f897f1e897f1e897f1e897f1e897f1e897f1e897f8aef1e897f1e897f1e897f1e897f1e897f6eb67f24fe7924fe7924fe6907f548fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcf348fcd304fd48fcf348fcd3bacd23f1cf23f1cf23f1cf23f1cf23f1cf23f1cf23f1cf23f1cff724fe49c42940ffb0bb2f0f4b9a05e8df71668494209e7cd49d20ef316f0087bb39409a5de859f5fde60c432abc27f2b9f4422f3f49f2af971f3dfac78f2e457e737a21e27bd30bd13fe82cfd4fafd7a1bbaf3f7a4de985e8573285cf66d8985e88fc91c29314d9a37ebc10af37d30bbd646d5e5f5f7b3df2c70fe2915ee8915ea8d5adde477aa1477aa1477aa1477aa1477aa1477aa1477aa1477aa176b08ff4428ff4428ff4428ff4428ff442f5cd1ee9851ee9855a347da4177aa4177aa417c2823cd20b3dd20bb568f0482f74679b477aa1477aa1477aa17ab8477aa1477aa1477a213ce823bdd023bdd023bdd023bdd023bdd023bdd023bdd023bdd023bdd023bdd023bdd023bdd023bd50e5eefd23bdd023bd100ee6915ee8915e08d3e2915ee8915ee8915ee8915ee8915ee8915ee8915ea806fe915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee8915ee837a7178269807e638aa167c575033f38cade5fb6e1fc7533e950153c4d4144be10748f4ad3107528e276fea15e25f1d0876cf937320fd134f9e34796798844320f757ffc78f9cecc431da243a4597e7a1df2b5fbd281597e5a651eea11f1ff35651e2ac2ffe8bcbc92bd1b9987c85eaf97b6e9fce812ddd7d7973cf350ba0669f6219afadfc93ff41c3bfa0b3988722e556a320ce5d983f2ac4031c727498112ee2866054273fdc4d0251912e7dac945cc43eab4923a18c990c9a1a759e8ee07c6dbbf26efd3b3422f236d3cddab833ec50d3ae4cc6477bf983da18dfbd1dcf87196a82d21dabdb336e608d51e5d66367756563fbcb83d7756c75628f39aab0dde4276f87e9dad2717d67cdb6d99bd55eabfaf094b57a1a7d1cdb663cdd3c64b4b352ebb15df35673b77f7cbe8b332cf598ac359d2a04f2a3678dfe756db45095f8b90c75b23c6b34768d4349a51281e3d43617ac68c22f732df39afdfade172d35d2f57875f93817b9e3b10f675b6220d65bcb5e6067b61879a21d92278e629f6d293a81f2f9348a4d875df664d3f98097d5275fabeb4764dce1609d666aff3900854c63ac9916b4aeb5d24adf7267c666f0f73833b88114b72c30de8f3acdaa393446d5e269474e0a8cd951b823e2d4b61ae670db4b725935dbfd1a0bd444f2d89efbc4ca249475cab04b706b04b4be42fe0d9458c0e5d2ef28319cf754508f74e8beba931877d6aae2c2cadb9c1d9c97c1cd51e75e7064b89914a72d4227b36e3977bd5f65f26114bb0d43bc1c2f6fd50e6c9bdea1c5e26d1db85a3a6b604e7cf5922d53b49cc28d4210d589a5b6b07385f7a6b8ad4365223d764cdfd9e8b1624782ef35d5b01cfd6da9eb5271df04ca346a1625b27a93c6706d0671b96e8e643de19baa664eeed392fed13d810d09c8d1691b85e5cc033d1995a221f5c14ca3a6903b2a3462eee79a4ac5d928ba48b0ee6ca4f43891f1d55a6e729ce2250f8c50b17891d75bc83bca1dadbbd4cf7cf32df2566fcd5999b872eebf43bb8f194a17b65cdb74bd2d65628d046da2bbce5eb2b3212f96e0468a8da963d37c8bd3aeefbd28a8c748123249ef017f6d553ec8013796e1d3f2319d8c760ba9678ed2cadfadbd56ae26fec9ea1d0cbf36c309d48fc959284a52752efbbb5bd25a5d5c45f08534fe42ffee4d03d6bcc8fdd861a9dd470e2abf05f329284a92719176321f42f0ab3d92da89eafd093dd9227cfb27d308ae3f5470adffb97bc9af8138bb324aa674d46dbe964c475157e7a5606936c9c723f709dec1ea08d2df2a4a78c0f81c2f74e0acd5d449eb3e616c7acc1ef905c49bc462acc289cbd9396625fcfb377f2acd8235309af4385ea460a75ddabe1c55833962109d3bdc65867c5e8730ad53d4fc05ca81ea9da1690157349508d1903daf7229519999240fc6b36745f445e222461e2cf4dcf9078e9acdadb57d1ee756602e7e9901f3d43a1a707499804e0f907bf2714fefa5a9ac34b818e66e0cb7cd79af17b2be1d3ebcc7c27e721494bfcf4280b8b4063b61dc077b2e059337e74909d6d04e0d8e19b55841b5d66fcde93a87dccf303d254a8eb59353d20934f2adddfabf6f6a48dc1de046b7eb566cc2892f9a53563244fa2b8bd3a5e7a0ad5f125db7234610a9e033967a9c6d595f811989b2f335aa8d0dbcb8c81b2d157ec11351b73964883b6dd5789b12295b9eef5b50764a829d3a4a150cbae4e917bd5d63cc5742f734a3a83fd22d9a340e6afddff27f83999b34a592fd2dabdaaf636489f69d416ac1b313717177688e389cdab18f707e4f7953537e17c1d1092b02766fc08acf7696e5f2dc959bc8af6c89429b8462729e301282bed8f6d4088fc149c4f963e248cd9fadd10d69ead501ca946de49a117eedc96ce22bd8d6682e4a9946528ccc6d3a91ea931603d362fec60e42942ffac3acbbde22c7bf2785ade0726582709cabce9591f06aec45b8e3c5ebcb0c3e0acd9db50b2c199e551b2c079a27db53ef8dd75c6ecc219b38b66cc8e98313b72c6eca88c670c9250e837d03e1085a923f31db0c7088de90573a343025a7e08fd57851f8512659d66c2f2ac0e019f65ed018f100a45bcb0c2d45204722fdad390a7967b8d790f147aea8176227f0934c6221466abcd9891213a5325fdadd34b4b1f2f0289d99a0a9dc810d38b750cc8ab9bd799a059a21d78da804cd66609cebc5014e0be7ad1f8ee4112a6707d27ccf20cf4176918dc036b6ac234027c20335b5f1d06a8cc89e961beddc22350a9c05307404ff15f14bb7792d6581caa7002b997986528095ca4d7d1761dc46797c0113a7fb5e676f7acdae45ea9f0b5e729f61e9c67c407bff4674ccf9f8db77eab353747a1c4933d85baec14eae2837f35fee26b7cfc1bfcabf1d79c1ff829a0df5959bb970f7e49e8957da75ed8317990c6f5fc85f0922ff1123137fb3dd1be9e452ab86a7c8f9084aced0b3b78bb4c86318ea271053ae559b1b5f42ca0d3fd20da3d5f62b6e187c005227ff5f5a28ce8b0836d4fa5b7c66ccc91ea38b82834e7257c174afc15f02025e5f4f6747bf3c2451b6b4e417e0c64269ddfdb2bd8771a639980c745feea4954e745b44791c403d8781c9dcae814cccc0909f057e9ed45a7e17e0a66e616ee1d9de2ce0ad30b51bd3739a70e12cf015d9590f9de09ac3dd8177899543d97e6f61eca8762ff1b4a34597cffcef604e8a73016e44d309e36d82bf2786a4916e1a5bf4570fed39cf64b08d2b133de9be3f7f549a1bad607ff0ef89ef8350a5cd5dedac2ea90fe36156614a92418a384b36d9d64661bcac2a28126db9e48f50ed2ea7a14ed85af8ea7d66ccced15a15fd117368404f594d9766a49f4d6178589bfe6b72785f9e103fd47e4b9f3ec9d3b2b0e187f14cd065356e6a7563ade04ea423fc0393654e86d28f3db70f2deb5b470e217f4a9d2f904d77e3cb5daef47e255a1254bb5255fa1d51751d8124ae4078ac006c8f340a5975d85d9bca814172ad4d517ed51f8c14b9e446fc3ba3d24f3a4a3833ea0de32f2f3338ff42466e94beb5c0ea6fd27f22ad26cad8097cc580789d99ee64dfd092438277d4940e42b387f99d145a5362ff9df601d4717c8334e7011f9e541e6bb4e72fe9cb8680be9a232a383047834cc65e5cc5413b992f17a665f257cb35718cb514c2f9637b97e06d6e82001fd0af645f634665f6e53e1ef74cf236743200a4b531e901711c86d67d953989121019d35d7e13c253edb8258d6f938997ae2e233d5d004804f17e80321d8973361ba97188b04b6d4073f2521bc99e81b7470d684253c13e6e6e8a20ffa07301f895fbc2af496807b7aed92ea78b783bc14be01796dc89405eca2444701ba8c1aaf0d3ded89ce16f0e369c6582775bc25664cefa2a5faea98b314666b6a832b3cebc09c4594df8641d1e65a23342cec0df47949ce667b7f59c145a77a94244c6d85e9d17327d5adb6395c8adf38398b8565086c3ec9ee85ca3a9147db00ec19ebef949616f92ad2db50a57ae1dc89ed4c4998f6546aeb4b3c47e8b47656ed45a0d1d3bd3a04fadd14f0418fa7975d95d964630bababa28dad8bb426c1d96e6923c24f61c0b9a0f01621acaed9fa223c04f4764b14a67bc5e6acb99de0e590697f691b2d96c5bb17c05b706ff10b6f360a085de85b7f0b534ba5b684b04e75d0a5a75223409fc3af1cb748e3a7812c2cf17d31197f55fb1072bd29b6d597968ac3d174818df992f1176f9d147b1b56f1745f663c79d60d32e6e510e8485d60275571adeb33c3b7a62f449e80f33bdf53b95e05ce1199ef5e3461998e6bcaccd694a9e9591f117ee95d28f24b4f21c946bb754ef7818e07f6d50b3b26d1bd82eaee7b4d589ee734a007dc1380b70999e7f6ca98b4657eeb4b63ced2c64100ce098db20e1ab3037bac30a75496a0f3037a95c26c1d89ef1e746ae9a974df1785c34b2a7fb2bed66f97e91aecdf5d507a861da322af4a7a13bb4af5bcba3dbdc86c3099efbac06ed486012d331601ec56e82b58e77b4c1d4fcf12a00fcdddd4bdd2bda638db40b4b721e4af7c8f25bc05c680f388f926e7b593c69386244c21afe53212d86c905f2ef0ac4ef6ba4e65fa375c436013c6bccd817d6cca233297bf2bf22cd95224098b97d97a0a7d5e79ffa38bca6cf7408713a9ecbccdf85fe247a148ed8b38a5639474221df1638842ff02dbf2dd989e40ee15e961cbfcd59a09db8bc2f4f6d2203f77a0ee84d815f3fa7ed3363d30d7583ee7bc26f3ddc45699fa0ac51d3f048e54a04fd342cf465f163862c65b0438e371725863b60180d5982dda2e95e5aecc2f0f12dfd5d0b1211d57451b7b66efaeb341d7658dcb491eb2feccde11e037b702bf455f1ebefbf270e28377f270e1cbc38d2f0fa516bcafa53e06921dbebd266b9edbebab4ef8b1eabaeceaeab24269bfa47637786f5c5dd629bd77804e409c629cb854af857eacb9f99ee846640fce85d91125dbe73a33b3f7e48cd991d5f7936c7cceb8ba5c797c211e5f61b67b695cd9eb610d1d2276d8efcdec6c3d82f2bc39a7ae5d2dfd0eb2c05d1466d49dada7253a6cead6a3657f65ba2ebed8dfb2d41f7b7f7ffc3eacd80c711fb576432efbd1730eead057a0ffa954cf97f9c54eb535435ced2eb3e19bcf0e2e277665f5664e6c3bcfc67536f4a6627773c3b7cbc7aa0bf039ce18806b90ebe8917b62e13930dd8b54e0a8768f54ec4590f9df28c994ccbdc5d280b7ac481b90a4e2c467b234466c001efae35ea03f0fc889d2b93db3af9e4a2f8254d6e8f9b91b28ccd6d6a0ff2a8629da34d9b8996e2e0b4b4b11826c1dc19995ac4de283ad6993e95bc03e0673dc7b0ab3f090f50b93f54e747ce224f35d4f632c6067444a696fccd76f566a6f68e3e95e71381b9c8773670bce8a50a44611c0bfc66f52e18bb2cc60339910cb6555d89e3566134876ef0cce3a9cbe50ec6307f483cc279ac6a03e56dd844e2352a19716d0d5a0dfdb745f447b0be66041fbde595a8ab378995897901dbe13c2f0edb258bf191fabaea7f06a20d95752e69740ee766682e649e3a53b37df2f8007737d62446a4caf37b3ad400a49421e8333bfbb97f96b9107cd6db237eafdb9736a7a566d8867619f4ca8a525ada716672276b13d3ac8c2369a1b99ecc3e94ed95e8636174d9cd25840e3f9b8eada32af912ae02b60ab637c83b3643e159921e463ccf8d4c6ed96d6ed805f379e3b4be06c2dae313d3337566e5773d67cc85973867dd5a85e280d485ab54797b91dc7ee6602b091963d71dd35156a6955fd38cb9e4c4d0fc8fe7c9999a02db19b994b0c9f56c68771d4ba7564cdf7905b4fbad95a1ab19eae096c320e09c79f308181ca17202b4bfbe3829575d1dbe543e88752413ec4eb56f2f974b8b043a2744e7183ed57fd843e75b630c6ff15d33b8e8346b96e3c73407f6424d2d3b3c25cf7a27d857e267d80f045b480b150d477a4afbdb44d0fc6ee9de51ef0a16a5c4e22d53b69ccc883b6152ff91a6f1d66832e8c4588bc75027b406154bfd88e0ca441a9adc005e5763a732ddb1d88af679af858df49802fe2eb49fcc4f1f33c363145e4c00f44478bcf8b78df916f0abf3dc8fcfbee1743827d44cbfc92908749ec7960bd36ce5fe082849e79bb61ffa28fc9de82212d89b1f693c1be609b25716b4f0f0b736f45afd258346b368f355f1fc21663f952585e57b2b2a62a4596e4ed8162c7fdce87504bc334cefe4de313e5761d655c96adef448c137152ed1e8dcad2194f46805f25616f28f40eb5136c85d976e62119a82109ff9e095a280a6f2fb13f27c03dcbce52d58ef7d16c5de2bf84cfe2e7fbdeccee9e67bc46c2bb340e57e5d3820d9de83463e2a4d83f02890636e53654a30ea0933f1b744f4a483ac0b6928469f4b795f8c943b707df6f49747fa4bac5658ed17d3e5680ce9798b603e21ad3fd12b0ebb7ec19bbbac4e3aedfc3ecbd913e635338227dc765f062fa8e4edfcd07e9bb43f28e84f671fa3e931d03c017393e0aa32238c5ef32bcf8ab83e206610df41d8bb6235038aed08788c2d1285c8637843b207025fcf945fa2e90107a2afc02c51fbecbf1270f05fc01ac81be63d176040ac715fa1051381a85cbf107700704ae887fb6cf0664a4ac72fc558a40f187ef32fc812c47f087b006fa8e45db11281c57e84344e168142ec31fc21d10b822fe3a93f232d941f95967509e8edfe5f8ef0bbc0d610df41d8bb6235038aed08788c2d1285c8eff1ee5fd4ecaff3add3fab9495c4dba696c28c085990ac395dd46f31fbfaca09fd0e666fc3e7f1febebee6f26f17a8e3a925d9db50b5b787cc564fe51c3fddab9415c1f836da86e945b2b0f43400bb4e61819ea582df551d3791c1339e3b2b633660d79d7fe9888c9d9b6f3b76f016dfafa1e018492c2a9e6755b7dff666cef4ac8550a78b66ebc93f7e3971dc4b027acdd0bd4c866f3b6e5dee6f53f15f02988f5517e00eed4d3524a2bf0d0f9d6fd2968d66261bc1ff0f81ce978fc346ec29791355fca3e8bb121db821960e21b72af50f75dbc2791f72ab320d36581ab066990693aa0fd7acd080c2d3e09d9a9922c581ff9569b0164f5cfc8eaefa889177c01685b207da64d53198584743fb8ee39a4132f7c40f5ea407e49de4fc0a58a14a87f9a093ac025ba2c7ae76edd03659df381a17e75ee069cee8c4f432d5f21a16de65fdd3b835dc94d790acaee126eb831b63fa28f2288a63c486286d0a3816de65fd63e8cb0ddfeb6840b0ab5a1a14de65fd7f6d2fc77d60e8c8ad777534208bfc51d4e3717c30c7d098336bf9806ae0030ac707731c8da3321fa8153e0030192fadfb983dbcab9563f361bd1c2bbc4bf7f0fafd8a915fdde27aa3631faea8fc28d910c8bb6d2fd337b1fb0dda8398b3032b13521bdf968569a4a1eb3620535f4ec0ae83fc6f8138a53e1ca04bd7b4b9b25190ff4de76d583cfc654ee5f1b659ea735d8f0a676bd2c70b82cf359f0311a2f49ef1697f93d7ac9d83e04163f188723c38048f25160f6e98e141207890783c36191e1c42c3c27ecef1a0723c24040f0d8f474e6b1ac1a383c76397e1311fe77814f6548e4737c7c342f078bfe0fb7e47d6f1fd82e07265f1fd5fd1b544798b5d4f6bd61e59cff514e0644b0e676903e2aa57eec4ed0afb52a1245ba5b6c46c3dadda90a5733887e5aab0f17992c622030eb9a797e894e5b32187152ab065399fc33a155812ea77f99ce9ea9c379d5c8615603b5558912aca950cb65b8555c9225dde2f187a4735635fd9eabc0bb2f063d30f247ee989ab3757e181bd9fcabe0dcd39fdce2fc1cfec9a02ef09fd50e2254b75a69694f91edf5e133e334481f3e26f270a6d621b02d80de67b0aeb00fd13fcbea953c7761c5ea7bc4ffe227a25b4e542bc6eb929f7495675ff4dc59680f66584d5a34b7a4dbd2e5f1c832dae6f85268b1a3dbbb58e56a60789a7c7a44c8fb04a8f09961e9c89b7af8a3a48bd5e5fe20daa7a2ee7b676759c7ea29f5775fa940e893e8fb51373bb7e84d35deaf4b74efd7aeeaa7a0bec7ffa7bed1b3806875b57b2bcaec0de2bad2b59ec67f9dbecd17c8c2d668cc5b58e1e257dbfa883a2ef0a63485fdaf7793f1aae9f4e1d3d4a7a7fc966a8e38f3d660cb1963f4aba7f710cb38e3f2ccc186a993fe82a7fa825fe28ea2ae99ea8977fbb06f9573edf101f1fceae5ed7da7ddd06bbaf8bb3fb62bf22765f2671b6ca1984952159fc952202c9b64295c6ebb6b3f5fb6bfef728d7fd004df9ebbeae1dbb6611fd8c2bb62bda5c799b5c8f4674c698ae357a663e46694e2c5e9f0e73bd745ac289c3e394ebd4510927028fd322d7efc7459c38bcee4be6386d4b3849783ddfcc70a24a38d1789cd41c27ba88d31c6f8775729cf6259c2c2c4ef35c7fef96d7aec6d6bb22eb7d654bb4626b6d3e64cdcbfcb87eafb1fdde91757f0f51dd1a8e55d5db2f1598aade5aa35fc7fa5b55272eeb0805f8a80a3f29ead9c91957b1414a6755117e5b812f9f3b4578a90a1ffb8b0ab4e0e8aa3d52f2ff14e0e755fa96fd3945f82aad69e8cb2dd2ec8a59b74e9dbd95f254797e05d92cb48d435f02855ffeafc708e218d937c70960bceb3b630530b6f61f8b17c018df77c60c203dbe336e00e9f11f881d9087df193f80bcf71b6308b0ffdf1c4780637c432c01f2dc6f8e27c0317e734c018ef10d7105d8cf6f8e2dc018fd6f8e2fc031be21c610cbe9df1c6788ef44fce65843720fe3fbe20d8134f864cc815f7c2aee00d7e29ed803a4eb3df10748a3bb62101539742b0e0171ba27160171ba2b1e519105b7621210a77be21210a7bb621395fd782b3e11ef893b6314098fdf19a788f7f8bdb10a3816c67fde2fc3b48f69c039df11d780f0b7631bf119d73ebe119f57ed631c31bfdd8a73c4f7b4dac73a121e6b1def80f0b7631e295fb58e7ba43c7523f6517f37b43b5f4ff3fbb503d2978af2e2a2da5b5b12a6a1c467f7a62f89ac0f447b14c902fbc20e8b6d92bc0d94b476af29ac444fcf5a047edf8c53c03b85357aec5dbea882fecea8018bf7cd93e53e63bda4a82b54ed19789f11afbf7f8f7e56a6095113ab68edd32ed383c3db786185c666851e219e1e624dccea1b756b460de23be3d571125ba31a0f49efeee577d0abb62a729f15e7cfadd5a7eaf5cb0ec6970bfa0f7f737c088c117dc94e45eee8feb6d85e3e06891983a8d72d170dbae502a75b8231a82fed7be42e32ae9f7adb74d3101b2b9f2dd9181dcc18543d7f880dfc513e8fb231ba9831e8327fc4f648f15b87127f147db0781ba3212e52dc2f58fb14de37c7c5230fb5f1b1525ca468e3ac31f1b1e4defa97fd5d997e787566bce4a90e87f7e51bc435d3110d02d5f17d29247d51a86967be237afb7b546c47d4e8a36fb98e88eabc80ae35ba683ec6a838a73a3d348f1b84259c223c4e480c872ee2c4e16d2822c76959c2698bb70d727d9a2ce144e17112737bc529e184b7a1e81c27ad84d31e1fcbc86da84e09a72e1ea7436eaf08a5b5ab8b75ad91f55ef74b78bdd7c5bb90357f2ff163c92e476d1613b1591c548f0563f5f1dfd11460307ef31a7f3f9c73558f2feb0845f86a3c212cf9fbe333aea26f97cfaa023c59852f9f3b05780a63575c8a7a3fa085868991d4d962318f55e0cd3a5b0cc05b55f86853a51966ddca7ea162bfef181bb2e8e3a9bf7bf51eb2c33e6a7f7494f1ffbafd01bf49f96efb037ecbf29df647fc3dcc7fcafe80dfdb7ca7fd01bfd9f94efb23feeee7b7db1f00efdf687f40defb8df607e8ff77db1f608cefb03f20cffd66fb038cf1bbed0f30c677d81ff05bb6df6c7f80317eb7fd01c6f80efb23fe76f037db1ff1f78abfd9fe48be7bfc36fb03f4f739fba303ecba4fd81f602deeb23f205defb13f208deeb23f2a72e896fd01cfa47bec0f88d35df6474516dcb23f204ef7d81f10a7bbec8fca7ebc657fc47be24efb23e6f17bed8f4ee53ba436f6071ceb86fd0161dadb1f70ce77d81f10feb6fd119f71eded8ff8bc6a6f7fc4fc76cbfe80b4b8c3fe4878acb5fd01e16fdb1f295fb5b63f529eba657facba697edb82de5f9b2f1d971326ed63d527a4c1e5a431234361363b8dd917f218a5397071391035a67741f2de24b9c2fa9dc9983b2b8c75980cb883c46f42692df566ce12e689998c97b01601f8179f1338cd7587cb8d3ebde8837ea450574f1abc9912b3b5587b43a17311edde415f05f1bf48fe5f88ebcdf664a414e911a81451a1094bc7b9b4529834e799c42f915c68a35b6325b84d2d69501c33ef6f01c7fe6c9f32b3dddfe8db124b6364b90657b90e0bf38c24eb07deab71ce7c4ba5d99dc64ccfd2f8704cfef5ebe1160def760defaa38c2b86f0a2f70846a5b97783e10879d369ebc28ced29df13d7b26c0dc9cae422d0fbf84c01079ee086d67be7b80b61bd30be7ced411ed77971d5c606eba99f946fe42d657667a94244c0afdc7cfa6b8ba0e6dc7a03998ff2e9d475677c7c73cfb47817e85e7fbdadc54a2b93f88bc6423fc71516d0bc8f0ee64bc84753d7ed1f1bfb30116e650f3dcad7b9eeded5c2ee479bfa89151c2c785f9a18cb77cbf8da71e9097fada8bdf3935f434d8ae647307c99610de8c73b94f30b8a579deff760a73ba3dbed925e4f11d38a0759256a81cd99a0ad53d005c4a384412e8cf5ede5c4f69281de6ccd240e515d2b67e2f16702acbb71cafd2ba96fac6c98a2c479ac152135ab4a74d6b81f697ef9f3ade8df6a66873cdbc82e0de7a7df16d00bfd6e48b643b2235352527970759bdabf0527d16758bf4459ed7f31167b2d48612a929ca47a44871cd32b600b3a879beab79deb49e6c47b217d739bf6d5c4f8de74c49e0a2bc86421d0d275789910cd1a9ca540c6e096f4c8a32afc5f8cdeb88c181efda0ab28671bdb2e2faa5cf1ad6ce90f80d29f128ef5b27c9fed17036a6ef1798673bccb3e6b5e26cce969851d35a798a2d9d6fafd3c89098a5c561cebe124e6dd7a8306ef3fa54c7ce6ac5216b94d78f2bae13fabc61ad6cc9de5aa28dd04ad0f6aaddf5145b6dd86728cca2e6f9aee679a3dcb4257b697354e33ea345617a8239236fae9f644bebfd9e6d5abf1cb7b66b5819bf791dab38a4b5fd10b97c10f9ae531d7bb9576cedc699cbd91ccf166565dace28e9ffc8f99be250d269523c4afb2eef2fd5a1013e855a2270aedbc37c2d76a5c6fd92cee9963ec3d92c3fb988a6d6c40b29be6dd70e03dfa05f98238365263472e626b5e8723a2a0ccc4b581af70a730ddee08b03e0759456495f0d7232eeb7b466094e7d42aee27573bf89b6644a36d744e3a4e6e4cdf5b2446a6b4a66a3fe59eccb2c9d2d155eb926b945dbecb3ad29f19ccd39857d16cae1a5f8bb2427d3674dba0867039d6754d85faaed37ac53fa7e8179b6c33c6b3ccfbadcfad09df38d6b04f4b7630bbda32bda53e3d6fe546dbffd7e42c6bda16f54c616790ee6d04078792fd2f0bbb6d2b823338ef736acd32d7bb28a7b3ad60ddddb0b256abaff15e768bfcc069d0b176dbc263d3c9d575b1d1c035f4bc74acef8a18adae6601da1cd89f0d66be12c326b6db7881b727b6ef886e341825b2f0fdc3a3f97011de3b300913f6324a7f5f040b2c30d5d9f777ed39578ce924cdc3992e4fb2eef653a28e70bc7f79dd6ef1d976541bfbaf7c3a2dccc9e359d73d182e49805c909e5fe317ea7bcfd4b151f44771f4f3d95d91e523fc464bc0ce776979007e459324858d3e417d33b4803eb069f4e486e7509b9f5c667cd0d09f9b5b00f205fdce7cb69e29b74df81b190b1d3fd877e175bfcee4b2567e621fd3636a5432809dc45a1ba11940b3017f3cd9a39791d9f90eca43178a4be0afa3ecdb70df53d8de9912285f8f284a9a5d2fdb3e270402e042a45fca3ea87db1ef43c4ffda574f7be18cb34d5d37cbd8fefbfd48ca1f0cb6a8dd9a8ecc7ce6b6dcd845d568b0ea98d83bce74cd5eef9302f7de4110abd356584ef547b1ba94cefa4521625adc8401a766ef86dfba5fb3d856fbdbaecaad39d8fe3fc4a3563f8128dc9d55ecab75ac88b9e7e478ad4bb29d4dc4b6aed411ddc599e359e7311795ce421feeafc6ddcda97642d9fb2ebf7eefcf61c3bcaf87f718ec2de6f33473c0f6efff7f86f7d9bf766b674566d228079cbc7d3731b3ae7b529b8bdc2b02ebbea90124fec7e856f69fc06fc0d73b6cf0b7510581ad692586570a59a04853a1e85fa2bd51aa5ef276e7dcf798895b1be2cbcbd14eb2dee6ae31f22b53d70cc728fafbd10d7179ad3711d3e39a9cb5b3eeb2783ce9513d89dc48f22e8af30c8b3262cfca4c69a2bf2cbb316be9dd8f5369a30dbbd4a6d60be0385de12a89cc8f21a8424585312e036e3c9735eef153cdb00d90f6baac0f19d25e0f9d7a4d68a57a85d0aeb6af4426545c27cf580c7602d3a810d24617f11617d353c6d93fe92ba908b66fe1a16ebdba9b6759232bda354e335ad759cd2254aee3d0ddeb2f5fe5895e7777991ec9ea9091c122b5974b9f51bc9454d7e1a11ebeb6299e901bc436a4cc218a40e7896191d604e0878afe46d97d6f9c875c8f7900dd3f37a02f5481c8df37d9ed4a114fabec87396ccc4f57e754ccd4f85eeef45ca22f435fcc6b54283b941060aedc27a6222b57515ea7a28c68f24938b36b4340cce2a33326561d98def8b60d64b6083b836e0e23bfa4b6a187ce35c9daff781d486f42b388f8210e8fc0ad5f5f52d59efcf585bc046b7e754ef22f29ca78d0f2fac407a8ad327b5b42624bfdc6bcc56ab8c31b8e2e0089927c1197b16a9912f54f12ed76c0c005fc9a32a9fa57545d3da811a6305d2b60a77abb6de3c8cf90afb8ec2d6f88b24fe6a89f4a2cafb49edf854ce68f6c8d7f8ed9dfb56aaf2585af36f50a157007093a8db67727a7f2dc1d917f9a9a58ccb357b3d53e4afe6af412742cebd6be9dccbef1324e725720e1e274370c61cb0b5c75bd791056761f62d4e4c8bb836ef2550180dcdb50fef4c005e12c3dda98c03aeee701d2cbed616a65ed3aa43626939202345a8a567780f6ec9d839ed8b758a4ef3a18aafd599d0adb257a04cb24eb046720b7da87057238ac79a0cb1b5442fe5bad9b57861eed0d4e936b5e7af93dc8b19c73a89ca00fdaa4b20fa4bc6f3ea78b703f673232d30fb29ae7352d97f805eb06eae3eaeee7b687722fb23ad3d83d67b45ef9c61dbe77549639e19be9f387e1b4c06fe6e6636e9a0b19e3619a4f55befe09535be3e5a525712431bad4957bec678f83bd6e9777ea17568935a99713df3baba76f8bd86d74d3958172cadf38c39df028dbf12b2d0f7a52de137c0411c04a15cf70da9fb5ba1c31553cb2eee17ca73a18fdb27d7024d05c047583b3ebb1f08ef5ba5b5eaf23d1d2ab486b473f37b90ccf4ac0fd1bd0def6116ee642675b0e3fa6f14ee59a5ee61006b9894efd31944523b299eb76af7e8bf8db45e11f2ac5dfda39aba61db8b8eec1558d7990ed26f79129be0ba8ffdbc389956adbb82d44c4dd7d49578ee98ea6e855a2ff6c850a99eaf094bf45e203c6f62fe237035a5901a8d29afed2d91f2537d13d1cb96ff28c324679c8f6fbb5490b6da87519ec3752fda5bbfaa2b2e2bb8a475ee71f21c471bb82fd1fa7906bcb39bd5d2c4f35f5683b35ac72bbbabc859b016b919c475fb0dd283358323cf94600de109862fdeaa35ba8c0e5dda2731bfe438ee6a68fa9ae6c603b6ad4a031dd532cbebcde2ea8a99ec35935b987bc540f793f94520d95b4b62e03957a9815cf1f764f382b55f33b9d854c39335f77b2e5a009d8482f74a0724a100be65ac4b2d3fd3716dd9d85793d5697d817713e0b7069b2aae98efe49acfa1f29ec1c87ea3d349fd2678d9dfa68664eac75e00fb94e2c20b355f5d4ef3d501a9a53bdd4b8c45aa595d51b03fad68264880ef0e1f46463b5be2b707384e7839c9890e2f83bdeac4b0606d140a9c2d6ffeccd1f66a48c66d991121f18bdddc69ee53a346d9b8a2b0b4a4c1db4bfd18fdea194163f407642eb341971673bc81cd759854f59c744c9c8f209c33ef3437c4d5b4c2e926b17ef1ab726fd30a445eb3e6ce3654063b03d047043a3f3f72a4552fd26cf5a833a43f1b6c2291e62829ec7714fe7a5223c291c74b421dbae719add15ad8a5d9b07b566df5cc9a6f177600db3a9371bc77fe5ea99d69d84ff9a6378d76ce642c7992a00d147ad79b46f1773e93e1ce99ae396bbae64c951911f2a00f6c2affef956ab166366767c22c7a5382086726bbe38c0ecd12971367bc11337313892171e206c4756682df0b0ac87b6ef0769d99efc1ccdcfbecaa739d0ce1b368662e0236ec74c4b043cd561d8a0d019c18cccc090d6058a3432b0382980c1727ce668399b98866e6269899bb648cf7132bb8ce2cdc44d20a8c0de76db1d4c6a8d05a985ac04ed586de4909c95012fa96282ccafba57076263138d4de8bed9341e79aea8cbf56fd4c0e97fa8af76f66ffa999bf34b51740dba46f3ae795d44eb430366ed7cbf6d2d0b3158a2355fc7ea9daddc9be49ed6fdcddd9544eb6f47511ece072e54cd5e7cc43e6ebaae2d26fd013d33db6f0a1ad016d95f7c6bd8bb321129bf03a33b3f5496878685a5f207377993d1921ebb3dea5ed77c91837e0c05a71a44ac33bed99ef23978d0bac1cc4b4f99acc41f888353a11bb9e60e5ce7f40de104579b3c1c91b6232dc34c99b34769ec99bc9b0cfb283b7501e2e5f94f5db8edb5c2e9321e772e31a19b0eaa37b3d5bcb541e4f9844b7327e74a02dbcee5ff4c19554ed8b3bb1c1b9f0fe22f3e45ea2366795969c5ff43e5006178ba53830c60ed04a71d8de94da13daf8ed6516f6688d564f5ac49e147aeacca2c9851dbec1b6d3b09fe80584331fa8bbd43e4a643325f35b7a61f73a7fafd4e41be5b7ce349d13dddf6bcc6e27d996af0c0967ba9e44e95e9d86fdc3df2b8d03eb3d198a272ee2d89929eeb84187642928330376d5b970830ec11940662e764006cf569d8e0264ecea2d7e167688d99a3dcd19f6c419c48933590007e472c841b93c3971d425e00640ae4be66cd521d8b043827e9231ae3373f4320d7f00da5b2cc5c6f35e73d6c438ec602cc628ae87486d4f1ab30d27e3584785e742aceb9c331f542e73537f9b37611a64aa409c807cd3985e3013384b61b6a67687dcacb41d7ac57634eeeec2a423ae5522f615ec0295daef25a64727dfa35e2683d85f3019c7717d6ef8e6b36b919e0dc5ee6cd009b982ac2fd84245fdaace16c2ca16bc6dd5dcd7f5b532279cbde0f4c9024dc71d8c2eefd7d8688b4fe98c37e793f6c34b96c42fa1bcbba1e715ec5a8c4e9adb0895fb12012aeff3732a8d6f14f56653133842a10abeb2c41f7a830711bf5275fd70e357ecdbe4ccd87e0fbe43f745b4b7807e560d6d716d637e49f7d1b8839d5fc2cb2f737b6a017e9384867d86eef12a8dd073f725e697c06a3deeeab36bf289b1b073640991da34c43fd88863388b5b6badd734d5f97efbda6632f29ef97f565ede37f7b9dd3d6b835dbe4603ab710fcd4dcf90605c65734b77ab8c5fd6b53f3ff6b6410f2cece938f675633f03fd415ab5953d92977c9b52277792315399536f4f7cac2a3854cf102cefc438349f73781a94d7a0960ed8bb88df719e5bff31ff96c4b011b78674f6148c7f04f5df89fc3452a82ba9319bd7f87be564dca4d6ca9c76c19a1f2461f7c2157cc6ddb362e37268946261491c6656f6fba5f389bf15b701cfa7b53966666247ad035fe6bbd68c4fe33cee75666eeebc3339b9cc869b28598beeaf9af84dea0b95e37b0ed9d994fd8df173d5c0657a02566e0ebd6c4d3e0c647d6ee92975ed3ee3f7e2335ebca15ba5f735f2b171fb3ae5b7f9d76cf1d8ee5c7bbfddde62d71367c2a43c95fe06b2e5d29b5290af9dc99803f43a4aebd8be4ae788b3cdd8f504fc2fb35d45ca0a45ea6a4d180ec050d3b06fea6bc2e10c75a79a84036cb1dc2ee22ea2b074b3f1e0f71b5d4bb67b9e6212699c37b579c999b903b619250f3ac47a28ee38a343ea867abe35f67cd009a7613ffc7ba59e001ea8ed88cc2d02eb2d539b8c0eff8ff9d2323e4fcf89b6bee16cff37d985d4d264a34deb73a424bbe27304b35f9b7c65f3fcdcb9ed53cbe4c2f26b7e6c0189a138cbb37a7b9ddbf143bd2d9fcbc31bba6a1ad7cee982f3ad71073162c9876fedff7edfda43d67f51d6afdee0774013a67bcee4fc2093cbd93ab3abf45cef35c8ddc3aec98f87da05f9fd9382be92dceb655bfbcfff6372b9c1b785ea8925df59a3ed85f5b315f5c01bf7195239b66d8d5f9dbedbe0f3bba197dee7bf6b83cb2769d55a67bee54b42716cf06bdcef27c8cfa496fe3c747d6ff83ff8767d7fc9cfd112ff667fc667e6f49ff1eb21b224e6336adbda3f8ab59bd60b8a1d4a8786ef3709ce7ca3a4f11d3c79afcfed6b74f82dfe3dbc7c6dcf0bc919f1a2d83de217b256bf84ff204d22efa4d00b776ec7b259e217de977825f7e934f9ce5079f72d7e3ebcac43fd70d7b67bb8ea93acac1957eb4fbca5d7176881c8fd4ff9fbbe511f90f92ed4f1d098517ebf740fbf3543ce20ccbd3ff8ade28b6af7e8c9580b45e1ed35c9e109bf059cc3fcddfdf82eeaa7ee0b4e2bb89663623a5dcc915b5b8777ad52c9b7c649fbf43e633fa575f93e24c455b47b9d39429f380f70215f698cbb99df714cf24106b2b0b414817d4963c7051a08fdbd48f9f93bca03bce549ce21de7fccf604ecb9b4cfe6bb9de95c325d03ca6bf5b373ba95effc3fca0377cf3dff56089963ca3f33bef48d44f2bd382e9fa7c25b0789b95a6dc6aaff2623bb375bf67b2277592bb6818fe1fb4496666b5ba8b9db846372d7faffd7fc9deed384e79abeb3b8ef0eb0bdf5c5b85676319fb1bd75c4b486765a6f5be05cd5b64e72e45edadd199e94efc62275182beb573d5fc7ff693a63e569fdb98fd46dcc73cef67b1fabaea1315df46cc8ee8ea7391af0df48e77141763d2159f3f01a7fb7539d7bd37e44bf99d7040ee822994d0dbfe95bfbf137bcc23682b194ccaf16c785201fd4bcc3f75717c7a9f96ec98cbf5752a91ed01f76e5ef9fb0df63a5b18752bc896d8a35d5de8726cadf50c7ba9a81ff6e7bc66075fcddcc7c27d5b06f89020775a319df23b5c1b5ac9334c0e5fa09febbf2257a5ea7b560a219cc5db035257e01d631f1d526cf56d6ebcc9e128a13c4df6267f7e1c1fa6d13deec34e5a38dbfd9e6979648f542319113f1b7a0c4497496a759ee5f877da5df6b7fd737d6f1184b4fa54686c26c0fd938f9f720e8f7c027ce04baea9480dfc243bd3d6ffb6b1d3f43fbfb2504a6264c23982f88e9c1efdc5487dcc33e8c2e3807601e086970b5657eeb4b63ced2861ef2bcbf4f687c512878a685337e4b011e87b603d5b5123ae7f96185b6b979de2e1c35b5b1797fd2774e42a33cafef7d39690cf6c20ec5ae343ce0ee248077a14871314d8d3c276e69ddd1dcb7a97faefd1c4deb305fd7ccd19c9a62d4527f8f763ebb5e44496e9d53acbf97f1aef0128a7bbeaee3dfbb46894d8bf051f26c80f2dce184e4fd2ae3da8ebfd75e9abbcb9f399cabd0ea3f30340915ba7f50680dc6f3254af3a57b78345b23ccfc79cef88ef9cfebf9ed5e3a20bee73be8b146fdce5fe2452497b1d5768f66b90f307b14f9d6addcffddb28014d762c8993bdc382437dc1a9c39c58d73ca688ac1a192c3e3cb384c5e0b3956f25c2227d6995acaaa9f7c17f51eb183cb35590b7236e8e4674a21af35ca7397eafb4fc9852c8706665f24efc6185cdaf2f3aa9bef6b0ccd5beeaf1744be443203bf658df71792ebfa6bf2614370fc8462b1f2716971eb0d293adf428797261ebc971e88bcb98f2ef7ca89350bf49e683eb85cb921d07b60bec478ac34ff77ebb314bddfd670c736a6752967766b1911b2eb5d878db0fbf32aad358b8d725994e6d9aee80b596ef37bf585c9753edc4573ecbe1a591cb589b861cbfb7dd1c467d740ef3cd025d990d2e654cc25539c13ca9b7aabef358bdf6235dcab2cd3e8d33221c735beab8accc74b9e9d8acf92fca1abcb49a5f6672dea5469329e9e556679d628f8adbe235157abf5fcf90d2dad59023b7f9ea5b9217bf986f95764c1a7e9b0cebf2bb9871ee8f7782d6c1f9a352ed7f9f0cd9fafd5820c8039e45bef8d775a5c4f0dfcde48dea5674e21177bebbd0fce5262bec6e6d7bab26b6d3f6732fd00e66f2feffb3827febd7bfe3d9a0f175d0e7b86bc5f39eabd239a2df7fc1ae8022a351bbe774b7a59826fd53e4870fe840e70ff7a7cd62e4870fcaa4d90d2e00be73dba1e95395fb8e88dceec814fceb96c0b7c72ee7576403d0dee3cdb1b782dcb8baf63fc7cb539c96cc964d76f344e76a6ef52b991d734c87291b7ce4134a1361771bdbbb038bea516d19cdf5cd84c77cd6a02946d0024f77f2a0bef98ebbabf9f433f2866ae43b62baddfdafa9542d604e7bc0afd4bc5731ec1bd7ace23f8e3cef9dfb65e9f3deb117cbf7ad62374693ceb5bd00059ab320dc448ec72ebb7cb37d0a07cde7f9e16b5e77d334dee3cefeb7932adf9e0b494b78518774dfcbbdcb7599509cd63b01d16d660c48d313db0e67b94c983acfe4537a36369ecfbcefe3663b7d4f7597342ce86bba8a2efe73857fd0039def79fff9f5b9bcfeeffac5ec7576dfface6ca57f480c2da94e73e09595e0ca532cfdc3ff7f2beff340deaecfd465adc6bebd7f25f5cbbe32eb96aeeed392fedb1674bf22e95ab497d93fbeb0e1a1cac1129f1eff8bb41fce622adb7a94e93d43628effbb42ec9677480a9c5d95bfc1c9991c5329bb6394d42762dfaacf94ecf8693ebbca88ba57863f67e8afb27cfff4facd167f5ff3bdbd7eefda49faf9ffbc8fa54f537ee30e797f657e75eb6033e4b83babddf448bbbcffb7afe8375615afb4fa24524ae17587d2a7d97d235aed773e7591f4d22d65443bcfd363a40db0191dbaaed97f5fea4ce4d79bfdff28bb121174d0dbc5f6801f88d68b9d73bac71e9cc07972e1b56e98cb3ef133a7dc2be4f6aa760edfbbcae0a42ab4fefef04c7afdaf7290dbe60df273c50e3d360f9f7abf4c53997f7f527e75e67dfd7d3e0cef37c3e9cf8f3e1ce9fafd982cf2ebbfb80d4904564e7851d6a464d8efae49d94f2ec591396f17d962fc5f7bf345e797f6371ca627cade2726f3ebb9ee062a3f8f1abb6391e07e44e082e3edfe65e52d31ab6b52567885d1def831ea931f19daf6f8cdfe369f515dffcf08d16e3bd5f95c1c9bb6fa50f626b7f239deaf67d6b7add79b697ee2d61e5003ee67f4f8c18a50da60e71db35e66c96e6d6da01b7c6e9bb66fc9be441217e7b671cf4ad331bbe5fb9f0026c921674bc25176ec7ef115ab4c8c5bec4c8a86f89db7fadbf56f2a178d741b57b17b016f0be2ea5390ab3fd1df750be8b4e651bffbbe8d5424edca2dbe6ce73effd321f5caeec5a05e75ff13e0ababf3171ff7be2c6e87ea9d6d9fefe38356ebc72dc0f8fd35d72b69497142f1ff2f1ab7a3e1e07bc6d9fe6e4acc9bb5cac6d8493d15f8fdda37c98ddd5f8b27d8fa7d517ec813bee9c7c0f7d9ae4c1a7e954eb03684baf7b6383c5dc525839508efdb36b6dcfda930e764f26ef307ba254bfbde5feffc2580d7b3fc1e54edf4908f63dbcab4334d22ac4f9f04a3035763e32dfcfe9025f8cdf5769f4c5181e86365f38f7af1cf51e8af83b99c9bbfdb7d1a4e2d7fb2a6dea627aad6874ef190fecda772abecfb3a83ba730f17fb01fd92b3b546b6afd83771a46b72ad7e4bfcbbfffd5311b6c811caf3be94772abcb75be667d2eacf30d34c6edf138e07c0394648bf04ecaa77d0377c7999b7c0377c7bf5be9fedf18cbbfe33ec3b7d0a8c13ff0055ab5d0fbbf37d60feb4baf0fd16ca8d6c983246e89faf4ee891de77449e395f79ef759eebfe69c0535784feae54035eeff996ff1b1673e129b6dd2f5b398305e06e474fea40cb837e6dc74f67f5b0c1fbb465ff00d7e913fbe5307f8f6187f5b5add7fc7e7cde7c039566fafc6b16734de744fccb8dccff03377fdc48e645ab6889533c93bccdecb62a3b5fbbe1cf76f292b873b9f3517ddd97042b2839af33fe9fbc6be4f69828febe574fea4be9fceefebe7feb7c5eecb731fdcd0f95be8877346ec4a36f6fe47f26ef47db46938efbf3db6df8a5677ebfec47c7009b9e1c167cdcda5e1dc0a65d4aebf23a65f905530ce7ea75dff85b11aecfa52ccbfd55e2759e342ce07178aabdde7c9fd82261d3f8931e3637f797cfed3673b9cd7d7f7f837c5efabb4f94abcef4b7cf77d7bfb9be3fbad68f44dbc0ac639ea49dd598d4aebcb2e2eec30a8c655695843379098ada9d0d37da1667f7e17ea84bbb3c30edf48ce9e44357d900a583f9857645bc0adb267e3fe535d1c9bdf418379e2a7908605bcb0fe353066ddfecbe29b377323c4f563df028549d731ebdfd10bb516e33cf4b87cf3ec2ac9ff7e4f0e103ace171f8f05f7c865267041724e64df8fa1f5adb33cf3abb7132e0fc9cdbcf7383c1c148f38ef52b53e8d9fe5fe61d76f976909fe5718d332a96553c9d11fe7c62fd7b09f92304f9455ecab5c635eb5b791242cb5841e717ea6b054e75ee048d1263d6d549a4729efd8cc96f612b32de75d39abccc894856537cd7f02e50d3f0a3f36fd40e2979eb87a73d5f1d492ec6da8866428f14b52a4b687194f9ef3bab8e0d906e62e13f9a5a5d3b18c970bb94bc804874daa3b40dde097106438c47989801cbdeed53167a5b20dfa0f43ab52ef21ae8b7ef545a11f41bf1e5a7f14ac2d338af591acd6b57b9953d05f17e7feb2f79ec42c2dc52ce76a49e081ae11a5f968b69fcc0593cdc7536cad4cff781e0281ca4b3f8975c05c4db344474a723aa5ef48755c539bb8d8577e3ed19a2b0b4b6b267017914f684b4ff7ea180f3f37bdbd04f3b234e40a43ea4727352f4eacb921617e1f4c7ea4993921397e1bc03c4bced2529cc5cbc498207b33a96b416d83c938aebd8ed6e34ed6b0f0bea1367b56e71ebd2b98e1d9d0c7475ddc99272fdaf8f032b7473690ab3a36af1612a7e2494b71b69fae1902e40d3bacd60d9180ac11162f719ba09047905df7617e253487532237f39c61a67b4a73ae439d7a88cde954b567937330cb77158fefcb0207da123a7fb5521c1bfc9998b8e6d553871e92530dd6764ed7ec8515fa9d7cad125fd5e072990ddffc99f94edeaa4b82f2b7c26f4fda2a5e2f9d9e2275d57f646b505aff40e2bb645afb396b63be25f0497e3b445e2a3c90ffa9fcddd09cd3effc127c1c1e91f2295cb8e0263e02f9a6f0db83ccbfef7e31309f555a4b99e6ccfd451f00d954e6d54da0003906fa190769cefe38a72285e4b9abce239006c9feccda01591bb7496adcd7e6be9caf0f6192fbb23a57fe9ae4d85bbe667551e21c7c0d7b0ed8ae691d95bc1d3b4cda2475f4ebd7ec3d6487fd5a1ae9cce53334ea282d6894c9f7c15bb50f2180b9fe92f9e7f20de825eb522d7fb46e799e9732dbf7295f69c2db0e1f5b775f445e222461da9847af52933e95f150975e7a2235f2b35c9a604faeb7896ce912505e1b13f4fe799acb10d9cf692e430ed8fc40767ad2a05fbc0b82dee54bf3001a9d08da12718e3bc49792e2d9d05fba7ee3ed6552e7a3687f1684a92e51b79eecb0868f6eae6b3a97f7a2ac4b6b80d6e09ee3dc907fb43c9f7ced80fe44c83cb757b2bc88f9b99fe774748d09f23d03c4bdb82695bc83888e9ec9b47cdd101e6e77fea77823632e1bd724b753b7813a06fa68a60395f59c4c2e67b53a6eec219c3e94cb09b88e3b9cee90ae7926c3629e40e4d88fb2cd81e606cd75a6d2be87f3a89bff7f4cf79bf6505df71e7e4df321d6d20f5d832c37782b7ecf69f6cdba6c03af62755b9c2edb4a6e35d2c3b314a14fe8086f96e549235fe2e88a93f5785d58d10724e0275382bf97fadfe9bede127e3b78a80b68b3415557e0e95d00ce2991da82df846026741db5ec1bacd9882ceb1919ede333ba78b64b8c45c93cdc2b691d2134176fee3b1906d0670c74772df25c995f1e24be53e697425ed609b5e94a3c67494e71cc38df2eba374b39a31b6a09b2ab4e921398f3819d3fb7a7678dd97d361faea7d87b4bb52d625e675fb6f40323b969cb3a2fccb78dc41430efcafe78f6ca99ef1d969fa4f81e249e3babf608c9c5da60a3e431bfca58a8bfb9b226999fb472c7ab3ea76b6d1c08fd4e08e55d8b9078a2e49fafbcffb46fb07d6caea217c77e1abaca97253aba123f3a94f4caf44c41fd7d897dfa9efafb30b99edf8ab53c8d4e27a987849b332d331621ad48c073a604fddc5a280a653905e91754f3a4a77b0cf58b4eca38566ce692af35f56dc639d20764ae4bd5f973d695bce8990e0ef37233d056c69dd3715e7c9c6cb6494f6296be54f1c1e6b0255f6c8cef30bec357eb6743f27ecfcdf71dbb4af2f15330a7f90bbb7abb4e98d8f6aaa5433246baa7b3ef1163fafd23c9339cfaea5fe07d4738dee8669fd55851edbc5f2adf8226be724417487129c558e23e937eaadf9416e751be7f5ea073162f417d42591e1d5887c9100bfbc8cdf3b557f802ae4121c77c2c9fbdb8f601857986c9e3aed2cbbdea2c5e65671b698c65eb498d0128c3e3318218268bdfc39aaea23d0a5bd408a8d228a3491aab923cd5e18819cfb9327f3dfc5a61e99ec6ac6d599846da803465c6f2a58155b59dd6a38b3e26707da478a47df9a2808cbb4e780abfae09aed7bd4a0319baf7140689d56174ee5f42d1564a6d20245e71c1e883a97d9cfbbda0de55e5a5746e590cd1b64214b7a6fd5ed7462fdaedb11d302043915f7a9ac016ecf8546627742fe18bd12fa19cbe7ada18a9b590da854687e4d2da0648dddcd40ee1d622d6f797d27a06f7844564768e7169633707c076908545668fa4ba48ad0f259dc3f08bba36da4f66cb54ec954b39aea75244b287f0f1d8940e597e508ac07fa3ddbc16690d42b4de67667bc7efc8b26cf6a5e43ef82ffcfdef94d6293ffa52d83c071d533f39ad9f89e5113c5ec8795ca87d719085a9056435f4e1533d5fe3c9532a3325c60a2520e7a16ec99d95f136504c6ced8c486346a136acd49249eb7b10a23025be563fe3c61978eb3c33cab2d9bdb2c3b74accb8b65d4b398d3b736fcaecd8460a58a1dfb91f9fa22cc6ca3bac1e12cb3e85192138e5f79f30bc8bcac506398df16d25b10054e67f99eef8730aa7c7e0db97cfa58afc7e2bdab0a98f1b3d07c6653d33f521d4e096ecedd239fc8fa4e603b6e64b691dd3b54868d42fc5dfe3da5ca2ddeb0815be2ce236a9c4e4470799ef6977e1fc85f14b3ac92ea921a46863eb22ad631a2b24591b634c626b503ec11c1071dccc9bd3fdb31cd7b209244102365d94d62899d9499db964ecfab8fa27ebb209b00ed74be21fc03cab919de3a9a5319c3ba7623b26b6d5c868ee70846a6f0991da55ee6a24b5f890f528d5c82beba4c30ea64ef605d34fb9067a1b18e86f82f54ef471ab3a5088cdf4e335a97d769d0cdfd3da67998c9eae4b71e061dd3e29aeeddf95da7e7eb956a17f579b469a1569ff2bacd4826f1c2bad85f42b2c8fdf38565a2be988af63d66abc367896618e6d78ad5ca70d9d5b56c7b95dbbcfe008fda5b11cd8b7a46b134fb7a6f12d3c4aefafb576db2d1e6ba87389a9bf8ad6e66cc78b885ff2e61a61ed79fa3be680fa41968d7b1cad2786d16bdaac15de1efe56fbb53dfe659bb4dd3afc6e7bf686bc147e7fadc38f55c3b916f3397e1d57d57ab66df6039cd71aaf6b63f837afdb9bd8c53775481ced313c76c7bc4b76c66157573ff777f1c58c1f5d54667b92aa6765a08ea7167c774f8dd4aa3c0c44a17f91f8ee613248ed9d120e423f9484bea5d88b5d56530def874664ccb4612e6f05df65653f0ec88b6a6f519cb2ef3d7498077e09749ca8b28e0372af8e113c0d3fb51d6ec2956912c784aa34476855ee333d97f03444e68e1fab3d0ed9ba2774ac1db3bc6e77cc131da30edf1adaa26b574723ecf3cafd1883b8aae37d958fb2792d11bb3ff535d5b449e93faedc49487d0535387db6ae74fbdac0376a69de8e699923e85bc3c767933c0a4cfa0dc4fb89436250dcf040b2c30d5dbeb799f992ee8dd91a9c35e7b7a6c4e7790de7e6e8a20fd2fb086fa6486d0f1cb344bfa5cce4641a2fab5b8fb2ad1dd7cd05b275af617811db26b5cff5727d5c8c6c8cbf7bb8adafcef8ab53ab078da12db757ededfe9790df0110a9912f86bbebccdc5acaeaed3819c275dcfd0adfe05d470c0d629fe86d3b2ac1fba6fd99c0d5ec95dc1f88c5a34e36fe6de0d7a16d3f99ecb9737cb41d56668d71b1e3c2de49f6d37b5683368993e6f736561d12cfb75ce22b2ecb2af8cdca6d7b6d4046caba4e0fdd43fa89c2d41257d66bb6976d6eaf30ac0b704af826bdc77d9c0ca7177d607d3b3e75700dbc507336413ceacee52a4f0a503eb5ed273b83ee1c1f6d873f0f70b1fd417a5f26fede4ca5ac1769ed9ee6c324b68097c1d8fbe835b2aff5be8fe3396579b544e32e61fecd938a97b598fd93ae67496fff7c2c13eb37a8934345fba36e6deeb057ebf8ee561cf70e3a617169cdd79fe1e94ff173c96e691983c0faefdbafe7fdf46c7da660fac8cf85923d772376fdd5beb1675043eceb26fd727dbece3ecdbed1fc9ebe70bcba2cdbe1957814ce8753b187c7046273e73ebc3a7f3762f75de798ef2fe1b71985ef396be2cd667a0fcb0f346a6bc89445c47987bb44aac3cef8d101de5d197a96ce8c4c2dfd66d820f712e3912abd789184fd5ea4973d44d6e667249fdcafaafa70eebb97558ddf5f13799d9ddf888f35e6d5e21db1bde8c0583321a5f7c1cd37949601bbcee303f15985d230b93f41f580eeef69e343cdba5d8284df5e127ddc92c61d2c5ce2277a99db530be816987c96b539ae6fd6fa769a714b7c08ffc0af59ecc7999b9e21f1406fde7e2a673a1be793a95f9fd41f9b7d571fc7f9247ee17d18d876b91f2cbbd751a5d17cd8372406e6016f1a1bf72d7f7a1fb53d5d5ae526e40c965ad0f8fcf6e93ba976ff00b983e2d9489bc1a561dd970a72cf556bec079383fa063d911c362df97df54db816f9a1ae5e607637bc1eb77a998bf936ec82bd2bea2ccf6a8d5cc0ecf73af9046df05ce6a4319cecde6cc35df6d886cbf5eb5122fb7b30e6a41aa4a5c16f1fd8f43e75f9dbb21afac5fc9d7e633aa14607d1dc1a52eecb487c4d8dfb26fd4ee63f36eff2f755e8fe04bace4c803acb5e1bbc91ec6073e6d66fd664b889f316e46b98dd3f407df32de512ce9f83f491fa6c76bbe6fd9fcac6e52dd994d2b8248bac40e4356bee6c4365b033246644886bcf53f89123ad7a9166ab479d21fdd960138934474961bfa3f0d7931a118e3c5e12ead03dcf688dd6c22ecd86ddb36aab67d67cbbfc7fecfd6993e23ab23f8ebf95897a7a7b6e7b81eac379c6526c8dcd2936637fe3c604b66930d886294c81f9c7bcf77f68b3654bf252bd9dfb8b1b1367ba00ada9542a3395faa4d68575c3d110adc35f73a7318e3bc447d01a3f76e168689dadb5dbb5d55d6bfc80e74663d4db85e385ee8f17fac119f4a54db7136c8cfbe5afb9e36b078809138e06afadb1242993c352d1bb0d55376e911637e4e9bcfd981cccbbe649f7c961f99876e1bff2a2d7dee9dd46ac07b7787238aa7ab70dfe6d2e7abb9de635eed385fe3c8efff03565e96b8af6eea8161cc3c8f3337cbb19ac0e1b88b9d22e3c43d19de4cc7702a043f4256b5df99ce7e05097e400c9bc6ff81563256fc8b2f26063c87b4b593ebbebf1e56b777c34d7b3fd3440bc35817c13356ce37681ebaa5ae168b88fec41f3f1d7dc3db9c3d96deafdf1eeaaae3a099dc704d022fe23d67aed1baaebec703c466bfc780d4703a27f49a1de75766ee0fbae04f44429441838af3bbdebe0f5d46fe67a761a0d75b01fdeac85148e17a307d0a3c671e7f8d7dcd5b56e3bdef45ec349bc7c58f3e5c38c21ef82faded77afb9d3df37a67bc1625e77385b3aa2067c707e446aa37009dda1aac246b3d067cb1770735f41c3ec67c41ae9ca45d147315f3f9b3686fa476774e1e23fbeabd646daaaf4772a79a9923928d8bb3ec04b7d32898edddc1cb33e67f283bbeaafbc8eede00ef013eda01f969875a6bacec2577d87e9ec42dd5559dabfbd0aeb63a0e278fd14debb591dc893bd8d690426d31caf03afc4ccbcc5892465d491af576bb69caef87cd7a7cb007cb9d7390422d767656e05fec1ecdf7a5b2ae706d125b217d3bf5119b46f09e2dc1a5b86b07eda21d9669ae89d4b7a76ed6b3c3868ea32671e0145e05535e200b396323776b71a273a46f02af699c36ab3b99c6fdc2f77d9338e9d4efe82abe642aa27dca9cdb08b300dd8724ef10c93d48827f807e8fb414e709fbb98f494c1fb953417e5969a70f3b8daf0bf6ce25f7bbc886d95b83916c06af5c6c28ac9fe57081b8f26d3739bcc84edcf1cdb50ef1d721ce5af79e3f130bcaa5d8bc0487c932fa0f18e703b1d55e2f93b5eb9b417476bb321e13bcffe1b7d9cb8d1bbfb544fefef6555b104ca3258c19b4d5953419fcb6b9e53191cae7d7bd73d75444bbbc8d5a502ef509c4023ac13bd8f1db66fd1ae171c37b417e9bb38f62467d78af65f915c5f3d2e7aca3ba6777a09f7ef25993f5fbc47c9a11beb4077dc95aed4ee4cee26bb2ef200f90b7a7c076f58dee8ec8b1f307e6f5dbf41b7217578841907bbf5d7817f8c8c9d1ccdd95a662199adc038dbac93b9d5df22e267ba775a7bfcbe12af231f5125dffb5a92fdab2fee061839ca38d313bda83dc7beb1ee28dd1f0a7db6f52d67e5bf2ec3769d45b16d86fda43efe97bbdd726365c3cea7534a47fcf9eed457ba72f6fb7514f3fe9c3135f1f9f77ae762c43bfbcb97edd25b821647d063896c4fba3e10c773b6dd1b90931b0ba3a901d923578e1e31a62ff387566c03b30733ddedb819e60744d0cf97debf17f4377337db03fd15b698cb7278a7f2df25b4e950477f4b972def8ac1e15e7747012ab41f0489fb10f5e318dbb4cfb9d931898223f5028b1f7affd28728dbbb459772e16c4a660ee5d6db86f5612ba63e94730bee0aff9e83ef2a5f336f1b5634c9fa2f769029b94609c306313e06b97e5d6e3fa0c93364f38066ad57006fd6cdc61f22e84a53fe6211c873c8618877ccc805782c5c0e0be26f243e58cab0b7140c57135f87e7ed4bdecb410ea7bd41b458cc1c2de1751782a05ef488b704679ebd2952f965a3d0688ee13e3233163a1f0ac44e3bcf36439cf3787638fcb710658ac9fcc5bc0f41ee97c308dfbe16befe5aa1b680dd2f326590fc13b947cac2db2ef856f8de898f9c30f190f904579ec51ee98888cfbc6bc7b49ee1638feafddcd0c66d8c7fcddf22e371efedd49664c997b1314c75d760f919f53710ca05530befbde0e9c936534cec2f669dd91f269bf86e37757d5fd51e65e23337ef60d17d6830d05fa342213b7b15ef0fa38efdde12cfe3ac896cdfacbe8b8f77b097e679bc517ccdec3de6bf2271dc726d4d93ea89f15e4a4faee98ced275ae41471abb958a3bd7789831456703d6838f6571b855e947dd2d8dbb4ed0521d655970c784cfdb431e7f96c24de2d014de431fc8ba138c994ececfd50cadb5480e82f1cff238b0b76ad83eaf3f576f0cfa57a0db9098ff04e37e01df6e08ca41fd2eb1211dc0972846e5e41ab227c293873a7317f2f6a55a1ee951ce5f3786799488fc9f1e4ec05e7f26dfff96fed75ae40ef6b26d8c45310c10430ceab5deed43710d84f640a7b6d55384d700fa3fe8b73ab93313f1e4e2f44cf1cf03d82be84d181a73f23e15edbb34f636170f8ff42129d1dd2ae74f3f646294d23dc5c4056531cab9ed7b425f59d6d6292847f145355fddeff33196ee7beedefe184e3c8fd7c4fb9bf51f65f0d3a742bcf93abc394a309fc43117a387d57373f622dea38713c55bfa0562027a72ec1acd2bd4b5e6d06f7bd8a842999dc3103be7316e0fee7afc80fe26e2bf17c7e71c2d63195b0b4e7c8e08772fbf5778724824ffca62167ed0de457dffdfbe65f66dddf3f8c7ed59783ee0cf898cc7e3e1f8a297c2fc0755792dc92f2bba87e3c79fa5fb1f8d0d7dfeffca9ea474825ae72b8d259de239c5c8ce60f19f79be2a5ebc47e2b3ecf1eebe914c80bea6ffdbcbcc5efed9fbd5896569335c456688de957ff7798ad6f147ed4dcc13f9fc35d45d8897e0d70af68e2e3ba10ef66a82715ad5eea9b12f89bcb8b2bec0ecbe84310c5df962ab2e2786ad4036a4fe7c262e3cb79ef09e62b25edd9c2c0e7d1257aeaf3bc21ceb2365e65b8bb1af1f447b95b7eef43d5ab1be2f3afb4d63e6e9c6f8c8b5f9a8f80cbe5e3896eda009fb4ff8255df7a365587bd7b84b15dae69e5f3f60ccd9768790f65f4c75153b4a2b9e0633df0afab23d9c95d396da5f62bd14c7ae568dd39db3bec114e3817d5f969311bfedeefd7be560d1ddee44845141fb51899f7f28bc67bf9a6bebe7e1b1b2be7f1aeb9ff6997172f2ac5a93a0b97793fc79ec3b1b4ece20227baee6dacce6b18b6f573be82b24171dc9832fc6dbcef64fe717a470bb15f3e1c8baf25a18df4d742e6bb00accf5eae20e253496f56c6f863a2f67440e571dcbbc90aea7edf0f8e01bfe2d87ae0473885307d334b16332fd89f35c70df58513e8f624c14caf70ad7c71df43d276e67d625cd150cc72999c6f831ea66d693602973721163ffa5d73e580bdf37955530ea92b3ca6a09dba47316d6b89fac7b1ef3e78df0d151ceb80c2dc434889b2c6e7b4d9f39e72c4ff90cd02f80ef7732e3e5e483c9fbbf001fd579932778e7d9216b7503ba429647a2821802b9a0de85c6b686f37206fec51e6abb343728bd372ae647c53e01422ba43f65d696ac238acff0a8755429aca42c4e1e8b492ec0c420fd127ceb8db13a125e81184b7322f7300e6be0fb56af91a1272dd393732a581d93fc6a04f3d018ef1dc587ef703375f03d890bca2e4859402b077cc6b904721824a174057c85cf7db07ffe6b12cef6a627df4ca3e9f372a730e553fd9d87e10fce3688372e7a53f44b70c86b60af6469da3f6c1ee77f83337e63584d018e2eca0d30973d6b3d532d63759d8af2a0aed1398071cde1bd9069f8576b3d42fc198cf7c026456df7cf7600648475710dff88f60ba0e56807f34386b3fdc668a29c45f85ce5b41bd930d783a0edb51e15b4dbb0ebe0486531c7d01a84109ff30bef3b9ace0cc64c1eab3359b74c1f372063a741b2267c7cf910e1d44c8cb1ef0e5ddf329aad941eb207f5ed61611c031b1bf27821f96b33f907935c53e9ef896c4eee296be5c3ad902b6598f2d4c41883b3ee48e16891b58dcc10d898afd5752e449fefd24df918d74436cdce4eb03a60dd1bc872d9361a9cf7d9dc9c7410c7c8515f23479d35313e1b944f292d80bccf9e591ccc0bae7e9ccaad5104ea8f387983b23127ba04e49909cf8cd61ebeab457798493b6c6e90723e2be2313d274f902e8172e06eb358e0b165005d6dec5b299e0df48fe07de4996bfdec3cb01f335b17cd716d813aa43cd0c154f0f9437b38a4c64c708be1f9c0e61d12c59c526f23f3382aa2373aa2dc7fb93ca1e53ed5acfe4cd61661983887ac9d939de3a8eedec378d4e00cf0813c2bcca998f87051bcef970cb68c28b722c9e952fcfef5d90efe88106e485f4a634e3b299e6496e6348fed10a67226afe81e9c05d81726eb8fe575ba20b94593b9fbdb6107c82b825f439d4b146d49dc21c61c2a3937236bbd077acd91601e65cec98173a9d6bf1c59dd8a63c89eaf85fd6f07f7eaf2499ca755d61f56012de5ac7c4679c5808eb8b383564697e2fef6ddb95db8f4dde5cf1be119128edfdd07f7fc886dd5add8bec9cd99c88f335ad56893b25bb871bbc27c178c3c29e093aa3c1ad8c3d5b9329f1af7b0f23ec9e89385637810bba77c9fec2f5b0ed67b9e27b17e4fe5e02a5d9b24d77b117eeec8f081fd14bb833e381bf23acd7e63c8375b1d4b26e0e9b2b335a070d793b335c955c4a5f1a8cb394b4236963a9b7bb8df48730f3b8a1e379ad321ca19cda17904b18d689af3d6db908ff9f5b682bbbc11acb96dbce6d73c638b14f66fc87cd9cc1983a34855fb07360b9b6fe6d0e6e8959d825cce8e5248cb6c9e17a0f3a95036c51dcc9b89cdc3fd8d6363921c2f89ad49d9d8cfb82d5426a3ef5363c2fe8e44f70dc5b968a0fec6cb4303e35a2bb6ef717d517c4c8b75f5364bf4758e4e4cf21953bea7523ea9caa3053a04874f453a04874f453a043b06910ec1e91fe8101c1f499e27914ce5da8fa2b5a16c0b31fe6d564ee6fd03ddfed906f64438dbc378d1127f17ed834b7c5e01c903c8a5f18ea73bb33eac6c4e7913d029cd297f9f1c8e0d9c579e437388e95a7a1e638cd8aae731c272ac721eb3fd0bcf63ce1844e731db3f388f393a8cd6e3bc411f56cad1cf9d4be6cc37ac8bad3a50368d0609a670c16f1c5f50887361f1fd74997c59dcb3d8b887f4590c68951d635ee774be88e46bd5f64b7d0b41df7394d6c55dcffc1a6d52fe6d7e2ebcc2f74b7c79cdf249451e2dd421583e15e9109c7d22d221d831087508b6ff462e8f5b565ee6720f111db5cadac07ba241ffe6282bb14f2d2727f398e2595d35bb07f05bf1d83288afe44ce7528dcca0ffd8acb524273597c6719beb9fa1c681e3ef8436fd5d5b1c9b857274bdbf5491a3dbc1ad8e1c6dd8ccf92db26b98fe8572943306911c65fbff4172545b8c6e9a581fcdf3ebdf411f6dd83f571f65dbff7e7d94dbe6cfd047599eaacacf453e2d0e4f0bf4510e4f8bf4510e4f8b7c5a6cff3f501fcdadcddf5f1fdd0e6e95f551b6feecefe6a72be7d71abc5a914fabf16845fefc2e7f2be76cff997ae28fd6117f897e58c1bf54432faca61356f42955d4057f9a1ef8bf4b07aca8ffd177a4038c4bc2deabb31879dddb6dd26d34bf6678e84e726833f7d5995834d4f605c5b4b539f7de28ff7f123794e2abdd26bdf66572389e4bc6cdfaf2856d2d6bb7c5bb97af56af6c5e4eedb17063299976b58aede218a045838d9d13ae595ebfcac786d5980b8a1dabd41f8cf17b6463af584c26c8a7d729c453286893da1fa236f4f991139398a7f34b199d99bbe0df86d3f86867711ac167161be981fe13e392223a2de369af7d19a5ff5d75f0dddcd939ea2cb695c8476bb43a98eb766b8ce329c771e7e10ec7ef1b6519d9862f59ebf16563c867841b09c683fb99cbe9b83d6767f5a470b178dde9ca2ed68e9234eabdc89bde2806df6907fda41d46425cd4225985633b44b24eae54bfdbd97f87dcc279ad5eaac94b946364672e9238de8a727ab677824b819c3f5131abf80c1cf4e34dcc93d5ac2c1497cdc93f180f6ad17bf368aec76f257a4e6e8ef01d3b7c434fe36e16ccb5923c67e7c0c8b9240e242733d2b1100cd947f68ca9d0f695c269296d5f3b546b9fc8e54cdc6c461f2ba7557246cc6bd66365bb68ad1f9b414bcdc6f6d6e04bb12c67d7a548a6a7e5303ec34b318dd3f8a068037d2cbf0db31cc8eb1d891b229f59bc690dfe37267252edecddc12e91d77fcd5db8bfc68b5103edab36a4c308d164a7751bf278316a666438b0c10c7deff424127344cb701843ed04f73390f57fcd9d3b1857723eac93f1c704e35aefb6d544ae2f5627ed3006dfc51a90fb9ef34e30fb10de3698c7a86c8d509e9b52bef00b651223e72bc83152a792ac11caf584ff0fe67a7c14e2657d38b7253f969367f3021aba867c9d2a99b8a5db5475df9de095f8883c2758ed37b1acdaeab8009b8e9b6fe4b259b79fe9f73c424cc421a387c599bdcd7ddba0ef9de0f5bf36c331fb0e3a13ab4afcbad2d554fa318cf71ff88f2477d4309bfb7a1af8d7cd60156fd6af9cb748691c3059c3bfbc267aaf857331e5f347e5f3785139feb339b7f33987316e689acb4e8298345f1765b9ba314e5537c1628e46bd3ece398868360950cc2ece4195cd8536e4e56a26f118d5728ee5f1a22773886b73ae927f4a5497a237fc7762ec7d13e2745cb2ebd9bdf1d7b97b41df77e5c0565a6cde6ae45fc26d8fdfada14ffbfe9eb579fb3e1a503145d9372af09e80c2ceb86da9bb85346f65c2b7913bf0257bb0e2c554637cd8b4aca3ae3ca63c1a7394e0c58ab1fa707f30c759267f1eef9d5cb24fba399b17bfb9b106ab83ad8ef7190c88846e50f779237de2b33f9fd737836f9859cb30839f46e1e0923863ee7bc9324c772c53da0f7aefa434a2f1d23a79bdfb4ef09532e33ce472b20d39efaf45fb77de11eccf23c4164cfbb2de6db50a4dca708d91fdabf5b473eead5905199cc88cc80a5aef309e3c934b72c693d110b74ab48f4703015dd609df9d2ca38fe55bdf7354f6edc664de50923cb0ecfe89d27875266ff5554fe996974534ef276340efe2745e3e6baa2d713fe97afa9115cb702fd9837e53e4379eaa48164dd5f42ca3f34b276f87a9b74dd4bb95ea7c98d2fb6c1b40af47ebbc31e4f47dc3fc469781e74dd6bfdef42d05eb2c87e41ee9b1198ea17da5f5b2d82c595a70da2ec9d55fe15ce1bfa1fc1e1aac79e761d533f7c83b2f736f404bcf6b2873817e09c73ae4c9f917d919ee85f7d6fac251700c00a6ffcc3795e8e3ef89a8bb2e617cd010dd2df2f2c0234c0b36a7bc8ef2bd5363043629a0c12c7f160319e3635f3af559849fc13d37987309f69ff244925bf4c7d11de9881fcf5dcfd297134f40ee3f327d12bd89debff04d6db77d1b2fa2fce7425d22cd5d0a3fbf91356274ee5e1e3bf3241c173f2faf5ffa8e9eee43bc3febf67bdc25b260b0dac3febb1fac0f640918fbb04c6ffff818c9d9fc5d63ac25efcac79acfd95b26dbb9eb98b789c43c28ca955b7b1df3e31e7533e7b764ab9dd237fd756993f681753eefc69741c21cc5edcc594ae8b7ad94ef37b533884fc0293a6b880e5ae9ed53ae5d95c2f034f03b544f3ed82ac48f7e005967aba7a43c28e31a4d697ad0bef0bea3b08b88af23b294fe2db5b724826727fc3d6dd7dd3bd9dcbab133d8efed507bc6b978df5d557bd6d2f301b7d97c90f5ab9e1f04e2cdec4d4507eb73b695e617e8270aa07c8f4ca379d8001b26798bd83f980ae039607325e711d425f1597cda18b3237a53cd29dbcbe681b715337287fecd9afb3cbc156e9ee88af9c7797b819a6743b45fdf2d4f96b6eb8eff755de217a1fb159d09408f377460a71e817e4df61fe33b0879b859dc77ce91867413e80fb3067a8c309d3e788ee358055efc02fe8e6bf3096d60f2669dc10d5a8a73fb604c9892b7b599f9e237c435f4c67d6b128c63604b99ea58b68d11c6e1b85ccd700cd6e0e084abc824b83d05712ebcf25f63def8243aa75ac577de633e3e1d856944e3dc4fe271ad79111f0a9dc390fbf6385c5d015fa31ce5f5e68ceeb246f4dc31d608d0a35bb199c103e4b74d6188f0f22ddf4cc385f93ac1586bf13e17fb8a831bc0b35fa9dc7acea01f5af3e46d67a9efbc001b2bc9a797d759214e1417937f24537c81f39f8ab170ac2c36ae10c38a9e53ea6bcbe4cba0f175643d183da605beb83a78f60c8e20331e9c1f881ecf237a77d733481f2c8b3f9a8bfd06f3b462fecde944bb49d8d95bf84e8ae343647093f27acd44ece71196cd8f29ab2fb7b3fc57e673f0589f4c813e9dfa87f1bc913c21d856cb3c3d327915aae57da779368f7b45e5f3cdf69f628131f3ff18ee1bf6977f10978b8ccfbf627916d903274f1b81bf14ed772b685ddd141b21d103f1d99062eece1b725ee6e1772475f8b65897f11a8fea3caa53fcc19f3f5cb345d6c79f8e378f1726c2a5d3b3f87505f73ca231c1b7dfe2358913195c94a3899bd76355d8179e7fce062cdeb72c9dc7a2f9b3587f617a2ea11cc6febb0ddfa5247de2f39ff69997f0084f368435e4081e9319f48f5626b695c52ac961bda0351930382faa3e5f927da392fda13fda371eee0bd66b48fec0049bc455f66777b08c1cb5b337517e348c8bc9c34319ef1d6585ce9802fd348f755c86e1fcbf0cfbd4338d5908f4382e5ea9f182d738c9b37231e7b84e1a7750ac0392fa867c73873e2fb6d873078ddd2400fce5fae2fc5ee82e92d848700c73843bc8c7e0b0046f752cfe1dc6e1e546ec11d2b613f8402768d6c6264ce91639c3f119e87f504f857841f73d99eb64dd893786bc77c2231327567ece9ab1a9e82d9333de09891f19148c43fd7e1d0bdddd91b9e860fdafd6a01f6fd7515e471560c25b07fdb154ada1bce7b6c3a559e6776eec8bb8bfa5a41b23450b05fdcd9b65fd7d07ed5e6ed3eeedae2d1c40c334f737591f75753095d5c351abce65d430178ea4af3373914d45f71d5563f7eb5c7eb8867eb0d6fa831f6359c0d7c652b5169a94e16b32de984bb3dc38b2799ca6827c48b9dc4792d6bdddf58373d10f4712834fe672b603ebbdf2f817ee5e0b460d7afc1ba319d80f0e7f019d37f8a3ee7ebc833ea68331bd1fe118797b31d3f7877809ecc11745f36ef769ef354f1bd55c8faf40e651fa730c784e3b1c8b6cc983b668ab348d5ca51fdb0138bb79fbd0dd3b4113de0d33b47a8ceeda61194fc5b9d58fdac3f7b55e87a657326e1ecd98b17c8c6e406edda70beda2c739f965cc202e7f6559a2cc0e1a9027f4fe236d1c787c95fc564f6629e3a376787968434e3f1e67efe5c7f0313ac55af7266bf14d99e6e53c8e69afc55b877d3035ac3dcd5b38ae9d27a72e180f93a193767889f5c5a859b0267b6bd1f1b36b82c6cbe3a9ec183e24a362bdb7bb6887d7263813d11b902c4f39d49b93121982734867f6048c119d72c60ee3411f75cfbffe11d230c74b4e70b972d621d3f7f603399af5c5e8a22f7617fd90c8a87fbb06b43f635b69d2b601aa87f2dff27ce3c5b965387ea7bcfd2bc67f5aaa7a88dedb6fc215b47b9ca075a574ca677d086d4938866f6b29538ebc7dadfcf69f9bf3a6d39a04fd8bb51e17e4b7a57186717eca1ee55b606976cfd8fd9531e77971b0686cf84e2393c37963344fd67a7c76e17dc8dd77c224260fdbdc2eb1ad55add7a1da9a9d1d453e3baa7e3683bbcfa31fa4fb3a6d17d80eb6e13f1cc5f7ecc1723709f48badea7e9d38272aef6a5c46d3a23926faf43ae33b95b4de4e16f3a97eb016ab80ef1f1d35ac411fe58ecbf731ef9ce87b24e4e749e446d30af4238a2dc7b44ded8a5d4aefecfbb7d1a37dd30e8eacd1fd29ab83ad348f599b477b4c7bfa51ef89f0564f076b31f63565294f3939dead9e759c0e66deb779736f0f56b1db951f16187b3083e3db0cc7797f519a4fe5b13f98811e88ea8ae736ba69072dd6e9b971f46b6db194f5c74812afd7ab6419b3a3b9e0ad97f6b016d6c1e4f51117ad97ee69c62c308331b55e89be5c30a78ea705cbbba6a6fd413d929ecf4093f59e29cce33a525eef96a1071a773e63df5aac7c7a3eb0fde2b9eccd6076b4162b6a5f43fdb988ef142dd01ef43c12dd8e9acbb4b754f45ebb606dc059aa1fac1e6f2e5660192bcf5c73fa289e4f6005cb86b9e8536b93e8b905735a3e34c5bcd173b206fdaba5d03696a66a87fe413bbc16e949475dc9e451a67e5b1df440a7f7d111e5f81d15ee21ebd0f7b4c148e5d513cfe7b5a92d46775dcdc8f47043bd8b193d76f769efa5a189f3d9059661f916bc1f667ced8135b08e5640f30d7e2b39d8c779ff69baaeab03e05f7a5fa7ef234573311bfa61a74c8799b589e9f7629a31ba5b8bd1bd60df28566f7f9cf2e58002e5d43ad77e890c981a63cfcccc9fbc8f13ee1b49eb2d6ffa5a6e5331296fd6da0f9ca01f596b6de70cc7ef963a067224426f8474c909fc9bb5d6bf58e118c65d65d66a4d9d9b28efde4e1b029a17956bef107e51e46f0d2b360dd977e2cecd095aa11300d927c3f74a8e3a8b37eb8eefa851e004304e28435b1a835743befadbb7f5ecbdb05c179491aee93cf55af1a5f9bca3543cccf366e01fadc1eacafa9bdbd9fb1c2fc9095e92ff9e9bab8dbe838b6dc58dadb5fe801833e187f32f08de5c15e1f2f3722b24f932b8385029d63bb4f5512c68b03f5b83996f1f72f68720778a30af81e87d05759f98cb3186e2c0baedab1e627f391d2b362458bd5ae404aba30b6c83ae1cd909160bfa0ee8fcf93db315e536ccaf97273fdce1f8ec404c852ccfe2b7f8cfe8ad633f9e182ebc57b08ca6f4b5f72a5b835538190adf90ca5fe7ed589f2f2f391d7da71d96fe24875b3fea2d6fe3f95df4cef9aecf8f3b7dd1f64bfa5379fde90fb6bfaf79be27792db2f9c01879601a4da0cb027d2a9347da863e6bbc2e42f9a5edb4b5b623f41c817f03ff0a7869aaf4af80de982fc472286edf9db803fd202638338d66b0319a6777b0a263ab09a6ca63b21efbd610ece3d7c8365a893d06bf9bfb5f38fc40e6e25b433a0631333e1c3fc17e07e41ae2dffe8763e6c97e23f84ff833bab35c6b2c961bda2fc0963d6ed7d9dc4849dc3b1857f62de32f94535cfb991337de2eb15f336f1171dc81bc476369a6f91416302e3d8d4bea1d65adb754f3f28cb607b3efff708c93d127ef63d31c11c5e5881f878ab978e1e51a13dfb589cfa3603a309b56d02fa14383ca6f5ec3b78763fcbecdb36f54491c5f792ea833132b57e70d1af1a9557ff3ebf3e990bfd3cfc7d2336f67396f7805f10e65fc227cef56101791b45925a63d19eb4cc003740c0e9f57a71fcfc75810876389c693e4dbcbf86b6adec3f2ec282a7f1c875fa537ee78f2f1c289efb023187ffebd3013fb58258f5ff2aecd19eed97e06ad78b33e430cb31c76593dbccdec384fb63a3e6f0388b376c9bf3be7c72371f9f5c3392b35768f541a93e87db930de253f9ee2f8f25c9ffb7727f09f11cedc2dffd69de1e1dc7bea0fb6c7e729e1db7f94d399fc86e754c0b7343f31efda93b8601eedafe9b9c1beb7cbf035964bf418abf8e58bf861b2d6a3efc0632c5a079ce7a4de592194c19c58cd82375a1f1d57eeedfd77c48f72df4515f00ccef5524bee7d479b7c5e940b6468011f96c81c9c1ba7fa79c1dbfbd9d8efec9bc59cacaf94aba468bc867cfc597b02e5b7f8fbed898271fdce3d11b11821dfbd27846dfed23d817292fc0df6c4b1a9553927981c143f704fc0bc027fc33d211ed7efdc1330b7c30fde13c2367fed9e80b928fe067be225d67a1dfc3eb6906e79dce31f663b608cf8bf9dfd5034aedf6843609cfe1f6a4714b4f90b6d099c57e097d913797f6517e611796c78f8499cb15bc15d76941d9f6eb41f80f409e3c2fab72dcb77a85fc1dc2bf87c12bc3c81afe2833128699c095706a7b123dfe7dbacde0e1be7573687871f98caecfbe7e0a57bab7adcfdeb6ddae3dd6993df56223f2b15bbe253be5dada129af9256102bab07af0d6b30aed0eef7ae5926169ecb7366e2a3cfc79097c70951f13c7c7ea6e28f4a7cd557d6575de2137cec1e56eff5cef509e2df84e3fa50ec501a1f24a03589f9f9de35abda0ebbcf4ae730ba5b03cbfbee39d4df67777db15451ac0cb3cff06f225f76fe6d8076331f4b4987774402be0c56be6eac3cd1faa338a8b2fd9060b67e471bcc9e2a1fbb313e5a83a5fc3d63e7bdc92f8e23ee1cf4c5aba2f162afc86fa2bbb1249ecaa5638964fdf07ad3857100606faf7c537c8745bd1d28bee34a31d3bfaf1df6cd43953990d8b2efeabbb6eca362e918d9477e13f1cfc7e2d89258b5c236bf73ad481c5b6db9a73f4677b350ee9977f3d0f174d6c6c16d27ef2f7ef0be7262cdd0629dbbaff06fc23b67e65d46435beceed31e93b39f1acb2cb006962f5a271c5bf79d77dce4fd45edfd54165fe89bcaea601d4477882417c28f5ea3745cec1ae1df44e765fe3dc8a3ef69c6322ed0fd0e7aa0354c452833e01b8defde4715da60d7a774ec548ce387fbadafe7a5b19cac9e877ffb366f1eec41eb61ada3c01eb4f66eef8cb11cb33801da9c60f5a0b7eaae4262029d9bc6c16fc962b7b4c93b75fa0d117c83a175db98c75a37d3d0cfeef0f80c7107f07b069e1e40b597c5f349f0bb485d8cff96e486783de3efaed9ef127d05d16241f0bbd27bfeaff8bb49f63b224fdf61fec8f5ac89e23564cf5dcf24b86680deca8a7edf238c4917bd4301b6b949d6898e4f1846375bf1613ce1c4582996d1949c47f2965cb295fec31d96e5c93a3df445e7b6ede572fc1d34591b222c4f2ac7695a968f21518c0dcdc4dbed6426860ee3d85339ea20c6514a8b62fc6884399d7d8f95e2d092581a6ebd740dcb62a8c2d9bbb9d62511866621c638ae8bf27bb850f7b32146832f6d17a77bb26e38afc79683af24287fda18339fcdb3285ddd410bfa570826e3c7f150095e44121f8b7027309e95f348f127725817083f38c189e6e07d71659830674d03e52bc8d519f8b76998be9d4271781cdce9c2b8a8b6cc60feff8a5c01842f30167e06432bc1a797df2de85b6fdd2ca3c98dd9fe366f46b6027d8defce60751d75f1671abf30961b54bc32fd7d33cded89facabe27b3cedbc1ea98bc25539238443a56efa62fda0cb645168f7149c59fa3cffcf873ea9d258cd16c1d4de31659037f6f0f5aa1f87d20ee2b40f903c87ea4f23bbe6f109f46d6da02bcfc48cf0e4ce3c0da5bf05d1da1f9ec6c1af7774bcde18378b26a19e3b7cdfa35c2f23bb0d53190358f1413a525bbbd5cbd10c5125b412bb6e7edaba6265827efeefa351fe38deb5aef4e00f6919e609ed8ea4a9a74ab8e818ac55d53fd2f48fbf7bd3b5882bd104139df3d7ea1db23630674b6e6bbdba42bc9283ff3c7e2cdf17a5eada07fb4e66dcefa0ae2cfc99aa01c499c3512ce13f322fb5dba6f92fdf370013f195a9df61fa6d2bf58f3dd7d7268c75f1711bd6ecf8e02e4c6f2a4cd1b37cdf35b4cbbb1fc30d531ccf10bdf81d691d3e89c4ef0b430763bc48147f209c89bf23e47e9fc933cb95b61db1d65d36d93b7ca67ee7b8890ddb793b57e7002980f14bf5d25634af178abd721d8a2193de76a19ee1ee5663c2539831d651f439c3d78d751990e9eadcc9a5b6a6e137016933ca2f9711af86c0c56c75f71cec2bbce44efc6e74b0fca5484d71fac2298dfad609c344e262716b9c2ba277a3f15eb0f319bd25ccb5419db683d8b7f3f431ca714ff334acb78238f3adf8e1ba37fb11527226f6a2ce3b5846f56595c7d7e9c7d616e274ecef8642df839a693354cb1ee16eddbb8644d7ec43e25fadff7f443f346ba267999498de1ef90632ba76bf0e2b1abe122d6d02f7398024c3e184f905f4b857613854b42cb266827c21cd81fd2c3809d4cbde7704327ab770dad93d8b7d3bed13a17a2c52cb6d5363aafd7b4bdc41f33b671687be5aa53fcf8c3ec915097ccf5589a2ae8338e6b7964f3bbbff2f66ef2be6faa9c65479d9dadf048c99ef30394b3e6b267ad67aa65acae993c30199ce8123ba34b3035cd123cfbc446787cd0b678e473aee4e93dea263e1f2a67167ef39acd6df53c59a0eff3ffe6cfbf82fc5a3cdcc20c1fa03757d1cd56137d37b68c3b3ebfb36fb1a81c60690e8d7907e5faceaf0d2dfb70ce869f87219ed13f183cc6fcfbb3e41d68a60fb04f2ecf96d13c0af811f3fdea6af130cb733c92f595b40fd660e56bc152a984379fd8b8fac773caa9f9fc3a393defd0f87712d772a0dfa8e172c9d90ddf1446c03e72d7e3328c9368ba3836a7eb4e233d0796376748bf79469f699bd351f4d856ee974a31f8793b25eb239361bc59fa36ef599b277e27c8a73c1d86f40f7d9230e66f97ee17bc16506f80f4d430aef6cfd2e70bdbc73c3df6ad612e0f5fbd71a6edf44ecf66b00236a6bf5538baecd03a41791623fbfdeb22d5a5a8f7b379bd312d837252087f473a3479df3da3f92fcaaded03ae2d7cf3cfcf41345d1c639c8388d0fd62c5a93db31ddc818c6ca47cb5bb011bfa9b38bfc75d3bb46fb9361bf610f1487abebf48a81de8ef7bb787b3b333ccc8d4bd19421d57b216393de1d1e6e9fd29ff127faf61ed6dc3bf6c17a7c85c776e36c497f51ff03ee7650cf17e1746ffb0c4dfcd2056c0cd5b2aabd05696bbd5f2e84dfae377336849767cf3e683d5c50d56d2c6d0f7a397a6ef1a4b6ff2d28fc19e9874c7535b9dbd8ffafade0e356f3284ff468eb28a378679191dfb376770f7472f182ff66515d9caf93cf232fded6686fcbe098edea43b5e5886fb6ecd3babf9bcb3d81ac0aebb79493ff9760e898f3bc9efe004f2deee31584e92d66b73f1acf36f6511963a79b79ad35d8a30e6f16f894f1be79ac07720191f037e6778b1d633e8df42f8bf102bf06aabe3c8346e5f5ca5155b5d19e59a65bf873a0f3c57d239ab9b812f5973f41bba1f4175488e07dc163a87b378c6d7690ffb7d0b73dda6f73f40ef4c7838f183d3f743c85f6a2b7769b36eef26413f7682962a3c17383e74b26f26873d8c5b4d6498d790f0bb7dc906f507fe6dd46dee9d70fcee3e283925ec2bc5891879234ebeec2c8600759e3edca17fb11667745e0fa5ab196a514a0719d2d65081ee7adf9bca2a02f5d7079823c99d749b9e13cbc998710e2c5a479010a617d0d5c70ff03ba65f04f5293902fac7c504b6a1b1babafd460be810eb79fb6aaec731ca459ead077e4f680371b5a9bc8e35c6cea32f773edd642c99bc1b30b7668f8d479d1c66f8de01fdcbe600a6ef14abd305e94a330ecd914e4ee3e569ddf635c16eab461374ffda9712be16f543d11ec5041fda5f7273a7f9ba91e498a1db32a0bfdfde76e59b0df6b621833370fb17ee835aff94e6f35b7e2e38f69cbb3e2437209d43829c6d578c2798ff972e4be2dae998d85be579103b642531fc9be4edca60df615ea9cec398efe5948f63863e646f50b22a895baf3b8fa48dd437b314ce8d2bbb146eeea924c67ad4c5b202ffcbd3f9f37e828ab4827cba66f9077eff9dfb06b72d9657c84e21bfbb5f286cca2c3fd2fa253c1fe4cab201f6d1afb646d9f164f64cb29f535f01d6171339dedee5e41bf31620bf46140dcf76d0f49d30a743223d5370779bc581c431ab595d26f57516c48c97ddad77de1d1c5f43e36598c6189c8bb23b007a0fb06de9dcbc54be9c455bd132d830381f5c568f8f61cebcffd36bff4faffde97a6d3156cb0f8c7d89bff2f3793d080e95ad76e05d3fa53788ec4376ff24be947e5eafc8e6e43ec0f343dc2738870c0bace125ddeb238ebc5c09f73fc756cddc11b1f16ef87e5bedec9d00dafe299d703e34e4b79f61399f8cab443746e7983368dda02fc943f94ab9f13febf42ca3ca17eac57f79597d22db4ffbbf125d2239cf890f58bab2b9ad6bcc6bd110ea7ca23164ee89b85854c9f94562b130c630935f3217fb93f237c613ffe03cfa01ced90bf4ad842fd1fb41b61ca523e5f068eace03c5d0623d2fedb754ef66e88df4e021939bad2e6f11fbe2bf72f6054513348684ff887d417cc2f3463eee29d59fd659fd89b94fc0fe64a2337e64bcac4e95f203d6e9b87cc9c965971d37c99195fe5b60877c1f7f121b32a1312d17cbe7b4b742984f15eb89d9f7d1a90d98d1dd927fb3b8ebc8ef9f3997c2ca6b93d83e0c0fa5f9ad73f84e0d99b243cbf7436af788f93591b38d44b7877d89f8b5fa5e49e69759a7ac8fa2601cd5fc14d0d6e1f11f17632017539bc42d8ee4ef9e9780ff48df6cd9f197e49ee947cd2bc142abb68f48fd35cc0197ccfb6419fd63263f2b2b4f539b3cd543923bd22a7a48ce0e91b52e573749710ab2f984c93b6e4213910e23ba4f0e74c554ad835b9956d83665d6177e4f9d2b69deb01565fb566ebf609f42bb34d55b482e82df73ae207f41355d0796e5c9802d954f41a823405b3d67470b6c75266e04e733aa262b415bd5ce14c4b31cbd27994ff199c2eced0583cf91d77fa8f127f9c069ff7436467fc1c736cde613ed635a8adedeb9708f54d84bbe19ac3ccb18fdf2d80c9ecc2ac80f98396b9838f15cae65260715cea56fabab8765dc0578a4f0fdcc1ebed55256d046cbc54aa3389af5ecdd35f413f5f6ed661a8d68bb00e7dceab0e9a6efa59c41ff3a59fbd9760dff6ac3dccb2531dc05f1e1dfe630bffcbbbbd67d9bf8b40ee9d9c4b51307abbd7338033e3865ef6ac6f9f155c56cc538c6d6c556fad23480fb398fa3cbcad6059a17757f8d7c01018c3752366bfd6c06fe05c657a5b1fa55ca548d4f57989c9d2531ea09be789a8fb3244edd62727316c4aab3e3197e2cae3ce5f11bc12ae1e7f4ccf10f7a97d8f4b7c3d70bcb0b1063c477bd1bdb3e854bc3f09c9a62b870db44fcc0edcf0e560d186b09df9d717e8772d6a77928e333c3f4053ceb1b5d140b9e8f49e79659178f19f5cba3037c5fa69037769cdfb10d5579cc118a25297887b0da9df05e3ae7d6330067cca8cbae317d97ace5787eb2eec4b6daf19d10eca9a49c70bcd82fc97e9791b5b788bc71e0d0e40afda615e95cd00ea47d751e23ef5b98f2e93bd38c5f9d8ef7ecb7f86de1f7c3dc7d05e5a2600c05f5923b556a2cbdec5bbd9c0c4a7e1bf53a0f2857d4ef972309dd6bee8b2af5b02f203f8f040b9f6e0fc62f0dc7ef7617f34d96bfa10de104ad0be0636b6d95da2eb46e45e14bdf3f1eaf5dfdedc277c4d22736953e5f56bd93a2dfe8caf95cd6498cf29cce29fdc17c089935863a466c2b3724cf73fc41ad27dcbfe2374f056df652dc81225e216fa98b79c6a4dec8f3fb84e7a0287767d17d4f366f22bd1ecd9cec66f898f8ee84e5e0b93d4bef748725fbc6f00fb6d2289ea3279f413d3a66786334f15bf7fcbdc82817638a3e676c137096c7726cad819ef38a72913cd05b1df226a48837d0989b797be76c1bfdd05a9c6527b89d46c16cef0e5e9e71de957747b5c2afea3eb2bb375f53f4871b38bbed40bed8a1d61a2b7bc91db69f27714b7555e7ea3eb4abad8ec3c96374d37a6d58771c77828d71bffc35777ced00df4584a3219af35f73a7318e3bc4266f8d1fbb7034b4ced6daeddaeaae357ec05c988d516f178e17ba3f5ee80707e8f7ddcef1afb9abebf346accfdb37bb2b49a3deeb4eefb6a551efe5aacfa5fbe4b0d274e8536d4b766f04be8be177b8ced673de499b135557ac78f9b0e6a3bc0e90e38fd76803d7387fefe647a6e1fad37015dbdd9d670dfa92b93823bace5b80666f806693eef261c2be3a0ddbb85f9d8704e4b6e4f44eef13d555ddb8a96a71f3dd099c77edd0be695d58371c0dacb33db8b5c6f132d6808cf29c1db60f10cd0624e6550aa75d67e706beef4ae3f76d4f0a11a6de6e37ed3abea62cc11adeccf5ec341abcb6c612a089238f7a9dcb6401e8d68674d3e2860ae9d605bf2f779ad7901780868fe56e3ab84993c56ba47725a9220dc199703695be64abe38738f63ae30749ce14fe1dfdf2c3795d6a9e138539531259b158fa8c5feef0228f1711c24ba1eecaedbc7d0ce368fd83b99ebd3b21cccb43f20a9fb7284773322e60b367f2c0f44e37f63d439b7acf40648b96932d3a1d5f9ece4345780dd979c0b2f84c8431bf5717c7c843f9a4ae624769c5d3d4ce2fca8bcdc182b99f9dde99a2335cc3dc3b84975ba57cab54be566d48bfd1cfc74cd2b1a04bb1fe47d994745c3f90ed56b08a1d208b8d996c2aabe3c490dfd3b727e0bbe5791b8bf7c168703f573babb3fc4161ec14e8d3fade19a27c3c22fd2dcbbb696e6084d981791dfc968d8df942c516a0fb88037cab9059bf6c5bd93771383623f7be65dc7aa5ecfa8db26a5ae1786f1babc768a8df3646f38a7dfd709db7eaec7d1bf3fd00104f17bd2f7fe887ced1329c73eebd766cab33f44efd61deacc12afcba6672f03d9c01f22f81f940df5f1849b6f8dd36db66feed76d092804dfab5a729da60e65bb1dffa366ff2faa1e4977f257124b9bb009cc33979138b3e437d0af2d8258da3c1b13644a6a6ef0dafda61e5dbf3241ee4e0425c97a6f48af4613ace0af923cb639093373c403799526b447296215d975d53e4b7ad94978ca599077d96704fd9c16aef789db31d58673bf02560473b6a07f9f6a0ff16df5b2a72ea63e3df0d707cd763df2eca1999f613c2fb8947db17f3a97e308dbbffb5d7cef327c100b87d5d4bb2d65d65f05aa6a2f648ec13f3ddaaf56dd9f9df31cecc7e70e13d2c652b9eed00bd03ccc7e0c37cfbf4faa1cf8ab51ec3183a3b9537f81c23324a106718e8b203da08673d7476f1f08e97b7710ef347988b2f47ef8ff13aa08783307004b9f726d4be82738ae587bdcedf1fa6185bf81e9dd8d71e38e7e098d65cdaa5bfcffb677bdd7977c2d91ebd77c2ef66d19b012edd6db5bddf18f2cd56c792693425aadf6863cc8ef660f505d8962e38eb80ed4262030dff680deefec8f02367d08add41ff8a7387c3f790690c3647f60504ff5938de8c6ce4b691939fa2719098435bed70e791feced0c1b362609f017e1937f3f92fe9185c5d190796979d8b99ab9f60910d5eae66e0a4b8d6046bccbba7d862d9dfb10f40f83bb1d70b6273cdd85434defcb278cd02fd500f34555fb8c72c8de5fd241887193ce574acbcdff8f3a4f090531a8d65c81f5e7e8cc714a34f34d6c5ab6c1ecc98e107d0578a712ca2553c1dbca87a6f99a595f192adff60e6493082f1f84617335c01bebce67f273e18d1ef65f3d38ca56a2d3489373f9c2b5d8c37acbcc4e661c79d1bac9b9f17ca73ce9f13fe8d3b9f4cbd82b92c5e5473b1f77873a1f2a51760396877ade770e793d467d62ac9752e58abf477fe5ae5eb8be767f5b4a6b568df78f34b31674571c4b383f6584afa9c2b57082e6c3ac6dcf7fc35c398ae07be4c21634ab11945631b1fb5c3cb43ebe6e537e88360b40a71671bd6c10f4cfebc2ef88d708e0fef389e04fb39b3f2215f2733278cef5abe5683beaf0d96b260ade28d785f3df49ebed77b6d2e1fc2baccbe8279d0856b047ee3ef2bba5e918c788db5c5882b235c650f7492425f00b75ec6b69f8970fdb2f1d82c7f105b8a9fcb34c5d260ce2adaef90cbe151bb0d722f93b7a344b80a2c3d727e8d351bf756bf2d18e3938f1faa3d376d48d156447f9cfb441be6fd39ac0e948f27d5864c8c69513fc9bb328d9317aaa83f74e726d16b4dde4e89c798e60ae1e016f3f5346aac395d88c1e4279896e2fe29fd82ed7fd430170e23cfa9fe31a63f07d377e1eeb560d410f20e8d37cf8c5b704e52e3a6ce22a66f6ba03d804e55c0b744deb3750ffb606a587b715d8c15cdd29a2f53693990c831de7c99fd81e4d4607544d80fac5f647218c7962109ebf1eb609cd0dcfcd09937f32d6c7714c79e256ff1327d4fabe62bcbf35388ce278803ccc8397e3e29ee3c783997c5f9a1c4f42e909155dbab824d53612dd05bd41c5d2bb55325ff7485f5b007ae182baca09ea9f8b1a9dc7d1e0fea8b3663835373be6d8cfb855faf53bc6e8b0e6facac2eabdc7d733d0e36eb1df5c686c56540e546cf807e1b16fbef3831a0af8989bfcbf707ef45a1bf69c5f341e5e77384f188b4af562df0e3c679d9fc8a7d5a111bffc529eb622c42aaac04717f18994fca2e23f4ce2ba517fd3d67eeb83df960c17565eb99caea6233e7f12bba971a5273f7d2d80e33683572fc9ebfab2ba22b6c83c5dfcafe5edc47b66ccecf20c2cf91e15b5f98ffef3572804c80f73474bbd0a7c98e7dad47c9f8d6bc71b0f403ede763048ae207386bf7b0b93c58585ec2b85169f97086f09f8acacfe5bc8f96fa9ed103103dd6a85d882139b8f3ea429e13f42be08124b62827cfd3720857ad8cdeb01de66e39f77b613fd9b2397e166334a9fa619ff0980d6478551e33e4635d1e03ed7f278f4556b7168f45894ca9c663112583eaf118a0c7c7790cf4fbc3792c4befdfc663cde9629cf2d8c0a9c163f7b0368f0d9cefe5b10b7cbf5e9dc740f93a3c9696afcd63f7f03b780cf4fbe3792c43efdfc66334d6dc71b2de5f6ae85b0dbbaece05dbff3ebd6b3bb8d5d2bd40f93afa575abeb60e06e8f1613d0cf4fbc375b12cbd7fb63e96c6ce0e3bf1c6684a88a7d0df35f8eae604fed5adcd5ba49fefe32fe82b0aebe9f7a44e1d3ecbd6a9cd6b84461fe637d2ff8fe739661d7e3adf4d82556406abe3643df61db5f30e63c372ef78056fb99837bb5aafed6770857b54be94c7f9b4316647cb680afa5cd2efbf399844f9f767dc367682eff1bb8fdbd532640ff971560f67d0ba3a8aaf58f38e6f1b63dfea0a7f3fa318ade545d4afad8cff6d19ba34e936715b726c813928cdc7666df930d677b07a2fc1e3bd4d337863491c6696c6545c03fa9cc439ddbecdf9fd4f16a3bb191e9bd9b7dad9f836bd37ba4e7b4b555bbc3ec66b49400be9ae07e3e67491c1774c70de91cf7ba94c0e23b9a00dc90c8f8fc2b13c96be782e7d6fbb78a1f9258dc94339ce215dbecd9b182b7afceeaac5f9ea73fb8787a7cfd38f70399c0f2114f741f0f62bf44370fbf97dad61ac4b6cad2ddf09757f2bc6ff07e3057457acf5f89195a3afdc9c00f01dfa7aec833deb28cc39919dab57690c642eb5c64168553a168829dec9c43c635c29d509fa372ead691c7246b6a336eca005eb6f3998e6e4b7a2b6b965c0d983e60a7f4be7b6128e5f347f92a3a54ad974ddac33d0dda9be9eb579fb3e1ac0bc4fbcf685bfa76dc238ad48cbe5cdc33859309f0c9fdf613dce1d17ce3502e3b9a47b96fe586eae2d7f7a78e1d203d67b9cee02ba3f36e0dc0e2ef9f166f2dff0d7553f019d61c3f1d5326daf856ddf4bda66c6cd8bd79fac1156dc9683858f7337e46853808d8fdbaad4afb1ba5ab975360dfd6d1aacae5637bba7f9ef0cf01a19cd63b5fef0bb8490d727fecdabd22faf2ce0350e5dba69ee0a1e7dd3f62bf41bea60acc0e6f7dda1eb5bac3c4ff269a478eaaf118ca1379a475eff40f77287e3b319aea4bc1c2d1e431fc714086203710e102e6fe5300a733c9c7faf58c857b9bc0e9017b87d71782a9f1302f3836f07ccdd71764c29767e46ce925845925ba2645ee40d4d595f545c2937c78520ae949d9f19f41f96c1b5d7f27d029ef0dd817e12ad9b13ac2453c9fb3f72f95f04e5a8fe8a65101a2b7973f47007fdd815ac615539c49487eb3dcbac23b66d84b248d8a751b41fa8fd88f9a360ae5cde48e99bda2d5c5ee3cf932f87b2fd26f243c0bb756411bbc6446664653ef2935590471cba93fc407b1cbf5689cf104feec56b5ecadf29fd1d75165bebd961235a77b8374d84d994b51748ee722c9f783a53a60d94338bf17764fb41e775969f491e6b2c2f0af791a3ac2ed6a1da1e82653d76ffe4fa63e4697e5e15e42e905b81e0fcced1917f8ee7e657267b717fe33d280ff833bb6748de762cef05729559bf72f98bfa15f028b38e58460bf62a6c4b28832be4d9217aa88dfdfbc2dc7d1023b59a2d28d2756da86300bbae599e23d0ab6eef15f497b565d7c5b67c99ddc4d223b565cbe7dca8348e321b963b9680b5ad0bc623b45159db36a13bac330d6139814dad4713a3f90edbc8ca7e5437c0bf15b5cf2d93da778e2221dbb52bf02164e621a005d79eaf4437f8469fee8fce8bc6eb43f87bb65df8de69b2e8e4de60233faa1b2c73f66e42b310e19416ad07b6b3d7423b9b6f5b123b9bf1a1a7eb40f2bce6c76d07abd02c1e37b17985767cb6fdbeb87dbe4f286d9f193fd776036b80ce4b9e9dcab75f78361839e338676f61dfccd98af534ce995a92afae7a9f5cdba6582fe5f62d3a63b9362a5857335849aeb2f26dae7d9ef451a56f266f64453f4824d051f1186667a0ef6d8cb1ec0ef2b2b7701c65fa69627709f8ad92ee96b36305bcc6d8e711c70f53aebbe5727cf27c3079db1cae31d7f6a9a6bbe5733756ea2fd1b9567c5bbd4c774be699ea8255fa4dec02d13a16eb6ec447c02fc7b7ef44bc93b1aff8fe3cd6aea9c643d9fdcbfa5e32b665012f31fd16d902b9fdcab50932732ef1c754f0ebf1e72b905799be45febd025b5628b3046b8e654b910fa3486ef16c5a30f665e237accc7b5c9f51ce8753ccf7b47fa1cc77446ca508e3f116d94a3c3d2c6b2771ec6f6e5f5c3f55d61e2cdc63599bb7787f119b9ebbb7b27d32f297995fb99cc6f6bc5007c8d1b4d847456cde12594df529f273e6ec7b811c66e65b2eafa9be05bccbac2bc7d7c3f81604325b9cb3547f980d1cf385fb958f3fff7e593efed23b6632a7df7acf4ccdf9ef70d70c69f293ee9b510cf4cfbc738eacdf76ef0cd6f167dc3d83767fcefd33588f9f74070dd6e167de4367dbffd977d1704ffcaefb68b0febffa4e1acaa4df712f0ddf20fceebb69b02f7eebfdb488df7ee21d359405bfe69e1aacf1afbcab863cf51beeaba18cfae577d642def925f7d660cebffcee1aeed7df737f2d9657bff40e1bc8ebbfc13d36e6bddf71978d64f6afb9cf067dfd863b6deedefa79f7da421de027de6d833e7fd3fd7601effed03bee4a6ff9c89e71d09b8f629bb4e2ddafc006183811b10d2bd89c35ee76c5fd65ede255c91d7ec9bd68651b9d3fe74ae328bbaf2ef63d541a8ff01e9a7387cdb34f0576d2c58ae5abad0aed79fc5b61fbdc32a97d7a0fb16d2e8a17a0e751e6b3a850364b372bf02ff630839f7d4ff0b379f11fc2df73ed22bbf826b68bf934c37671d17ae0fbf3bef8fe9c4f1b7c7ffe52b00ed8aecd8fdb68fa96d22f1c37b9bf16decf67dbbf8bdbe7fb0bd2f699f1f36d30202738676c91fe5a64fbf26cf4a2be195b88e850ac4fbbe86e9d77ce0afbe4eab0c5f745dcbe45fa2bdfd6bf87a9aed9e4d15aa4bb72fb4eee69c83d5435bb1fec0bee9d111ec37103cf66df73d7b3bcec2d1e47c97d5162ff0af8add2fd49eede59c06b79fb10cc99d1e72adc9d64edfb421b89f813c01a7375b96af726c9fccaee22e9fe447a5ce53b1332cf728c62ba5f810e57f5be84d8f9a57647055995b7790a7c3655e515c7e602b42eb1fbc4bc54c947c8b17b01ef727d38595bbfd836a8109723982f5f5e65fb16c5e614dc2d0b65167fcdb93ec2acdd5f24b70a6cff323f21c37bbcb88e7c8c4521dfd3767f597c07b647a1cc76d7306f83d84ee3e86139db901b6fc8e98b174792b5458bf758e6ceb9647f619b90bbb772f6afd89f95b1f18be434f12b8874803c4d8bfda3789e25b29aee53108f94b37b4b7da478be1530e5d3be05bccbac6ba1bf08dbfa22992d8e2b7f89b55e2763736f07b79f6e73437c925f68739339fd4e9b9b9ef3dfc1e686182e3fc7e66ed8dd9f6c73aff797df6573230c9a1f6f73c3767f8ecd0dd6e3e7d8dc701d7ea2cd9d6dffa7dbdc504efc269b1baeff2fb6b9914cfa0d3637c45cfaed3637d817bfd5e616f1db4fb4b9c19c7f8dcd0dd7f817dadc88a77ebdcd8d64d42fb7b945bcf34b6c6e48eb5f6e7303defd3d367781bcfa95363794d77f039b1bf3deefb0b9a1ccfe453637e8ebd7dbdcfcbdf5d36c6ea10ef0136d6e844ff97b6cee02defdb13637d4615b7b37c1ceb72eb6d297a601c47ae3e8bbb02cca2f308cd0e7de19e71b606d06d056821719dcdfcdf52bcab59a9bbf30be7de128697c3becfb61cf6b8d15bed7ae355efce6b86ccc627f85a34c87c93b78501fc5540f5601350638078e2d949427b91dc818707e08d13c230bd8b34ad34fe9c2c114ae18d780d6ed1e929c35091d602e05d13adf4392ef211943883e0be799622113ba70b044c5ef1fb4c5e8a6e5680df64e1d5a23bf4b2d5a23df4129ada5abbbd671de99bb6faaaf404e5d32f93eb3bf3f9b79dce2de25b28dd6f364bd7aa0dca9ab8b65e8d234e8e7b0405f917caa50aeb4cf2167dc38662bcdc1cbf4c3c66cc57264ab27768e9c734f3076b64db5bcccb779537694558c64dfeae092b52aa03bbbbfe198deb79e9c6d8bc79f382f789536cbcb80fd04737f015e53c9fb34985711ec33d511c4bed175f6676b0074201ede2913a748de27bc83f3d11df4a58da167f156617e65ff90e711aa4c9462b20ab15a8bfadd9b21f4234ad63a4abf3f9cd3ef99dc29296ee9c4b0cedbc1ea98c53245df158e599d9d4d256a66ea79298f90df73ba043dbe07a0cb463d327262b3de27b981687a5881dfb08cd72f3817579a0bbc1791dc575fb6304fd40cfae3b49e76a3dac3b971646e9b545b24874e71bbcbcec35d77e0b89db87dfa3a2ca6c3d779e7ddf1daa71c6fe0dffc2f935007bcf3b69db3f3a6f2f3129ae5790cb693e0ce66739fc7da506e4d423d9e18adab3df485b9d5d3bcd29d4626dfee709762ba3e4eb724d7fee114a1b2e9fe990678ff1ca2833df06fd3700cf6ea49ebde6e935efba2758f5f506eaa6584eda3e4bd530efbf6e178f2bb1ddc9b1303e53d8518cf8bf3de54e19eb8a4733a46281fa00eb1ed499ed40c6f421d7e7636138c63641fa4f3249fa9b984d6d9517ccf1e2cbfe07cffa88cea9e800e3b5923bb0a9c5f4e20ef6db04794fdde027204e7d182d81783fe11bd516a5fb505ce9fd425f9903ab1a58cf75f216d6eb749b771d31fcb33b3f770bbbc36513b6d981779341c8766f092d07b727891bf32fb5f3f99c6ecdd8de58b0573b1413f2a6c7f7a68ef34d4de43d09eaacf993d4bc643f4916c9bfcfc0cea643192b72ad1fb46f29819a713e98bce97549f657339b9b1a4ea69ae68559ff3fa79b969c32cee32db0eb639c978f867e3098e99ca5fcfe9ef34592c6f541959ebf2ca38743bbc719ff0b8d3fce08bf68da151b0c3fa455a8e7fc6c9840e91b9de513a20af4df94497dd0c5638ffe3cb8d3317a453864e04dada2ab9bc0abd8ec2de11c232caa62b81dfbf40fddfa071b03b0ae31f0b2dce3a72da0e2d2edddc807957124d16a0ef7e6c29fe95733f11b95de936e936a3ed5c02f342328fe4d464f478399e2cda97492037e876d3dc996cfba6c76d3f3d7fd83e2edc3e482e4ca6bc14696bb086e37063348439e0ecf56ba4cd6f577b7da4cb23fc6f51de3638df173c963e3b5f713d0fd76b66ea25b9ed8539f322bb2bdd21bdbad29da2173aa385fd499116d234e0b7ed76a518af459c5f6b511dc7c375ba993a31e6e3ab78fe233cff3177fe827a175caf91ad87d65e34c6c942a778bc2f9afb03cffdc1f0b970ed353c169dc3ebfc7e4c8fdb4fcaefc27973fa4af2bff2d75c1fa66baecd1b42bed7bb88efa9f218f7fe45b40ef164f18ac733e3cc5d58cfc3f59a997ae9badf456b68772509f399c4f0bdb03f29d2a9bdcfcda118c8d264b1c4635a5173817ae4834fb351a44359b1a3db4f73ddf2fbf1b8fda473e7f765dcb87d41ff84607c4e57ba6de9bc15cc39d291a00c0d3b4d2043f3b90a38e5635cbe912b4ff6397327ef0c4691d6bd5d9de131d286543e8374be9c3a375c6797ab2390ed611bc8eaa49c38bf2698ef0b9e6f9f99af302fe87004cf04389e4c4e8612f91e763c417fc93e17f60968c0eb339171827a40fe526b2ed847372ce36f50c6e7f35c787c1901e9e0e131855c3a08e642ea1db3f5cae47cd841721ef2db98a61f39e744347864f85ec80b1a5e1b9de57d7e9d18d769e4eac4897d289a7f8ce7af72e72fa877c3f576b97a05f23e6c03f99dee05914c84f37fc5f39fb1f3179c13800774bc37e97e4a653edc0bdcfed2334fd4e7e0c6ef93d04154af2bc9193ee0e6ceed20b90fc7c5e66b11d489719d46ae0ee103be3c1ea0f302f081bee6f281a0de0dd7dbe5eae1f973cf1929b20fa94e39f524a12ee976e52bd179e93af8ec8bc4baf228b20f58ef351a778ede27ae6b24759bb9baa96c9c0bf5e60ba883f400f9aab1e7a1b8dfb97ccde8c005fa907d1891f1b17ab0b0de88d46be4ea5134e1af83e9c9572027d13a70f561615dbb4beaee7275f179211a2fa0874ad16321d28be52b9045686c3ac32342ddd8683c927552591d49d41fa405af3f8a16421d59d027e10d919e6c1f66f47e11eaa080164457a6eb24b428e48d57c21b128f3784758da46e335737dd2f0ba1be0d6822119ad0fa7d429302fea075e7c94217d32491218cfe1c097461c01f72322e8e0e2dea0ff007b73f9a3ff87d5e447d26b410c853ec5b4a6821f02d49e8aee50fbc56f8de25430f7e3c06e00fd426dac7b9fe129a08faf50afb4de822e8dbb815f68d68c3ab5b25e7b604f10335da0e65fd39e8be1cf9749ae8ef4e9e8f185bc186777812b4176c8ccd4cf593da626c7f5e617f05f609a055519f988fd87a73f961cf297fcd41ecdf41f106d8c793afe715db2b094de6d4f8787babe06c4eeb1f99fa66d092dc412b2af06b5d103db16e00ffee73fc34c2331acc37a6e924e807f3cc885ac3719e67c4be8bb46e83ad5b6cd32434f2281a855c1a09eadfa8fa3ba63ee621a13f0396a76491e02cc4f4d128fa306737d7f6487828a6c6c79145827ebdc27e4bec9e843682be316df87511ef4814ef149de3b02cb5c724c6e75770968371e9940cd07972a7e03c4feb1f99fa09ff887d68788fbd52fc3b637d82e2731dcc57a6e824f071219c619d92057ade2f7ee09fd18e87fac0676db63fafd8564ae823e837a50fbf6fbb5bd837f61772ebdec85940dff3b276f06b7206007b0e9f01f97c9d9c7a23aade8e534f78477233113d6fc86f83fea67d37294dd8ba981ea86e97ad2b3ab31c7c0e657d4a223fd96b726e24346173988acebc1be6973b3546badfd2730bd8d785fd97dfab103a09c740e4b2a83e394728de11fb5fc8f913769a4cbdb8f0be85d02aa6c619f369c5af6fd2f5e76cfdb2f3cb19a4e757c2c721cf4f5944279da613df0746782aa6d65465f3e28a7c5569dd1da76ee11d0da1d183a2d183bfdff8f5312f3da8357a7078893f767c3665fc57429fdc6b7296101ae9ac2c129d838497246a9c128f97c4feb95171ffe5f73a8456c231105a15f3d38ae627be2f6d989e67c958591925a83ba2eaee3875093ff1cf15333d0f093fc97c7ee2d7a7ceb45b72a6b134e29fa7b0bf8cafacc8bf8663971b94fd91f5b3a17b02b14f11f114f12b225a657d8b44bf16b76166da38b26d64fc62225be686eb51b648d6df88ef12c4e3c075687b44e887c371e257da2ec8f8e3129f6a09dd3c9a6e3cbb4412dfade2f166d68e6b9b08dbb864dae8b26da47ed6429a3db699f8b8425e7b64c6ab32bc5668a7d8078d5edf07d7b7564c2f61ff14bd0aed958231101e2bb059327eb9225f1ea197448f97b9b32ff05f121ea36d97ac1f33e131611b66a68d23db06ed8713da40846eaf34ddd87bfd8271e03a7286cf44f7fbb86c866eac4c13def5a3b12ee9b1f2ec1951ff5e59ff149f0963004ac640e8557406a0d8be845e225f24ce2f93f8048fe9e7fcdee4c707123e83ed537224db7f4237915f7254691c09ed0463b1bb95c682e8c76b43fcde45d50ffbc4cf691bafe57e4e433e267e47f877353f276c1befd95c3fc57ecea2fe4afc9c457d16f83923ab5bd9cf09cbe23d91ad57c1cf09c734a7c657d3cf99d63f32f52bf939213dc91d28f8bb969f13ccb79a9f13b63da2d6b0869f33addb60eb96fb39215d3c8a4635fd9c69fd1d53bfcccf09cb57f173c27969147daafb39611f3135be3a7ecea27e2bf8398bfa2ef17302dea9eae78465a93d56cbcf09c6a55332a0ae9f33ad7f64ea57f27342babe52fc5bcbcf09e65bc9cf09c746c9821a7e4ed8073e57b2fd55f07316f55bc1cf59d477b19f139f05657e4e7206007b199f0195fc9c69bd1da75ea19f13cc83f839e1df35fc9c69dd2e5bb7c0cf89ce9e8a7e4e726e2434a9e7e724671e19636d3f6761ffd5fc9c856328f573e273a4929f939c3f61a7c9d42bf773c233921a675d3f675a7fced6afe2e724e757c2c775fc9cf84ca8e2e7246741b2a635fc9c69dd1da76ea99f13d0e541d1a8ae9f33addf65eb97f839d17954d1cf49ce1242a39a7e4e720e9271d6f67316f65fcdcf593886523f27e4a76a7e4e72ae2463ade1e74cebee38754bfd9ce44c22fc54d7cf99d6efb2f54bfc9c10bfa0b29f13e6a06850f6476d3f27aa43d9201ff073526d1cd9362afa39513dca16a9ebe74475aafa39d1983d7accb5fc9c54fd1da77e053f271e6f66edeafa39e936ba6c1ba57e4e54bf9a9f1397cd8cb7869f13d148a3d7b79e9fb3a4ff2a7ece923194f939619f95fd9ca87d891e6f4d3f27aad3a579acb69f936ae3c8b651d1cf89eabdd274abe7e74475aaf93971d90cdd6af839d15897f458ebf9394bfaafe2e72c1943999f13f459d9cf99e4928ad335aee9e74cfaa3e4486d3f67957154f0735619cb47fc9c14ae8f04f176cafd9cf730f53b82bf2bfa3941db64cf66fb29f17316f457e6e72ce8b3c0cf79b1e2ca7e4e5816ef896cbd2a7e4e30a63935beba7ecea4fe91a95fcdcf09e849740bf0772d3f27986f453f27687b44ad611d3f6752b7c1d6ade0e70474f1281ad5f57326f5774cfd523f27285fc9cf09e6a551f4a9e1e7047dc4d4f86af9390bfaade2e72ce8bbc4cf0978a7aa9f1396a5f6583d3fe7c049fd9ce0efba7ecea4fe91a95fcdcf09e8fa4af16f2d3f27986f353f27181b250b6af839611ff85cc9f657c5cf59d06f053f6751df257e4e741694fa39f11900ed65740654f37326f5769c7a857e4e300fe2e7847fd7f073a675bb6cdd023f273a7baafa39f1b991d0a49e9f939c79648cf5fd9c45fd57f373168ea1dccf89ce916a7e4e7cfe403f67ae5eb99f139e91d438ebfa39d3fa73b67e253f273ebf123eaee5e7446742253f273e0b9235ade3e74ceaee38754bfd9c802e0f8a4675fd9c69fd2e5bbfc4cf89cea3aa7e4e7c96101ad5f4739273908cb3be9fb3a8ff6a7ecec23194fb39013f55f473e27325196b1d3f675277c7a95beae7246712e1a7ba7eceb47e97ad5fe2e784b93eabfb39ef21e5e7ccd7ade2e74475281be4037e4eaa8d23db46553f27ac47d922b5fd9cb04e553f271ab3478fb9969f93aabfe3d4afe2e744e3cdac5d6d3f27d546976da3dccf09eb57f473a2b299f1d6f173421a69f4fad6f47316f75fc9cf593c86323f27ecb3ba9f13b62fd1e3ade9e74475ba348fd5f673526d1cd936aafa3961bd579a6e35fd9cb04e453f272a9ba15b1d3f271ceb921e6b4d3f6771ff95fc9cc56328f373a639f9aaf839610e43cabf483e57f77326fd5172a4be9fb3c2382af839ab8ce5237ecebb7668df30567c04f313946223ca0d3bc147447f57c248846d630cc35c3fc5588985fd15632616f559809db81ddc2ae3276e31761558db5cbd721c4538a6176a7c35f114d3fa4da67e255c45484f822d03ffae83af08e75b096311b61d536b58036b91aadb65ea96632e42ba8c281ad5c45e4ceb37d8fa25188cb07c151c4638af07459fea788cb00f8d1a5f1d5cc6c27ecbf1198bfa2ec16904bc5315ab718b31b1c81eab85d908c7f54a8db12676635abfc9d4af84e108e92a51fc5b0bcb11ceb70a9e231cdb921a67755cc7ed20c55bccf5578eef58d46f059cc7a2be8bf11ef1595086f948ce00f8ee189d0195b01fd37a0d4ebd420cc86d82e988cf841a58905b0a0f92ad2bc684c4674f355c48726e2434a9870fb9a5f01af3fd56c2892ceebf125e64e1184a7123f13952093b32397f6ec9d9550343128ecda3c659134b32ad7f64eb57c19424e757c2c775b025f19950055f929c05c99ad6c0994ceb3638754bf126b7097624a6514ddcc92d853dc9d62fc69fc4e751350c4a72962434aa8745b9a5b021f3fd56c2a42ceebf123665e1184a312a213f55c3a924e74a32d61a789569dd06a76e296ee536c1a03c9233a9167ee596c2b064eb17e358c23c4cd5b12c614e25dafea88b6989ea5036c807b02da9369a9c36aa615ce27aa99e541beb12d5a9887789c63ca2c75c0bf792aadfe0d4af827fd9b0a99852d45e6d1ccc068d85c9b6518a8789ea57c3c44465637abc35b031118d1e99f5ad879159dc7f15accc9231946166a23e2be366c2f669bba52e7e26aaf34af3586d1c4daa8d26a78d6a789ab81e4db79ab89aa84e356c4d543623d36a606ca2b1ca99b1d6c3da2ceebf0ae666c918cab037512ed88af89bb02f0a0b33f95c1d8733e92f4ee5486d3cce2ae3a880cb59652c05f89cad4992db13e6febaa01cf1e7bd0573550bf2a42d22a44ff4a2c4ae9b2aa99d3655f099dd8b9e27867fb506abc6f4f0f2c519b4ce2827aaecbbc1eaea0e35449f4794e6e0f546de5419ef4d250a513ef2d7c8545a175b1d3d3b81eb99f31dce8f75bb7d5d4b5777b06ab85d39b295e63bca93b5fa6205adabdb9503d390cff6f0f8bc942c1dfc3e598d7d4b5d5dccf5e8b23056577bf0c76561b4aea6a1bf4f5ef4771bcc6fd07f4cba636d638c7d27e8c39c58a317f9dd0efef026dd71cf5657f1c658c5a397a6efc6a38b6db4aeb68af25be5d7078f2dc98fbe5567fe76f89ae4914df3c1e7753254ce55c77b6718a99b812f5973f9e10cfa076b71bedaea38328d5b7e2f4ad67a0ff3a08aebb9b1b9667cc6a8de7a16bb46f360ae67fe969fa70cb74fffb6bad803ffe628fe8397eb0b8d23a103caa79aa30137d7279e3fc99789eb933cb8fcbcaab84e92bf37d70f3f277f057c5a32164522f93e311d48fe616e5e584c533d4a7295e6c623c8fd99d64bf2c5e7fbe3e715ae922796ec15eb036b41e78aadb31e304ebdde9a08f3b04e17c718dfbd609aa4b9637363e2e6624df8304e72f566692bc8234bd54b72aee7fae3e74faf768f84d71cc8ffda730167e547e692c91f5f6d2e291d6cd5f59d40df3bca8ad4959ca07f7514ebdd09a467201bacf5f860ae85325176869dc236dc75e756d2c6c15c77ceeea07fd9accf3ee12d7738dedba11e00193905ed0f7c09f0db96cdab17803367b276513e3578f6e1fccdf4f7bdd343eb3132935f17f0b862bddbe16be42a38175c7087ff7272ebe7dbc072fd4ece2f95e465d37b6dc6f7992b139941eb62b163572b8cddb395fb11f041be5fd826b33f93be11ed0d9dd47bb843ff622dcef87b4d20dbd039b155c059db8aa79e1e58812928db893786bc7742ff0bd101468ff64d57c68195937f2653e7f88c725d6a17de6f9b013cc7b2ed2e2c4f63f2e411f9bf023acad15c8f9be9d835c57c38b2aee4e4b8f14278f46119fabb1dcc04e3d17d53695dad413fde2ece27987f3704bad0fddd399c0e7aef285987a5680f3f5c433f586bfd418d279e0e5e54bdb7148e87d421b93f2703de6f7b98d3976af73e5d984d2d10ac29d0f1821645cb17d55c8cbd6957b046b0fc1f2c3df0f7ecda7402fd60aabae08c71957e6c07fed55253da598175d0166d3577b6ec993a8f73602bbaecc477de6fcc9a98c14bc33cb0fb11d3f0681acd3033766576d01e4b499f8b6831dbdb81eb3b07761cd6a07fb59455ccae87bed71ffda316bc08d6e38ef3a3a77b6c3a309b56be7cca17171c97c18c019c094016306ba2e89ed5d38fa64077053cbda1d6437bbc3eccc5eb4db41eb03c672df0f7cc3a688bd15defbd288275f06c6546efd3585b8c64edc0e463c5f4926577b03a96eeeba4fcf8621afa1bca5f8aeb84f9fcaf6c6e5a3c36ac974bb23624f339e3dcb623599fdf627db1bc6887a53ce936383969115d3603ff08f3d92b4d697a68bf6bddce7e9a8c65169b4613e8fd31b4a95422c3327966bf9841ffb051dcd8565757ab2b93b1a07358edec1d60a3c13ccfe3a3b51e3d6b149f03b946ec236073d841728e677e13e4b425748c2da3ffb655dd7707d879e1d8378dcb33fe377295566ce5f3cac3ef6494073940f5276b585f70868c7d4759a57b21f0af9bc12adeac99f2ca66ad9fcde0ee6f5594cf7863dccfeef0f8ec06fe63638c2f6c0e75ff9afcb68e707dffb259cf9a89dd943ba712dd7608cea2a6b48d4f97c4def66ecfd360ec5b412bb6d6113923500ee2072f277c85fcc1c6cbb31db4a4afdda6e70e1a67a2275983d5c156c77b6cdbcb7630f39de07eb68355cb34c69ee347d2663dbb582be9791af403a4db001b79e6dbe1ebf3c86b9f465e67ef0e67f1d7e1ecec0eeefed779dbfb3a1fb79ce1d8dfe6edc1b0135b73b46e3919e0d983d661a3f6655b9df993612746799131cfad3b8dd110ac9d7f1c75f5bd3518c966f0fac519f4436b2e7b4eb0da6f62f96c87fad91a2c9fcdb5f5be31662d77b0f74d63f6c51af80f6770df6f176709e6c59ecb27cbe883b6234b1dbfbbeb4e6b63c8e1643843f794ca92f061b4319a8f8d31f3a7415fb115fff8cdd8c593c1ee311976bed8eaf8b619f4afcee3046cdf33a03ff89b94c9b7651a16d00193f1bd0ec1efab2f8ee23f5b8bf35e5389cce2bdabcdf31d5c87fdc6680299c8b391f2bc7d35d79667021d2fa507e4a76ff3a6671ab3504c73eda6f53a40eec09ce15b927bdc83f2e262ae3b0f6b714eff66e515ac371ace8e936e13af4147da0c230fc850b80646f338318af541bc76788c63dfea7624ab7bbbba83be670f9640ff8d81ae6a19af3b77b0ff423e4f0ffddbb6db79d8cafd6c75db076bb0f2b560c93937503bd973eb7cb683bdef043e3d06a003be8d8633b85e5f55f46f3ab7e4f723e7bb13ef3bc08fe0df1c6d911e83c79bf6dfbe22dec667630c69c98c775ad4d65afa3a894f07b0c6ee7a7cb096f2de1976ce76e0ae96c75bcb0c5aefee5007e7c00de5a06e1e1c55bbda46ff36ea9ede9f3e3d9d376fdb307afaf3fff7f4d771f7f4e7d3d3a7277d136cc15ffff9cfa7a79d17edaff67f3ba7e0f3f11a9c376f9bf073b071f65eb87d8bddcd65ffe7e7776f7bbb8006bcf0db09fceb6ea38de7c3af42d4142af2e9e9e23db64f7fb69e3f3d052777fbf4a72237be34fe68c80d197ef3afc883c5154991fe292bff94d485dcfc537efeb3d1fa6f5596fe5024a5d5fa2fe9cb9f92f4f4e9c9bbfccbf5de9efe8cdeaedb4f4f971876d8dbbe3ffd293f7ff9f24551a44f4f1aec467efee3cb974f4fbaef85c7a73fd54f4fa3f0f4f467e38fc617b5f987fae969e9b94f7f3625f9d3d300fc052ace5cd090f4e9e9af8dfb2f6777fa97f4f4e7ff933ec1fffdcfa7a73618e9e5bc75409f73f08ffc2c3db7fe501b72ebd3937e01df80113714a5d9fccfa7274d585eca948733fccfa7a76ecdf21def2dda73eb7c69fd212b0d524756e53f24b5f5c77f3e3dcd93b5e8f827e77881b3edf847b4460d09fcd2f7373bf4c3601bc27f2717c030f0cf57fc2720cbfffc0730cb7efb06d700accad3e7e565fb76f91c78d7f0f3edf476fc1c9f6edbc7677f636719e8e9d3d328389fdea2bf36d1fee9cf728e4b3934d878e1d3a7a7dec9418cbbd8bcedb651aeefddc9df84bbcfb6c736333b9df2a54b46aa6d2267fff4e7ff7bfaefa7fff9f4348f36fe963020fc30db6e2ea7f0e9cfa7f014fdc30b2fd1c6f7b7ee3fec6bf48fcdfbc6f337b6bffd8717fec3be7abefb0f67e3ecb74f9f9e06a7bee76f2fa05d30a5ffde9dc0c63ceeb62ef8f37f0881608152f27c764ee1e5e48376a9b2c1e6ed686fa2ede53368f7adf047f0ff5eb8fb1c6c03d0796f7b863ddbd76f1e18981d475bb0991dff7405033cedfc2d6c6677faec9c82f335da7e0eb6d1c6dd441b41316f13887e09a3ed5bb8f13f9fce91770a377e59b9f7eddbc53b858262e7ab7db9da853f7ede9cbd77b9b848d29beb5da237cfbe46655da6552ece7eeb5e7d4873409eb7ede5f2f99bbf89b6f417bb8777a63f3f7c0f0efb14461bb0b49f7def12e12fb677f8d75b7c8e4ec91f9f376851d007c73bef518fe8b34bffe85e36e987ad93fde82acda6dc62be48e7b3756f9b37f7922fe6fbde39f29cf49b7db0a13e25d5df36a17b8d3c9ff3d3e56a47906ff10f81db4c3f807ad427a7417da02770d96fe4cc27a5f99cf9dc9415ea73aecbc8a7e8746f4aadeca7cfe7a3777ffaf40458dbde5cb69f2ffff63fbb6fde3ba4f536744eae17eea83f3f6f2ea14c7f06b59e1b996fbc70f316d3df389777fae37e7ba73f1e2e90f592cfe76d407fbc0780b4dbb7b7d31b98ca377f03c6f32d88b25b7ee639fbcd9bfb353c9d3ffba7dd0e0d5b5020912f9fe146a950eab3bd718edbd0bd7cde04ff3ed7ade3c6e12638b976dd7adb0d23daca2b79df36ceb66ea5601b10e15dafde2984c2bd56a5f0eafb75ebbc6d5def52bfd2e5ea17304ab6d6dbe9088ecd3a0b8cabd45a2752e7edadf27c70959d734ee47f9d7ab53802d7a9456f5ce7f2efaa359c5310c05d5fad70f8cdabb89bc1deaf58f26d1b413155a56cb4b91cabce2d7adb3885d2e76deb5ee2d0c916d8dc2ee0bf7f5edce33f7727f067d9efe0bfeddb5b9562f87c2a2ee7f81e309caa15a315a292f2a7b7ed7e13bafe36cfefbcc26f5b771b46dec6af55f6f3d651de4efe167c55b362e89e4f5e18d5af797e3b39dbcba57ec54b54b952f9e23a97a0b48cbbfdb6b9fa5179875b47a9bcac8470e5adbe6dff7ddd5eca19ebb2bd60c5b7a49cb703fae37ba3b064a28479a157ade0c53d7aa7ca450360dc552d8c75bd6a85af6f5507bcdfbc6ddd4aab9bd689debc7057b5701c3a9f2f5eb8f3b7df7c6fb72f5ec3f39bf7be89b660634427e7542c70f285a11608ffaf545671abbe9d8be52953e9dfd76d5efa57aa82febffe20dfcab60053e31ec0ff4abbba6cdfde3d672b50324b0a277f6c2264076e3f569da36208aa324a82b81cf8af46c3257b802a07fee3346c6fc2c7c6833630d023deae977fbe5dc3c80bb6fffc767a0b365194d7f2ecb78dfbcd8b1e9f7727a2410b546967fb1679dfbccfbb13fe2bff33743e7dbedff7c83143fde6eedee2cb1154fde7db3674b78ff7d33537d5edfbc63fef4fb6b7c103ffd7651b32eacdb74b788abc6f71f247f6e7dd3642b53ebf6ddeb7e13ff38a3decdff5b06ef8f9fd8f929f134152b5dc6730f768b3ab5cfe7cca8b99a2c26063552ecd6ebbdde99f9768e31c3fc3ffcfff665fbf7ddbf8a7cffbeddb36ff1bf4d7edde4ed733e210ffedca2d0247685fbff187ca29e2fc7307d7e9b3bbbd386fde393abd95d48ae2f3f652a5cce74d98670f7e39f7fab68998735b50781b9ca36acd42df6ff4ff67efca7a14c7bdfd7799d7bed361ed8691fe0f05d515426d5441b1495723c73189c18933598020fdbffb95ed6c6423b446ba2f79a882d8bf9378393e3ef631bf00d32e44bb16b0eddc8d4caaf904f1ced469d10a466c6cb19e84a62dc1fccd33d9e97db2ddae3678c777996ba37d0b163560a9c011103fa7631cedfb582bca003676251d9c99aa1c3b1984036ce34f9dda065f62b2ab7f487eec97a0a47863a60e38ae42d1faefb6143a23f83b32920de0216bba6b8a3ac8a5e408d4ecfe734d711711043d94ed959bc205eec96d21171ac804f74a1d01c15ad110ae9074a86ba322ad2d93c92dcb4b916cf99c696b362d60481d5b3220a9c8e27f20eb67e5213670dcac3a14a01cde44b7602e04965503e639905ac75b308f1e905505e27ef2ed0a70588da2715cc143f72673483c8319a0e8fb35e280888b02832d0be8093912b28e451b3507027cd7a6ae2725b10217ddc418fe6ed7ba892a9a060a6017d7d36a81924151e487c5e1299dfec9dc03b7243b17bdca37ca1d81ae122cfba752e0642b5682566bc1988b9cd5170254e1f62068d0dcaa3bced5b945972025591f240bc9990213e818520b6087790136738fb33eca5dd1bebc0b9645c4dd6c02bbfa4961e8b00e4642a69a35f41581c83a38d7d368ae7c9ec73590b7747e5e8af3b10bbcacaf65620f1a88108357dc737ce8f9d9b6a2365b03f02dccf4f79c47508e93443caf1e36a70d362204791839ac121e353306df3ee8853e878b1ddf769115ae7f3299f6aedd95c00e39b43423df292213e62694309daa0e28cad89f00f1907342c033906302ab08648771ac5cc611db59fd763d0701ed048282d084ebabd4c51e9574eaa1ecf47204240004486ae021b63e414e7ec1146176c0f53c64da611c3785386bbae442079805c97c37c976102f15fdcea3419afa9d3aba880cfd296289922a627cb720fc1fa49a70f3ea804554ddad0b774e75916c1552076b3bd8c41e3ede02a363b8b95e8149af972b6071cced1646aad73a02cb740c520b22e7564f2578db41bb5ae093833d54efeee1fabf02919c90a8429d79ff9ceba142c5736ef5e239ac4f1c22af0f9680a63147e34e215ff85df52520b52c312eef938bb610eab4ecb5e04d05cb08f00b7e08e177e56a2a76469eb916e0fefaa5e212f5853c6a5342f5e03ea968fd5e5fe2841d6422d7057aa8ba6c7a85c8727df73ba605491923539cc70661eaf0470e6722cfc130fc88c2459520dba19a0fa321730db489af634ba2500f77f1b300b606f71d585443c9f580e7966654d656208e189d8a72633b94498e96a7851995cf1308e6e2023da5874520fe9f952ff5280f11c41a336030ea2122011b4b903a0e2299db15437542d574c9aa5005152986d7c51d8eb711c9ce56154e28543d94e4201dbbe14678159c7adca9bc052becbc626846518a41be8585118cb62da8c44f528aaf1a15d54cadf7697a514f93953bdf16e5d6e22c45e7e7a0892ab2d8d4c2631125185b3d68bb4e36139dede8e4da55ba85d8529a1fe593a077363ccf2e82b074fe4ff7c3515b00c83d34ce908c0eacc8b4c37df96c36d62c50981e9b3b6c221739e1b2330b4b7a329541d9cc9c2ba9480d775d4b32af464c3e7b7fca1522cec9f5158fd022c7e1c185c24c1799c03622cfe62ad74d453b2c17ec908180166a5606e85bf89c4de77ded22e83b4852b1861d3f5f658ef11c60b93bea9885b9be8599c7cf6f5109b0a23bf0c3a9295cce0c15023c36f98723bc084013d7a728dbf56d9b3a9ea4fafc284b298e579623e3d92c0bd391c5a31de9cd79260a2c8b7a20716ceac861608667186bc1c3b3bc77483836e413a55fbf4c6ce9c40c457123c51271c4678711d1fe36817b2816286d4756b6386e5d523e8651013c5011bf29031060c1925ebd42f0f3ae3560ec8aa8b581fce36fa2fe5dd6334532897f500d77a86f690e5571b1760b303fb44ba8b8fc3bbeac2c101b9615cdce5622087af888c53e5409eafac8d74d9004c2c32ef590d596a15a84afbef88f807e4396d5fe77e40c6069ae010e55da58473a3aeff03b77098734d3041ddaf5ee909af36e62efeb131a2f820ba1570b954a4412572fc6f171452b6e74bbdc7113deb43929a418a78402ad0e3c1a9a75b07cd3af06101acca924971ad0ab38cf0d6ced06e557b15f791b1a9d9fad018d0e72dc808a386f6a3ba326dc06aeeb190ef5f50a154d22b4624cd66ec0aa99f71a18b810903a158d1d9472ec01211b90684fb310923e275a8cb05195e645ed578ea8d954c9fabf34bbaa013d506cde62e7e4da76843f55aa8d3f61a7b811637c72c204b930fc554b4d34b54b6a5e04f74a47571e1dd74143bb6389b5ab900af7a35c54b732b164b4e7728fc8cd1e4964e2b0510d30774addb2615284278875ca1df038c450031e9d28aa81d59185ebf63436edbadd6b02fb8ee6b01da09bc5d6210faeaf29ae57bb03d351825274b94310431cb423087a2221bcb84b22b501552e228e998a84f8646a5dfccd4e0c4feac58704ede29549067fb0e8c9928015dc038f8e03de23c34f05de23c007275b30de23149f280c85ec83fe1d5b12b6f0f7709113a604c024dff931b9f00c08fb90005bf677a354093a30b9d8f1c8abe1f16035fb9052a1d4c41b0b3c04889e4e82b69fbedc995e38c692d14c1d4a3329896ac789045c0207e9e86ca7532de4459b57c9ef90dd68a733758c967b0ea99fb45e8b840a4df0757d32bf0cc8fa22fc009c8849e7b2c2fda4c42941ae271c451eb4c054c23474dc4472f8730bf621a9584fbef229907f0f273813f36d4ff621993ef1b00d788bf2847f7cea218d9fec0a8f4e58dcf6449b88a9affc5fd47a578961c1e2b4d4aa806f17a2b317d91576ed3b2c879fe3a06e7486918adfb1009125f9627b373c65c50f5eb11922bc4eac4edcc5e28be4069607584b860621f926411e238eae34a4fa7aea3a59e27ad4e4bbe9b99cb0c973e96ee0a6926ddba1bbd475d462a11e27c7de925f9e84ce3bdf9e8c1f9f38b0625f31dee5165b8840e521e42421195f57d762ab9ca58ad608370f936f92efedda3faeaf07fcd2053b863b224ba38e54b4250e0d000dd069d543d99404ed6eab7f03cd3f9869a88b8b7e825d05f69d238a7ed05e81330edaae1a91ff2d7b05f8468dd960d02c97fd2521cb326036527013673bf41cdc002631823254182a28cb76a85fda516ee086f6bc28b764b7bc149ade342f0565f6ce6fe2f816fafffecf1f0be47a314787e513229262560e91f4ca8fd373269dfa4c26af005b1183c8ddbc29327da5da1d22128f946b5c72194e8a7ffdd1fedeee71ae1f6e3e6322a03aa50f7958420aa0ff0a6284a83dfe256621095b1a3a7fe7de4225c9500a17310d757e0c5a11d750afd32a24196ab716ed1f7ff5da7fb506df87ad9f834ee7476f902319da01e2de6019ea76db8341cc32d4be6619eafd6b2c433ffbc36ee7c72062f419f606bdfe60d8296519cae2a32a96b10c55e0ebb30c757abd76b715b30c897e88788606ff2f3c4392d8d0bfe21a4a54522d61124a588212f61fa1de21f94fa818d7ec3f694e1f81ced80ac1a9939892c6badcb22e6933101b9a3f66e3a75f8bd6dbd76a397a5264a3a5ae4edfc6fb33d5e4b6abc8674335e1503197beb2ef3dcf04d9dab7317ed095f1c3405d2d5b9bf9680fe4a7007696add97cba5cfeda78ef63258bf137ab3699cda71db05a7639d9dc58d1379da1afc94b5f7b6ce190b46fb895971db07a231169fbcb7864ab266981d593fbb27edb6f5667325bfcf25f53cfd064d252e5af6f8bd5d31ec8c4dfaea77de5d7a7013bfae0a52b0819673a7d56c60fbaf278e62f76502653a23eb630ec7ef655797901f292b03281c9946cf72dfcd27d6b6dd69ff62618ee61f7f55842048795c7de73540e71afafa85cfc0f4ea64499b8cfd175f4c7495ccdadab76a1871ea92e5efe90c7691dc3d6e42f4fbcd0e1eb873249c823df4f54bfc68f322f3aa0facbaa7d44b87d82e6977889c2638d678cefc61f5fd6d5e552bbd3c3cb9abf74e187225f9195fe4cb717ff93db061ac7c48ffaeb8513c45fe1761fe219b3f13024dbee3dcfc6434e2038d3e960630e0f683edaabf2d3055e5a58e8da93ad9acb80e972a80bf475d17fdbacdea820903ce89ff2d305c8d3e3663d3db0fbbd2e92feddcaa4a34cde5a88e9e07c7a00ebb7932a3ff5dff1c854e565efbd2308e75f56a4b55db52fef788437eb37a2329d5c78f1cb2f38c163441e3a11a4a02fab29d1261ad9ae7a3fe3f289bf017854f450b7868a5540ee8e475704f0ef81f20d755dfde5f0d686d694a8d6e742939f5adbf968b89b8fbe36eb3787d7edfa3957cf64759de9b97efc898251c0899de70ffe422617ed898f130283d130ea93ecdf4cfe952a7ff202c4e8c530caa371428f2e566423d8ae3643050f24d8fd3434f96bc8eb8159b9c33ab1ef8bd371b3e8e5f492e56f57fd8332690fd3ba321b0f0f60bd89edc54be7d3d6f6bde77158bfd92424e694dfdccdfaed329b4f438249e56a2ccf4282ca7cbb301ba47f5b32dd692f97f3af0f66776825ee723ab2ef39dd7f3cb7c0637fc1f468b3fe6c43f3abf25e9feb69a076954acc47774a581f6d7f3db566e3610b3ce6db8ed56d7678da0b12dc7cd9b89cbc34b68fe53aa3c9d3e37672c8d769e2fd54c69f44359fb02a2fe7dbf5b4ade2871fb3f983ffb9eaf3b6ff329781b6eae9bb75aba05fbd58e7441b873ad7bdeee7a8fde03e5fbf286f3b1e85f6a285e317fb5d688bd9979cdd5970bb8367fbf35eed7c9299c5ead1e7e367b95c4e3fc7a12e768687eda2c7faa04837a2f63da4e517e693c7c7e382b5f547becde236fdf836e3363fb1656c4e6173213487ae2ac85a7178efb4deb0b61cbc744741adb25dd7f1095ad323c4bf55bf47682e0d4d5e1e3ed61f61190ae506e5f5e676c6dee287c3b6867ea4efb9b1962d4d1efacae4b30f99bd31a76dfe3226ccfc8db301bbaf2cada5e211d6e49e0727535b5d3d0568aee86aa7bf5765b207979605c5f3a852d4e773c59e5efa428ff7a763f4ccb2b629b34b42274bfa9fa54f94c2b1c4ee956907d6c69c7c372a031bafdc86c5f357dca6fe477719c0ce30c8d949f957e2ff5c86173e672cda3f5110ca984b3fb2f74c766af6d95c344ce69a37a133f3ed85cd43b375a8936b9be3957dfffd73697c298fbd6fb3f1d088ca7ad53eb14d66fa4d4eda95bd1999daaa2f6c141e05c28e186d6d450ecff351811f414c65ac1bfcc5122609d4b98e3fe5818e56c3b632d10cd5fad03579a047baa5c9065126cc6f74f559a0d885f714e508b67c8ce5edccee83e67d3d79d952262303adcec7cdea439fcd473f95c9b20d566cfee79f85725bfe32329d2a7818d655718b7c4951d736d164e3b87da4ba4246b3aff6077e7928d6476ec7e565002f5447395f2dfda73d462f237b59f2f164bde3076bb31e9dd83861feb1dae91d81f57654312c2ed7a4e596b421d7d7773c7afe6a0ddfc5cbd788bfed1013cd8dd3664d5cb5f374789e9ff26d3c3fe4d25e2c3637f728349717e5b1f58df9f730e8ff033b43ff797cb0f365e8b3f9cc7fe6b887e10c97f67517acfa07b50b7d551e4675f6a1b914e51be7cb52583ef3cdd0e437faccda1d573e6faf76fa17b573262f261b73c340c8dc7ace6818fb37dd64ec8c997d62be70e81fb375dd4cffcf7f6e11578b4d02d4b011376cc40d1b71c346dcb011376cc40d1b71c346dcb011376cc437b00d1b71c346dcb011376cc40d1b717d70c346dcb011376cc40d1b71c346dcb011376cc40d1b71c346dcb011c7d8868db861236ed8881b36e2868db861236ed888aff31a36e2868db861236ed8881b36e29bf20d1b71c346dcb011d743356cc40d1b71c346dcb011376cc40d1b71c346dcb011376cc40d1b71c346dcb011376cc40d1b71c346dcb011376cc40d1b7115ba61236ed8886bcb346cc40d1b71c346dcb011376cc40d1b71c346dcb011ff3b7ca1fffd3f000000ffff010000ffff62febe9812bf0400`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec5c4b8fe4a896fe2b575e47261159599555b19b3bad69b546dd1a75f78caee6aa95c2f8d8a60303053832a25af5df47e0f71be76216572c32c370be83791b0e87efaf88f254e8e8fc5794519397f1231105ba9485c40a73546092530eea9e609d5bd00f5445e70829210c2a445232880ed14f8514cafc17367974f648e610fd820b88ce5181298f0ed10f8244e7283a44bf63958169d3cf048ae954f95721cc340f3f6343f2e8fccfe831fae310fd663083e86c540975e057c05af0e81cc52565c9df7efae16f05d585533a443f8affa00cb455b7797acc447488e42583c43efed194d00136cb8788e05ab84cf5b0055697181bd0c8a6ab5685164279860a286c617e00e9de1c9729b5198bef067474880813a5cda0c818b87764021151c8d2002ac0e0041bbc00a3b8589270038a638684345470ccb67057509a0abe009365accb785588b0a4d7d33aa47d5b42b551342ecdd62b3b154d72b09dc4d63929a4fd2f0aa9406b94326ca01f917da303c037465de60537d8363062549b3a026eee49dda511ed03c255d3b85844a8cc4175e1a42f4c34ee0240927c101a0893a78f1f4f5f7a118c516928e962522af5e9f9d845e49724ed850adc03e7f2025da8ada85828cab345018a63ba22d5b3423b160ce6c6d062ee95c08d12f28eaea7c7e3e371063029d75832acf03929ca48b1866014afa510d3ac10c90a80e4402e2bf244c5d98a78d8f273628dd7e4e3be318378c32ad17b6028a5c0d6ca3cec5d53f1a0bb4dc4055b2f53c12eb0d6649c6a036b2fa80028a5d8aca0d46a26748e9f3e7e5a077c58177f3c3dad01cad830580118a65713b0f2951c104cf295e413901ad94f895009a80d1c91e506221309c4e54a4777a88569a086e4d5077e412a38bbcf486921d94cb4c27cae03dbe8d2d0390d7dd743a522f9d80b0cfbeca88b0e151579ee05fa6a3ac7a74168d0c5863d6adc81c6fdc5b0deb465989e54d80070fb78ec8d7e1b42f2426fd121b2cb84186b40fa2b4389a25750e3d826e5e810012722a93e13cd23c29a9ffa619bda87a771cca7e7410ce558ddfb31445ffbc11c6efde09f76f5360acfe56b2870a194e14caf4384341b8837aa6082b0a9d7eb80a1e03a28bd84a21fbc152ec34a09e55e7a93576cabdce6d3fe1466b83afc95921cabe43fb99088892cab6a7f01d02e45d1f5e48742312617e08946b8f82af7ea24778e0b91c47bf5004f56c1db4a34c504f62a15503453e13e3dc133b15789978cedd5519050bd5f4997ccf86a297101b5af816b955dedd4e828a577aa6444b65b853d7abb7a44adb3abbe6b1dfdd5578388a210dc1bcc53ea399a1113e4b2af412a8d3d755469eca921267cf3afc0a8bb27d6607df1cd81519878cf89c87efd4729e3378df09b7ed0c9e52113f6714b6eff40291f58bdda58c71146811b4f587f73bf81170a72cc13064a6f831524c00dc56c171601795282015190ec54e48914949bfd9a5209025aef57d4c65b69bb71892e363109a4b86466fb85409ebc9bb5a9b8ed54157c2d416f772c0dba36e26ce06866ad20d7e75564bb70a29cfa017572a1c21b5a584ba32fb85e9afb814be59be11c2b48bc5ab7d331d650e20bbe738234e5198394d12c5f6f43a9e8151b405209238860bbc0dd5a7673ae9a555592ec53fa5a82babf43a5fabf3f936a6b084c346e0543b7826dbe4a83ba52020babe00d70fb804d65d384f7a9cf7cdf175427ab98659cfddb91b0d1be38fb3793708cf9374c9d09d96e7254a91f54c9adbde02115aac0c68c573d3108c55f900475d1e86b89b9a163a37bac709252f30d65a2d9052c6c07082843538a32513f8dc55a6205e876b3360a747d1a8a934cddf5c56a3f28e0097cbb8a72542170c54ce622a6b82edeab063e590fa59a0b43d37bfb301467602a2da4f015f8c3787fe2de9fd0d905dc50d64e345e20bb4ed5d6a6c64d6da3f1d0b14883333fb014827922eda8f6834e87ef0879fdbc216ed3f2c57995fafa7947c1af9ff794bd8f9e7ef956c04b75e5be43cdeff5d903b2dcf9668128134d6efc75ecff99ceaf0d2617e4fe8f657199a6980994831a0dfd4c30cc339429514a373320a6ca5988abfeb84ce7db6104317709da078330bf7be19252e1fadc6b1b6c674e6d702167d19a6329276fad0e53ebca6dbbc63a6ab6b1ed09a0ed99a49088147243dc75c284a6a937b831eb79a24b4ee62a6f51e18a5939e9290e5d96349913604935caf0cd7e18c75f884c61993f64c29e07ea2af4954de7ef0594b5a808a53dc16d11e64c11db5a7003f21e1d24ad114cbd4b558116ec8a6306ef52d7c0801848f62acf2c44b795ec797281f76a5d31a3c9dcf05dd154424b203b7426b69845a4b5998ceada7eb928114adadef6a6b05c1267e2a12899a1ae532e8172c25644ee0f6bb305915869509b28e5aa790ba609e6dc03661411fcba0533e2027c0d6437541e0570308fac39dccc4bff2c404b6c723b8935cf43c40598867b6e3791e20d14027e9db33b5e182eb514daa0d6fd21d5b089c9cb343d6ea2e63e3833b06fda245ea06e60554bf2e830eb98938987197bdf82df0e66a08c1fb24ca81f72b6a277b80d2d246bffc502abc40f1d7bc1fe147eb8028ca2c4af4e15a8924fa6a545f06443b480b4fbcef1706138762b3f04241793a56a2bcddc471111c1845a874cb25de08c12c13155760d27ed4611f4aa27d7aa70662d3a46b4bdbcc052fbf88cf960101431245e48eb81e683d3261193fc19c3eda4e46a7afa696fe554636346cd6e85462a6b0ca856acafcdfaf6156e06b835566a24e3e9aea5a086e4c058eeaacca89298725ccb42da4db43b34e83f4f9663cb38541dfafa6127fd48026360bf9fb67a8c28464590976c76c1279528c0e450360701afdddabf16ec56687bd87e4d8bc98d915b9a854880a14c2ce3aae106373939fa9ea2da1111531397e402e651a80cbdbda14ce0d2080ed956122e438b207bb6902ed77c25eeb2b1033aedac4ac429cd105182a3eb87a14c53554a0dbc36178d84323d7d40380535aad69e603a782b2199acbbea7811abd1b2b612fcf9869901f506d8e4a00a3c5a805420597b2f4c04572ac7f3a0360a70f286ef3307d2ba8c85a646a04c1818afc2ae98dd31c3ce6dc96ee8414dcd270d26c5da182864ede2d943dc920c69a2f0a8765cb433d14b052e57e2d1f90024b1eb62eef9a172904171e58eb20571ff884880f8822bb75bed0b576fbe486b16f0c14a450b6ae8750b0cd7fac47205d374fd0d58eb69b185417eb5e302c8f631223801c5bdf15241ea057e53d4805feab5357005d1b950afa57373ed73f343d51d4f6db5e2ad2e4febf7e50f463849eca27da75269f27d1a44706e9dbe79b64f2f014d947593167ca722d7fb149ccc79d6bd57cfb3638ff405d172a78684ce86e8afd63b1ef65732420a26b2fb3eadc6b8e6af613df30ad01a677567b74b35025c97fa918a99a8f68bbc264306678dc7de1caeda04d53fcda9fd2a482a919404d41c50b232a31c0992d587a963803590958acc95d0dabb8d5e14ac96b6425c29bccd49db996b14ddd88e6605abefab1076f384b35e3f9c03b9ff367fbd57196060ebf46e61c2005b1121fbd9d56b806946973128c65936e8612bd88c89d83359574ac485906b7086e3f5b2da1025db08c4cb2206e5015490d9ab36f735e86c3b4e61a3fe320594bcb2a608542f4d0572b7b47a8fee04d2284ceb35074a04691fdcdea0598df44c6ea26ff2139df1cced9adc4c7443b5433426ce317d4984ecd682d4e6a1194c3b134f4532be24e9d358c8c15abd9cf33022e6d6eca3c6101beffe65a5d14b80d9c49d00e54f644528eb73b2b198261ccfc6b7bdd61e2e81aaad1f6358d7de3d81dd9ce5939c56b1b676f3da4f6d01511dd4ac0abbec5913811d5d98105172b3a0d5c017c47fbee945c92449773a094ab943c459a18602cb5c28984afb5b44ae710a39e0645a131658727a1bc7bb3ea481940a504c13aaca6945398c51986beb4c312b2d39b533a64b6215c09b146c8b3cf67058d2b6249b00440b094a0bde4cf0b36063972b422d0244b7bc5b16b7efdc40eb52da5b9e282ebb9e388773f5e890ed8cb00e6b3feaebb0b66a128267e119706706eb9f37da2430e7c2e06e01e9a3477151df10f07a4d7da972878692c4ad4c4aff3cd92d2a829b54eb1aed51b7bba5f65a607d995758aa76db1ea875ba5ac89fc5586f7b91a66b008679b3a65b45b82b301e30abc4626fa0d37e65f1ebf5e4afd3adaad6e14a943c5122a6f363a602bb7b3c4c54baaf6d703543ddd26c41ccb93df7bc52735f41757ebd5e208499d9816c87e33b54dc07cd5eac7f8faeddefbe47cf7e40758eeb23f8f76b37ce7aef49a51ed2b64f6644faa5d0fbb46f62dd1778193ad8b0ad223a179ef9942cb8b6dbcf039a226f23b667911eb21aa84ce0c407de0c361fac33977a00496e8fa2d9371f68d7321ee8c1b1f306d6bbfa9d5ee3e8e601b56b314f6863aedf80da9d39eb9b8d3ce1126b6d7225ca2cf750abc7a47705ae7d7987c0bb2698f914b45da82c632f0012b3c6763c0be95f7298474858eba74dfd2d233cabaab39a2c8ad72ad0e0f9e9ad5d9c0c679afa96a837bebe6eba826fdbc5da39d36223f501babeedea07378ba36b8a6ecb90407ac56caf566dc5d360f66a3696aa3d2a70f37e4d7b18eb014e2903db243be0edd18c07bc717af4483a034e134f6c7d8bde07daf005f860a5c259813dc1feedae8d7773f4cf5616d1cb8b8116a220b53e86d51bb6db7700af03bb347ad6b76595ea824495467ba7c217bfd9e2b5d7717d8c22948c7df0172edeb8f569de036f5c9bf7e84021cdae97b8eda2dd2dee516afda86b2579c91ea97543a08fd7533fe68e0bf678b516aeda1fcdfe206c2d091f9a584414e902a93bdece8df3ffb03fa8775edd76601cd3415063de0fc754575dab8bb1a653926683b8bb01cc2651cd506e23498e498e3fd7537e172daea07006481922ae03892cfb413bf9590744468785480ba3ab95431b9509ac483e8c692844c6517a180737098a16c047098a11ceb2880d63bad9a08d64f8db5d410637d98f2d46d5ccc13466cd364ee8c603b68db2de06b585ad8b138c0dc24ad86a5240841ad4f238fd7a4618d765338ab11105257312d298212712b851930b71999365b36965c419d1e744b54fda4cbcc9e7e2a554226dcf3226627d9f4dad5ea022467979eb03aca5545131881ade97ece25b97de7e94bd8c39aedc7a7f30089beafa602faeca91753d8751aa75666b3b6d3fbe660e6ba36caad516a78bea0ebeae4f7dc1c82c5c155a54be4754202aeabd0aadbca0ab64ad4da649213a44756bd58d637f50c5e7553f9a46dadc1b6a9fabeb6e4545dd637f9073ff96d80d6817f1b5140612e7875d7bfa71f7316f0e1b7a8fee5f339606917521dab85ee62771086b42e9aca439129997b47722e6c53abdd6320e8636f9b6abf7668d6065a5b23dd84d374237172644753ddae5576854568750b53bb6fd6967c7e810d563db3dd5734f3509d91e67b0ede8f500e89e10c9442fd44c964db873cb6d62dc80eb859b5ad78c12777453cfc9ddf0e8ae28d703c1fe74334dddbda343646ba477bae68206c7ce2da68be83e6783707544675155f9ea9385ee0995263d7d1a86ed55bd92d3afee524e3520ec43e52e70059e0885068716f5f95bf5497b3afaa1a460f7d387e3c70db44bda7aa1f9e21a8ea41570db0f1b463a1fec467e6d674db84609d79d8bc412707cd8b889934adcee1bc0ee987109559f36ce89ed9157453d36275d38f85a84f6cfbf1641a363b04d5c7d1af606f862b93a7f076d5a36514b065445b5fca155d4cfeea29de553dd419afa33a6bca1349da561fd51fc2c92513472ae377688fc28fea75efc9ca3d3e3e939fafefdfb21b253921ffbebd9126b324a2cd899b6cf7f4509186c6f579cff8a7845e95a630e91a6df203a3f1fbf7c3a44d6e1363a3f9d9e5f9e3f3f9f3ebeb898573b2545e7e8e9f8747a389e1e8e2fbf1f3f9f9f4ee7e797ffb59f32fd9ad84256e5b56b03cb3e0bd7e8fce9e3f1e9f910fdc445743e9d4ecfa74f2f87e81746f9253a7f70750bd1f9f4e9f3970f87e8bf69129d8f87e8c7faf71fafaf122747f7fc6b62533b1ea2df7a59fd3bbbf473fe77c787139d3f1fa27f33b4b065ff0d48743ebd7c79fa707cfaf2e5cb21fa45db982fcf2f5f9e9ebf3c7ffc7e887e1e403f9d8ec7e3cbcbf1a5811ebf1fa27fdf48edf4e1cbc74f9f9f3e7f3f44ff787d2d79a92189ceff3c1e8e87e31faee52cb16820e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f20642de40c81b087903216f20e40d84bc81903710f206425e3f42de7a76b2d9b864fba85b51cdbcfbbd620a6b0a26b1026eba143b05f73a6fd65f44b45e67feb580ff5fdadfcf2dedefd3bf10edef8797e7e763a0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687f03ed6fa0fd0db4bf81f637d0fe06dadf40fb1b687fff75687fff8fbd376b5615591a86ffcb77db11efcba06bb5978ea81b8a5691a1ee04dc2a827a5a94e1d77f918c05140e7baddde779e3e9387162f792a22a2b2be72c32f31441e5c7ec7e54f15b8e977fcbfefe5bf6f7dfb2bfff96fdfdb7ecefffb8b2bf4979dedf58faf7ff9ae7b30f79ffcbfff10ea7fff3b4187073785e1a98fd60f81e971707eef01fef5405feb971af4fcb02f78ab2c06c5e1698e739a6f35659e01ccc96c2c03cc7504b03f30cc3e4c57cd9cfdeb79606e6ba9fdd3fbbbd5f280dfc7fededc53d47f50ac1254114cf9b2580cb42bf6571df94799272bec599548bfb92b57cd3d135ce4b6be9968cf9bf8c5729fc5470efff2746e7fdf0d0ffcf6c3cbf9bfc32b6a7f3bd351c7068d8614547dafd10f68c3d1dc4f2e1cf3be654c6f07a777b8a18cb9b04a287eee6eacf4bfa3eba5b5337da68f6d91ef62369340e456516484e7fa70a7bb736ffc0d69767939fc74fdf9dda177bba74ad43b05b695d47dc9d773f0e0369a321d73c21170f07ace9c1f3015aa98b1abc2eb399aa8714ce1e6373f358e448387a0753e81d448edd6fb4ce5d19bba3e5baab2c57c71fb3e1f92e9f92b19fe28a3d9853d5950f52208dec03f60cf8ed627acb0be6fefc98c5062729034f72aebea80f58eb34b862e5ec20cf60244f0ae588f12dc1bd6de2b383955d8c95bd93fce6a947f9808e462cb168b48639ef9637b9616efd31e3f01171eb108d604ed73585f06ec3fb1e7624a5cfc3fb989fbb58eb7ccce259c7502c06293076e91a5a00bf05467ceca2f8ea8b1aea1ac9b8316f28f3839ccc699f37fad2950fc8cbf673b2bc49573e489c115b2ce216c56fa2b6dc5bdef563164b8cc48d1929797f106d34766f9d8e1fb3b81f206eeee164ffc835b8de0d0b93689be040e291621f015e8b571d8353632b3e3b92b3dfa378c1c2ef1badeb99f09b62ef256fd681df6c6e12999e7bc3f53d0b801f35aae1ed9ad0cee8ec6067efc91ade676323c0b9142f62435904f09b719abb86e60726e7deec21dbb1e233edf7d854ce2c8a71b085bd6af3086b93bf2da177314f0bdfd4161f28363ad67407383a589ebadff083fb46eb32a2169e64e7d8954e830e6d3d73740e25a71f64ef7a2607efe0bda9b9d7ed8a8d0dad1b8b3a722dcff5e403bbb7a6832b5eb1f156470cd698ebc20b2fa6e72343434afa1b2b24730ce70ad6ec3b5e0dd4d56a765d7bbd83c92fefe2703ec35ac8617d7931b8f14ef15416af66d7853ebf185a709d1dbb775bf873b7e626372b9a5dade45f36c6fafc820fc161a10f025358ef165cef6af2b3dd5263ef1bef78a8ae3798985aef3f9bd5ec3a73918bb99e3b9ba8f3d904754d6d7e3787b3629dfa3c8023ecf500379ea1b117737af44dad77337914181a726517090afc1db12bacd9ac294c2271ccbaa617dec5317b37bd896346e1c8e4bab1c9857b2b0a0e8ae01eb03edfdb827b370f036472ddfb0cf6c2f558cb734156c858b70ea200eff7624b98385867fe238ece1f868619accfaeb27339600ddf2d4ffd34bc5e47d4d1659bd0e3e560f2f323d6673efcfe53db33a6167ed6f6f051c1a3e35f375ad715b5bd9bd169283a63568e581e6bf3bf37fac2b705b5630fd978a35f5c519b1c3727358671d2a8ef56c74d0251db5f30b74f697ec83a2617de2de70232f966f183bde5a9377b0abc09671ebaa2308937dad215057cc11cda5bd3e5c5e43a57ecb9275b9fc3ef20e75ceb109eb13681bd5d37821d99bc1a8842221bafa637e1c429720d1edeed7e62c18d2d21dc6f950bc85067c3b307935b76b71cbbb73cfb623ae740e6f01df8057b137fa385ddff15f49cedd9e2dc0fac9c43cb53fd1c0f36a7c2b931b2b308a4118d26d69f46ca1f20bf43c95947b2e23358df33a23681f3bec95ee8e2d3e2d3f026ce864bcee8860b1a4864a5f753f519439b837e72b723e6202ae383ae5c3c9343ac155f6e26bf38cb1ebe1bbc1a8b3abe589c7b3085f565cbf5585b80f3587f48c3c9c5d40777ebb4dc9ba7656f339dd7f9c08173c289cc9bdfb723ff8c35f7b4992e3ea4917fb73d35c21ee8ac0bb7d1d1c5f042f7a7b60b45611789c22e16851d230a3b5614765c4133079631f93ebcef1bfafcb4d13ac0638c2df47cf9d06101973ff5c1a7a94d22ccb937515fdead11d059f13ec83cc6e4980f499fbba6ceee0d6f1e69dc726f0b63dfe4e71778cfd002df165cc614545b142607e33437f3bfb7fcd2dd4e173e1654c7e43319e25c521b2391bdeb4f51b75dc3f32ff690cdce66093a2f3274e08dce87ad758f589f27e73b139677b05ff0c87f67ac63ebf3d8e4671f1b41bd5a239f9439293e9cfe33387c8bf32fd690bd98def5c3f47a37acf8af8dd3d93d169611d651bc6dc3ade2a7ba4b47cc560b5dd9ebde2d8fdd9b0dbabe5c4c6f0ffa8cf9a92dafa2d0bb8a53f5fad2993b93086b6ccfe4829dc90557f8d7d682abada57fc3bfb61696f4a0cd01d7775339073fb525b36df09d154853f688a7edf445d0d2156b98919d41cff0c2bbc1f9a1adf518ac17ef7e48c37e301ba5301a87106ce7bbe9d9b92ee0737e30bcde150b6af45347bea185d76d554674a4a1dab378f5204e116b4dfdc0e4d125a3bb086b21d020874b7c5fb6defa03c56b57e6127af43742bebffe27f09d2db80ed0b8a18517cc753e0c6f12630dc6a6eb6cb9024fbee8cc5880dfe2d560cb27fce48b8e9af0ce96437753e845a4dd9be9a923d610d8aacc46ebdde0ec4d6fdd22939a7a49f6f6897ca8cebfe60c47a2cf7f526f803f537013da84f5ece1dedc4ce72e76994bfeb7c1857b8b47f60fddcfd72e684fa6f3f5cde4baee4f6d0c74cffc98f867cb533d7d75ccff764c61125b2c7369c0ecb9b78da0461b7df100276acfe07a47bc0aff36bcc5d59ace5d718af6a63e68d80b6b0627768aa8ce5dccab57439f5d154dbd99c29f57b07f0c0dddc531ba9b27587f128bc3b9b4d1e66ebede2cb185fe04bb6c64f26ab4d1d46836eeba7634bb56eca92aed85c9d94fe7eeebfcc87c9a3c762d0f5f4ddefa30749531e3ab6fea924ffcee5bfcb26b0aeb0f8b4391c98557c39b443f357cc1bc1ab5f1d046634f5b98e304ba7d722d751e7bc1c2f28a95520ee6f367f22ab63dbb02d746708f58506ff2a3f97416f4e415eb847c05fd2b4c028b5b7f94ff0de73809129e3cf981a12d8f1bad7bcaf4cf0dc56a82174b981c31d06854ca4ad1b132b952d07ae15f6574b33705f7643a9754de94f6199cd111837d95e834b6670bfbfa3b0dface799ed00dbea12f9dcd900d0cd089a765cf142607ac85a40d773153dde6a7b2ee4a93a93704efebecc1d6019e2ed80311f0a5a8cff7587059f0a57e6a7336912d4e666ff0feddd697894e909d49b01d0e8e801bac2d3e4d5e65129e56ceac35dded125a8afa20af0f1bce05bf28b351c096b1fce43cf879cf38a9a0036fa2e0deaca9ca88422fb0737b758a5c53501d7b1826ba0ef66c90f436f2ab3e9742e0b0c21be4ef35395bf0feb201cb96eb71589f7ba6d0e3e5536e5ba9e5b81cbe69a68bf565043e1ff67a91a964f248f58167dcbf725cbaeca7c1ab91c5f522f994fa99589ff72c4ebd620d315bdebe5bdec2b7f9f9de1a817d37073dd3d3f865d712d6c5dafa2a34eda91b608505ddeeda13e69a8f01bd606a2ea3afc2e27c091a023fd435f4f9def4902b7b195c27369f2f7fc74e65f1ee03fc8384b7b4c5459cf8cc561fb87fe973d7e2544657721b7479b1b8c9c114d4e38f12b6d8d6e6fe465fd2e712b2b51dca1c7a6937a5befad2b568303a67f0313f0afad2dc9be9a95113cef387a8b1f7ed814d6939025baa0b7e5213d6b6390b785be622e409e8ef92a74abb0af4f646eb06b6beccd7753682ea6cb8f97d3b61aeb56791a12d2f26cb3ef45b657e00361ef0d5873465495e216df7bdad2fef320ff8005be502b4cd6c34b437a7acb7d1d42b9e22d79efa3ee8099b738fb6b0031eabec299725e4fec0ae3205f584b5ee71cb2d2f163fb81afaf123973fc55c4a3f982bc0bfbb72fef437ea1a0d7955b39ba4556ee7b5f1f4a2f0c1365af70c7ea33df2f98de032e0b726b102a5e4316b3abf431ccee2d153db2be735f3a4fa86a746097d953c96d116ac91c8dd946e4a5abbd91a0b718d84d64a19093e5b422f019c41ceeb5baeb0bf9333049f30a56d047cec6c266c297f57ec1d7b38c6fae24354e649ccab9c7f125882ba071bcee00a7d9bc314636d1219dcbe0a53be46cd26da12710c431f04c9bb5ad7c1b9dcabe2c3db68a12bea6a600abd3d1e16fc9bda4e23423eb7cf9bbfd383bd82ae20696da375335f657e3539f4f74f1db16612d37449dd78dde888113517e26f54396c0baa0fb1105b50c9f772597ede68cb23d6ba36b97682c755d5c716bd5d280ebb67e910dc3623e92a7a3b06fe462bf8dbb86e46e3eb6634bbc2b3cd6871dd8cd6d7cd08bf40fb76460b67561af53fb3332ffdf55527fab9ea9ea5557896f41abfe47e373c3f8467e9547b7e029b8049e0dd8c506ed726712cd91967b611db4bf622ec986dcdfe149de239c402d8e6f359b13e3a8467545f5f4fd73705758fa7b567ab4ed48287581a0d7aa2579c875fdf373ab5bdd78abfe3464781294cbaa232afe161dd761e2fce57c7ebe28bf32d6bf349efcfa7eda386cf90ced1ea3794b29fd473890d1d82fd6771bdeb465bec2ccf3e18ab5d208efa576918dca495db134fa9ef2c4edb7ce875c3ef46a37ef073d50578fe8638a6e5f9a58d1e9f6f526297cff706e79f2cafc79ade22b33167907f815c822bf1cccd14dcd81eb2ac794a75329e96ba47d492781ce8bc544ed4f4b6e885178b5ff8b9acd9967ad73705d5b393f8553aa6ead314eb16b639e46b4cdd2fce1174567636590cb6e59dc2de02ff18f6b8bf98c2e2429c5f949d7766e333b78dd6851804f819b159e30d59e9bbb9bf017934f3843cb039e5931a58821a19dc2406f85be2260dbaa8d2e2f82615322195cb96aede6d61ed63c83d0ae08337ed85ea1c3bb00fb218409983fab9ea66789ab010ef06fb0ffeb59cf387e1a9b00737f1ef4f4bd73c2d3e666e1049a331a38ffac142e91f7eaeba1753b37cec85ec465b82dced88ba7dc1d3e55976c601d060694f4c585be8f544cff571c4329b29e8fcee7ea385551a4cf6ca3c8ce7cadcfc6e41fcd1a9f2c98c5bba5899bbc821fc626f72dce86a2c1f0ad947b39d0a5e4e7c2e9eb9e5b98087fa71d5f5369acd5a408be0ab97f443f8bbe97e1a32432fd710b5dcc7edd6ceed483f370dddb1a0d6c6ee78d159bba55f8d5c79845c59903e6dae17e121cb5bde2490bd347727eae0232d7b86d2754c6ee936e338cbde869b1f09fefc101d7897d989ce9242a78df5933c6adb394ace3842caac5b9ce521b5d36d5dcad66193f567827f20e50bc8caeaba299d35645ddc0f7eea830857e4437a6e55bff6dc41518725f19cc396bcbf1a64f869f385eb785be4f84ef3a071691b8b27988f8d0d7e7e3785700f39009039db214117f122c98592b1a3ad72c9dfe925b9fbd3720f74681d821be4966d6102f674246af86a6bee511cc29976194383f8e0cc3705eb5a7d8ff5f1b0f6ae8efcfa7b5b21acfb1da59cd7e7598c75cc02bc050f47799c38fdbdb0c5f2f1891cf893b0d1527d91f21ddb3735f5b8d1c6bb1f020b7cc46fb425b31965b9e7a1fbf970ff3af2337c96ef8d06c176caf61602eb62c1ddcf86fb8a6f96e5ad2fdba8b2f797f0555b8b979cc76bc9ca317a61ad2b8e6a6b696ce34c2d8eadc9db23274d079d9f7a2b0ef33cfb37adcfd4dfeb98d3ba5f3a665298989be5f57852968a1a1b03bd627d7f30f91de92778a6a076e488f5ad884dfe5bd4edc8d0fb1f693cc7a7fd56e852cb4bf948546af497d925e9effb9ee875efa266834fe95a27d4a4d38a0f9dd93453e6667a7ffa98079f528dacb80378ba8ac32ee4e34fe05b617d1effe56671f2e80cf1eeaba8b2247fe4b64520536c9f9f2ba08d20c5ed9001ff07fef625a55ffc26ad12fab88aca382a9e1ff2dfa47c1c933f43c578237fc6e7cfe461feec983d6313ff387f5ec88e217bc551098f2958044ce9b3022e2d3c91b0256373f89267398cc97b059c30ae80351997c39b8c2b60867105dcc9b802f62b8e6af06b8bfc998f097c9ada8284dfc7245e81de49f8b505013f7b24e0f731816798b3849f3d12f0fb98c0398c2be1678f04fc3e1e56e12ff80ceee0ac4af82d8e21e14f9e15f0832c27e04fc6e6f027cf0af8e1bd027e1857c09f8c2be0877105fc30ae803f1957c01f9bab2afc5b21a765b643d2f35620693a7d56c2bfafd07632b6807f4fd078f25e013f8c2be1df13f49e8c2be0877125fc7b92f63b39fd6ff9c1dde2dc2cdf36774d61c26c74ecca7cd5bea5f07588f44187c2dbc9ef297f879fa5fcdbf99007c39e1a599e7acc7d0a299773da7c6f716e0c764fe51da1176ff4e5c5069f5fc9c7829d657d48341b3793c1a286eee654f225a5f39f2d216365a7bf9386fdf47e0d97ac91e5a2d27d366d7bb5279ee6773b4a6cba5854667ffc38a5792f0c76cde81ccc46fd1d52eaf3ad1bf14b18f373d505d8137fd38a98f8afc385dc6ff6ae148b8e1427ff8bd69fe43a522cddb227717dfecab31a1ed0888a8708ad6af327b67545df476855c7c19a8a03c9a9e360d68ce13a0d1c70741c8c39d1313804ffafe340316ec9ef8ec137e6279f812f0abca4812dd66bae21c0ef7f56e64eecb3d8cff69ec5c1abf64f423b99fef225bd890779d8c94e41aae163d77a76e43bc5dc341c57f75ea16974e8c0ef1c72acfa19569e15f3f394f99d750de619dbc0b1b32ee64053ca1c551a25618ca588c44d05c6cab3627e0a7ed168dc8603465ab5e2a0f2ac98ff6bbc9ce2808247a4ecda70c0cac3561c549ee5f3cb141c23a7950eb80774507956cc4fc3715ca703ab4107282ee9405206141edeb5ca317954a191cabc9567390f2be39022bfbad5f326d73e86a4fca8f910c433b597d98a219ddffa410dbf99eea0ca84dcc7f736fa3cb6c9731bb2792cc79714bffc6f886167311cb0a55bde09a5b8782794f8f21d893e3e90b932df26e6315765429e919fcdf141c013967b602212dfa296cf37fb2cde3b1170f05438e2120e44c0b1a4c28146051c0c01074b87635dc081081ca213150eae84031370d874384a5cf3041c1d3a1cbb020e795ac221eb5438ba251c2e01c738a0cf3d26ce711c10b084127dfe903c4b92b624654eddaba410e7a9cc01260f9f906b0f9970dbb81347c834989bc31ee4cb21efd1f0216b7ab81c8b9a63537d92e7227d44dcd3cb6ccaba6e28c7ea8db175395f8e3d35c6b2897d57ee996fee79dd296558656ca739d6206c96cad86e73acc556f1320e9a637671cbdaa1d4dc774516fe5c0f7cac2d2fc6aa7f3635f756cabe358f4e83ce0ffd9af905c8afd01ec40235b83b357771117b845864426707434717b8ef538d5b643e04f80dce381f0bb9155e76c6cf6deac4b71ad36dcaf7e42f6157267e6444b72dd7f539d9a6edbf6ef812e00f4a31d58eaed0a7ccb5dbf2d535c867349c2c5aecec976db43a3e583a3e66757c444d7ccca8f8400e151f157b53e6daedfaea1a63aea9974b5fbbb9ce20b3cf9b367d8e87cc9ea7fa89a55f3fa1e0a4d57e23e4411dfeaa7c2ce79f53e6afe2a14af315dbae067b55ae966b20ca1a33b6067be2ef55619eb1d579969479aa34fcc0d6afc24a3eabaca152d658846df840a3712b3e2acf2a6be02ff17d398f4d9ba7d3868f9add5f85957c5659634f59c3e05af1e118edf8209f55d670296b5875fae09bf461d5e8a36aab64ef75dae55fd507a8ce5dd76f39bfd7ec9b6cdfb252a183077e40453754f562e6af24b29cce97599eada183a83224b39d1259883d37b278ba6d2b2ae3c2ce12954969fbc1d968e1beed3d499108fb0c55df5306f4774a3b9ab01953bcb6d899e51ab53d4927aa9d199576e9bc0613a2c354dad4710d26860ed3a28009117635e019d16d5fb68449adc184a93021a78089abc1c4d361b24a98081f0c6092a754983a254cfb1a4c2e1526b9b4dfbb3598c2165f2f24ce3b946ab8925a7dbe921ea53a3d2ae316df6f4c9cfb38226d6bc081d4b4db83c698a6dddaa1dbb8c99ea3a64d5cb7112ae3e3e6f859d5cece745cc307a9e9aaea78b5e1b3d4f54e753c6e8e4fe345155c20be818b7afca7325e6ee2b71ecfa98e6fe29a47ab06ce42cab955e4797d5ea0a9fafe2ab2597f350f0d79abe57f3d470079aa6fcf1324b9b5efcc15c05d867f2e5f0038f9d69c41828fefcc1b24f8f8077207ecf177e60f00cf545b87900355dcb4db97b4d871323f0de7553c3cf0ddaab0d7e44db906ff253fb598e777e713001f486fae5191e7d5352af1a4da1af55853b9c6d7f8be9c8782d79a8f41c25ad11f3558ebbaa558e377e71700e7b24e59e3cd1c03ccf3dbf30c899cfaddb986ec1ec6d7e35db97d08f7297e2de7a02d7e29ef909cc53bb98704af6db668b946754fefe5201a72e8591e2281a96a7f3cce452430bd958f68c88267398904a677f212094c6fe5261afcf82c3f91c0f46e8e22a3f137f31400dbfbb98a642d4afc7c501ff37a4e23d9f31b798d64fcf3dc86a9bd97df48c637edf8badea98e7f9ae74870f146ae23a3b1c678a3cd17f3f12b398f9cae1ae376f103389ee73edaef867665655edeaf85bb7e557911401d00acc3fdefe2de7490c97a1fea296c74e9431a55dfc9ea3670f0dd523e16f373a8db15b6c86dd28e853b7f618b1dfb562caa62bfc35d447a6c9eadcf8994867e619bfe0cdc3f94e8f6fbf7d867759c30749cbc1ed3aee303d17dbca88163a7818f888e0fe38f1fbfdbb6162c3ff129f8e63a99afd1cc87e477f7ca3be84d5fb5b847ca84149c1072a08a9b07f6654d7614f34794f96b7868f7dd6ab0d7e44db146fc253fb5c4054399a746c3ed7e771556f259650d96b246459ecb5c7b5ea4ba06f9acb206f725be2fe7e169f3c8c3367cac3bedf8a8eb96628d0e658d9a8f41ae6170edf8a8eba3628d2e650dbe4e1f286ed047162f2be6a9c660e93ec683bc4865ee7a8cade0f75acc96ea633cc88b547d1c654c896984a77a8cb7ea6bbc18ef2aecc3f0246af862c17792341bf1c084858d7860481b1feeb15f0dbde53d674cd8ed63326f00b4d9628ff64b1b91b47901af2db668b90611074feedbb7d8a165de2022f2060d3954c244e470481f0abe03985261624a98963598542a4ca8b4a7d91a4c1c1d26a38009913e14c0c45361e24b98ec1a4c7b2a4c72e943756a3075e9301d0b9864bd0a53cdef2fd69014e2bc15d2df0c4f753fbe5c675cd2a333aed163cd2f2ff71f12e71e4a27d28e85b5064ddb7f5a1f43899b0f5bec7ed873d38eafdb08d5f1cd7c42a6df0afb19f6c734ededbaaeaa8c679be3eb7aa7329e6b8e4fefd2547161377051cf8f57c7ef9be36bb9eeea78b7393eaef962195dd5c7d5e342d579c7141fb21ae369bf7b358ea4d180f43f3ae6f4bfee7fc03723dfee7fc0b727dfea7fe8fbeb3fe87f004ebed5ff007c7cabff91e0e3f7fb1f00f76ff43f00cfbfd3ff80f97fb7ff016b7c87ff01f33094796a344cc24acaed3aac75995eacf1bbfd0fd8c777f81f30cfeff63f3a662d76fa1bfc0f58e33bfc0f98e7b7fb1fc0efbfddffc8be7bfc36ff03e6fb35ff03be83fc15ff03cee22dff23c1eb3bfe07ece93dffa321879ef91f00d35bfe4702d35bfe4743163cf33f1298def13f1298def23f1afcf8ccff0098def63f12b8def63f3a8def905ef13f92b59ef81fc998d7fd8f64cf6ff81fc9f8e7fe07ecef1dff03c6bfe37fc0f8e7fe47828b37fc8f8cc65ef63f92f1cffd8f9cae5ef63f729a7ae67facba797ddb5cd66772b559b727abe9ddac0993cfb11a305043c516a05ee77a670bfbf25bf0b8ac814bab81680bbd80a87b93d50a1b746653a825e31e674374c4da3ac20aee89a765522766365d26bd08e05f7a4de0bcd61d51bbb7a82b05b99d01f483b8e061dfc182ea4ade9a23f76278bde376e5a7ff12f57f13589fbe0ff7e52af8f02d8e69e044e2d3da74f998bce619d696441dccc9b3b532d8e03b9cea9ae57c8b64ed5f9d7303b5f31ecfed1ab5358a5a83abc28e88923a23d9f9c1f3e4bcbdd0b57869670bf33b9e1effcefebdb68f5b3c78b67bf0ac0923d4182dc6ebd0b3c80d525c2530ecece9ecc33c2dcfa2d6f3443da9cd7936b925d4913d181afa3bc9dd69dd63923b825a51a7f9c9f0c667691824b5e944a7cffe20ce772340eddf5965fef4b739adafc3ab6bf068e512fb28faee94b8287ffba382bfcaeffbd61a6386b33f1a1af608fa082ccf0519de9d4d97495f8f1f7cfaaf38a48e39b6fc7e6efbbde0ed522e14f0206e72a8c1734eea431dfa05aeade9fc02f272ab5cd267a7167c1ea42ef6d0117ba56c11b5b496fb8c025b5ee7fdaf53654fcfd777bacc66fa060c649fa455c97716a742dda123e0a906430c35f74d6ff9f43cf1081f656179f8b9a2bedbce8b1598aaf28084ab76aeb5b969b222c7033a48dc8c37bcf9a3b320e72bf9a78d76e3bd6378e831ad10b0bf7cbef477cedbd67a9152c7e0e60e3e95f2a0e8771505cddfe26e15bfc4efed74841c895b730647e04f47acc1a1c732b63266d1f2fbaee5f747e72975b0b708654d7d789eb6861ce82592e3b11d87b3100bf8609c9a3295025b461bb3aacc7b61fdc7e7488101fa93116798f62bab9e5ffedb83b33b606dcd628da47df786bd3fdbcfad78bea0fcb6a3fcf6f8ac90873c2c4c1e9dd5c5f4f0fdf9394d0e5858ba88a2fb6a30bd7a4695751f9f4f73eda2575c14347fab9d13f9fb83b3f2b0a7ba8647e04ab7f796d785baad0ff88c1cb3b8d2dfddb5fcfee8eca047dfd243dc433ee30d7d0e755b4fcfcf0f7b58d9efa547e757c2f6ea1936d67f7c8e4d18f2de7e845c3e1a5af7d45c7bb9373dfb89ce451ed2a4aaacccdf3bd4ecff55d080a166d3e470d4f8ae9c2fb7a137d3d29728f7aa1e65c5e8e247f82ef6f4cc9e419ea4cd0203621fed6791c3fbead951c63fb02f9cc94112663c615f64bde84a3c424f93cd74515b17fcc13df3842e8e40eb24aeb2b91ec8c974deda9965300d98cd30a0ccf598df0c0f3bd8438f709cf59c7c7a5eaec1a90e76f0eb733935ddd2a09530ab2dfa0a9fa90ed69087087d96f4c5246465f2774d4ee6bf3de02fa8cfdb3138529f247d421f9c53fe7c41f9adca57e96f8fce48ea22e5d895b5876704f6dbdf399eda7134eb1adefcf08c3fa1076ac91f8bebabeb3e3e9fe6da868692da7b042defa1efa2edad6beb4e9cf4bee983737ae64f3661cfd77a627b5f22cccdf73fd21aed8138ec04285e5f1ed9e1f9be08bc3cb4c129e35bf1d8a8193fb248df1c7827892711b455fae41cf6b0d346e7528c46688f4644cdfaf2198394e51129a55e061a487541c6d3a94e2864271a1d5969b4e6dbebceafbb58432e266a7a97cfb27adf755ee6fd7abd70fadc79ffde69edfda8eaebe5bf6d68bf3dd273f18245c282256db3f4bde28cb377e62e2edfff90a6ade3a1a7dec512d4631e87984d9791ec7599cd90bde3039bf415fa21f48e78e83ea1d3198b56418494f55572d66c42af153e48e8a2c1ff0f63398fe826e73b588b583be73ff2bb58324f86148b159d63fe6d6c8e07e8d714408f5be007f8b69856033f9f23bb9b51f6f189d84e9e8327faab90cff37adb89bd670b3dd6e088581ef46de207d07b0ee402c43aff68c6e1d46311cb817a03d5bbf7d55ca663dd64659fde7f695903be07266a24a775fbe37a1cbbecb525ea798d17b2770df91c399607fddcc0f6bf3026af3a1b82ee2c4f8d2da177b33897c32bd6c7a34ef18c1eb71dd4eef79079c9237c87d095a7697da59635ae98a7d46aafd55badd445cfbf2325fadd90cff35e7b890f705ade6d0d9d7fae5a68480b4f7f1d9ef125db4aa79232eecacff70835b2ff7b7bd4f7d757f648a741f5bf477fca73da133de84dcd80ecbd257d475fc073d99b02ed4d413a432f2ce86ff023eae7f99bdd8fa89fd46c27eaf97e888ec427bd2456c5b85a2f844a1f8f4aff95469f2de809a4bca30fa932f6bad1fb1f45ae25e9b7b86bcd7f189c7a44c2724fefbd90f61792f9b40fdf26ebcb9bcf95cbf3d9b013225dda616d1227f18a037bb7f5c535ebb17636b4e5dd8efa374951e319f4cbe22026d4f1a1ef1429278aba06111b616dc9026cd017aeecf70abfad41f6433f12e8dd0f35e781e63fb35e2b796fd8b4bf54d257a317992bf0cfba0cd018f4e24a72f9fa3e3092fe6a74dc66f3657d21178fe96b54ed6f67416c85f7e93d5ef35ec7395ee2ecded3b05f9cf7cf557d7fc107f67a8eada352bfc68b2e52fa2c8a5b746cd173a361977524617e847e1c448fc92407b9059a1526c7a426447257a8bfcbfb7c9436e43892a25c5fcfd81f2d382ef93ceb43a90faed0637523a4fd7eb7949e9f263fd81b9ccb6c957348c3817c607d933f27fdc40c4e3d9b5c78ace68fb083e2358fe14eb5307136fab29bde57a79c972e41dfa1c0d617df315fd6c3e01bf77afafa1c446fc86b03e6890fbd5f8e26d7bd6e55b6c53e3c3b5871c147f764ae17181abad8d3e387a4b317f33460edbc27a4063dc455bbb1c630a48d63361a0b7efa1d72d67a13ee7acf461f78773369d259de5734ef1d680bae8fd5e6b867bdf5a04e23d015f51947edf1077d0b5d835f34d6ca7bc7e772c6f626575b53dfe45bdca4b1bce7dfb0812f1f60c3dc739d9cdf5fcb60be1adadc35c97e5d49cfde8b6368a1f363d88909bd17d6f45e799f20d397841efc7b36821cfb91b8b391df473807d57e3b64ef1c8a2e2cbec5497191f6e6851e12767997204afbab012d19d1ee5687a1aa07d3fb1c6d63e9bdb628fd9a561d968a4be8bda0b7e2337a0736c9a9e1bedaa7e8268ff2dec974bcd5e9329549ee2de991fc823d54c0067735e274add9a8ffc2999ec356b8287768e477f5ef29bb17334d6d124b00fbaacb10f64b41f3d00f4c9a3ec105859fd23e270dfe037c257d73b7d326df277e27c11f79ef19b2df6b7e77890e13f289bea4290f8ec637a4a9fe6c78dd89ce231b34b5d366c3bc7feb1bb4a2d0fba3657d2529b8b11fd9cac9d903bcd269d0f941f6a1cd7a65ce46157bf8255ea3dba628e90b96f7796e9cd7c4f76d2d6436fae08a55e6fa605c0283aed7fbbe117d7f1b780829bdecd279133b4c1fd0f824ace054073aa2faf1c5fdc0e4be55deabaee4e9c8e46de2bdcc9e857b90c2fcbe1d91bc9ddcc3acdcc9ccfa60a7fddf38da6fc5fdb7bc87890fbd4a88dfd3fb740726eb9d94ee1bbe23ffeb90f72b227e7badff514bdf3035d812bc92f475e6fdfc5b9ecc2780deb2e017d2645ac98ff9ddcc9c5708da3e630dfd9ddb6e955e2fdee4007d376d7d49de0b4cf44d4a7f0cada75446cb6c811b83dbbb0677cded4dc22e5bfe511f93e9b82bfddda549bc6bff3cd4f7007de4d46bd3565c3660c9fbdc53e834a0e126d18164ffbc437267b7e8bf49a7bfa20767b38f5771571141df7dd772fc3de4b2cc037b497a06c71707e43ad66714bae8377b741d3a7c8d4f527a2961dcb5e0f433af8d67729d0f8b075bd675eae72dd1fa8a395258c8ad028fa58c07db6fa32d7cc8976321d17324bfa7f6654def96fb02dba1ec81f9a887a7e4ecf7285e804dc225f74a872c6302dd0a6ed04acf7c327f16abc9601ef91fc00718ceca593761ad7e1b91befb500fd5798622fb0f9d0e8a1fc9fe577a48e671ec45208ec61c8a024e5e053779752cfd5f7dbec782cb5a455f51e04f3716750c7477fc792870e7614d3d26eb44c16d93d9f01b6df987784ac7c2d9981ce896fe553cd97b2b62d3778509037728e5d3e3396d6e52ac6be84b170ffb1fed6b0cc873287ad936ec07622fe2b0cb1b25dce0731d674d3b275f931623886461cca311ada715cd3649ed8b1f8d7b9bae6f68b62b9fd4c81cee0e589830867281bb8127bceac5b667fdbd15d8ab385cc7068f381c0d3aa616deac98396da64bc61a9def226ff376d4e5a508eeff5b77e85b280d93774fb369ca3b7fadacce3c1ae474d39bc7bbd36c8a2f58b78726bfebcde3f43b9fd968779a2bc89d2bc8b1a087d770003ed5f5af95e54a4eb1e7d34c58f4e60c13898eb443870e2f31c10d1dfa8ce8ac6323626e68c884a2037f2f38116a070cfba1e88c7dd1d95fa555279c81dd36ecc7a2b3f0a5a8d331a20e27ae3a9c14c138c3179d190f63a4438787bbfeb3d1e2863cc9179d452c3a6b5f7476d91ae39ba49f4f62b48ef10ad64ef6ed4adcfad0c035e40804756f8f2ed07f30c2fac035f4459d5f2aba33cbc191fe5eea9f0c3b616e33fe580d0a395c9b2bb19b4affcf2ae2a5b9bff06355cccd97b492fb896eaf618b0dbb9782974617cfe4106bd1f9a541ff39cfe5fe37edee6c2e275f8c7531d2300891635d91732c625d4d58060fecc49cc716d7c4d7487c95710376520ed17c88cc270c45a7389f0c87c747e70b76caaef02763e27c945dfefe2e5be3c938382bc45a7c72a7bd887d94b2714195839477be2673083a920e9d58526654b9f30fc81ba62a6fd63479c3cc46eb47f226cf9d17f266361a48f0adf366b4fc3095fe0ead8360364267346d9101ab01c9ebc559e6f2782664b6d5e14fc899ee2405beab0f59cb0bce330fec9cf107dc8dc7dcfa6ef1f8f483dffbe63070250e819cd901aecc93d49b737bc69ef63fc4a8c7dbbc75b36309bed93b89f12c9046fde4dd7934c8ec02e6240fad5dee1f65b299db682abff07a9dbf5656f68d72bf33cff7c40ff6b6b0db61cfbd9a23e634576671ceabf36870fc6b652338efd9c8b8a11849a263ecd0b0c34a5c22337d69d509d0b0c3a003c8e5c50e64b0b8ea744c90b12b90b98b9d14751851916eb220ddd081b92147827120972394c8e5d90d419fb221c875ec88ab0e23451d16e6c9d6084567f2318ffe04f9eb4a9c94ee5b41eeec70dc2577f60ed5f3303815e22dd16c9adaa820f3335be79ef31b2173f378db65263c90a91013e3d5d8167abea823d71454c77e436e36de1d5daaeff1653ca08c59ce3a86623169ac60e75bdc7e8f851e9f7d8f1acc861de857e9cfa6695e1fc1bd17c5e0c591d115879d0855643d697b9332e4812f44952dd4f93e1ecf157e36f644f3174e03b682d3698762cb5faf2feda961cfd16dc6a7fbc9e7d1b08bb56562673db1f32a7e2dc5262d7d84c67d099f94f7a59ecae6aad9cd909782d825a1c7f378e8131a2cf56473bfd4f51bfe6da633d4ef817774fe303c1574b8db825bdabb29bde47c34ed50f797d1f287ec418eb307bde8dbf94c67a87334e90b6c03a017df7d79ddd5af9ec92fac45dda3c418dcfa41fe438a91805ca4d82f9f696ef3fdf6b32d64e43bfbff5579f9dede65af7bb787bbf28c86ee431e929dcb016b2073d62df2a87d7df9f45d6bab0fecc00a4f83dde43ee367b01ff0ea55d9832fd9b7296d72275b339739edfec4cf550386a60ea1d24e0a434637cfcea18203f9d44efb153c4c693cf81dfadcfdc7e25b589062a42478be980d7d3aa8c4ef0c6d0e775b585b587f26f1f37cddacd78acc9f41ae1da17606aac48cbb77d3a3d5d020fc912276c4ecc47adc2fdf0ff84b505f84eb32796f0ed1c9fc28c587dc36dc2bcef23c108f5ebf7967721688a3759c9d45f7474bfe268f8582aed86899ce9f766e1b8d1e937a30aeb013a872737429cee4e7a17b315fb553dadefb95b89756d0e213db2a93ebc4da34becee98dcc1ffc822f9ec6b994cb6ff7b72465769a09394de57f836c097a732ea1ebd36c0adf78f7fec64aea5fe57ba4f9669232dbc11cb9ef6a706e6470a13b1310f86fdc3c1a385b8539a183b5b31ce604be58e91741ce76792ed64bbedfe8ba1baf77311d26cff3e63e2f2b3a3bf0cdb8cdb0c3282303e26decf660dd9fad2d0f3bd13c1a447fadac1bc041fa8ec4dee08ef47dc3ad0b3cfc2f8ba55dcc9a9e78e233e4b6cc47c1ff85dea2e8116ee948f1fa653d52935da95f48e1d747b132b9d43bcf636a855c587e2d8e9df76ed57a37f9b4bc5bcfcff9357a68f7e58b736b39af42d7e779ed122fb4d81a3a1ab1c4fe1b5bfb7f3fb6f6afacffa2ac5ff593ef806642f75ec8f96121978b739656b95eef3d90bbc7dda338decf55934f6bf64a76af577a397efe8fc9657a3caa614fd662670f7dafdad8dcb72e64ddf3fb0cb91c535f86afcddea5c25293bd74bbf4bdf8dd2bb0fc22ae5eb69969b18a367c3d886b3ca3d152f7e63e6aa9935e8ce791e7db3e6f4db7bf186b58ff36f81fc7337e654fff4c5caf38f7d1258d7d73ea918c2394b664338646f59b940527417d9dd6ef55d60c72fa1c9ebe4193859cfb47f0f05be27b74f9fa3a2d643ae2c3f47acc0fe2ac7ee8ff204ee2cbcde41767d94b6533d616972fd14aa6b79fc4ce4879f72d713ebaac23e370e1ab3c5c89c7d1cf0cfdd11c9fca8c67767d0517a34bc38f7b2bdef78df6c046eb26fe3c99332aef97ee617e520751eefd25df637ec0ddd0d9d48e0cbdff99d5f04cbe0594937e4a83f42eea2fdd179c3760ade7c4b69598a4dade8757b1b8ec5be3ecfdfc3ee320c775193f3c94b0c2776f32819f647fd57af429ec4e79c731ab07e96ff4a56bead2479e3baee04087ef7daee533ee02b475c1a723c858f8ed668fca391fdfedccf752d81a89bcb67e754fcfea9dffa334f0f6de0b194cd2754e3fa256fb4622b9f3fd27b59ea7a9b9472c84ee2b6bb57f9391bfc3d6e39ec45dd6866f70a5d07d264b8bb3cdfcc84b72268f60ccee5aff8fa6ef9c4f339a7bf49dc57b77803df56ae8494d916a3d634f3d65bf97fdb6757486ef3d37f13978edce70f64d6d3936bbd33ce851ceafa95fa7ff349ee7afc195eb7da26f63f18d9833807abb075be892ba213f133fafd140ff463ad7c152043129c9397e26fc42d9fb237e24bf99b7750435350b9fdaf47a37ac5cd36f78753506dd59c6d59258424a072dcfe8f3d56288b92d716af96ec939c337763bb89fbdd116bbfaf74fd4efb1f2dc432ddf243dca35b5de8766eadf50a7f9b703fdbb6d51a0daf83bd119b35604313394d846a2d663ed6158b7491e8c2bed13fa77e54b525fe7bd606231a95d00f59ca01f682f8bd566bfaddc4fd19b33e6c94fbfc52eeec3c3d9aa196d761ed5a34dee788ada126a804646262792738618ee69791333bb4c1ca67365f6dfb77d639daeb1bc581cd43e508fc53ae5f720e4f7c037e480bf39674c27b7dbcb777f406e965f9cc9f97ee8be63ebf318ec454be825dfb95927769fcc71e8821e486a91e061e86d34f58aa7c8b54717e2f7c13ec3716072894e8b444de580c6ad38fb1e3fc573591f567fb5364f3f40dcdca3d6fdc99f9d321c95757ddfab49739002696474f188f866a0fa2c323894e2f450d6c4ad9d7bb937b07d135ff28d3d3aee5126f2b9d56773c7885fb4dfe3dd55521671565be796fa9b75b81bb444c25e9eebf40df87fe18c329f96a0a3ecb7214973c75b5eef8a02eb6bf4ad5cf2da5d57f184ce266ffd41c14964f283a3c9db493e1f73f615bf43a3c51951f6afa1c377ec9fc8e37d150f795ce13d7c9474fd555a246a19bbaff26851fb80c2a3c4b76ef5f9df9605aca11811727634590071800372e6b4756e054e9bcff298c037c230fbacd458296b89dca413e42e06d97751e318be65c8ce02ea7b953aa552d79aa4b9a0f9fc97e442514383c217d9b329059657e979d52df99a82f317f9eb83902ff14648be654df98ba875fd35f9b0669036e324aa7c5cba4859b3c6e95bf0f0f18806dfc507216fdec3cbbb724291c0ee89e56110a2d1fa2a3a49bdc474adbcfef7cbb5fcc8fb6d2d77df4afaafd5cc7e59464492b2eb4831953f43acd8ae1497b228afb3ddb017f2bdc505be5edd63288f76b14ce5ab898bb8758c46afe11ec5b3aba4406d9f235f930d396e6ea59db9b8d6f744d22691cbc9ea0f55eeb934ee4e12dff6379e35cebf8841bfcc0b1458d37bb2c47e2ed96fe41e2fb297d50f5d05378bdbdfedb8d3c4c9747eb784e5dde63abe2df44e980bdd97f7afad79ac480c75ff9ac4a391147cc3fe1bb2e097f190f373f41e3e72f9b17dcdf7e1a54310caa3fe5556ac8a0c486ac8bfcc1b63de50e6073a6f64cf729d53a9c5fe32ef8752bc6364855a5f2b94147b2f0b857d90d46faff37db29fb7797e1ccba345175175c83844dcb863382ff2bc02b680c589a371b7669765f096f45483f9176c80f7cfe35dbbb806e3bbb670dd06ce71f0057d4f9e4763cf018afb3c3e7d6dcf846efecadedbfc80761cbca9db1fd05a51177f4b89f3b5c94ee04c49e9f334d9993fcbe546d9d3a0a845fe720da219b70e0c65174834bae516b1acad0329a7dbb22740dd07206affe7b2f08dbd2a83bd3cea933dc2ca6723a98b95feab71a5487240cf5b81381a47553d4fc05eca7e0afc25fdfc03e7f5aeaea3c0fb555d4fe0e5a1ae7f0107c459d57160c406d4840bbe0107757dffebb868d5f78f71f2a6be6fa7c9bce7c3e945795bc971b7e4bfeb733b4d99f0780da903774b255a8c879b1f25671c4bd3da1a876e81c7dadaefe9fe57d67ed1de979c192b8e7671c3de2f616ec6014ab8dfd7ffbf7636bfcaff45bf8e777dddba8f5bf45cf98a1d50399bfade6791a419119e7e79ef75beff651cb4f9fb0f71f1a63dd04e7f90b3db336fc95567efc91aded3e46afe2c97ab597f9347799e167b1f253d32b136a6d8fbe86868eb002b59bee590f736a8f37dde97e4576c80b98b3c95be4761e24ac2fad59a2691a41857c919f3e26816ca555b2c879bc2fb39ec25edfdee337ad7161687bff47e2bef67f37c5def13e7d3d8fb081d656de97d75ef04cf7e09076dbcff08176febfb76fa8358e2df2fc74fe2456c280baa3d953fcbf10a71d40dc5fe7f28b763a841624574ff6d02323d4284dc86fe180d199cf4d7a9f3fb937d395284e2f981ba2f6d01f4c6bcc8eb1de91074e461d095a2269e493d220e2b787a5fbfe7bd53683a8ee8ab42e0ea6d1aafc1f8ae8f5bf76d731cfcba5ecf6980bae7312f69e3107f71cf39ed7c71ef6dfe7d3b0eded4e7f268769547bbabac4895985d71f781e8215bf201e4dbed434b8dfaec19ce69f66eebcbe44e1b993b7d3366f7d5f5eafc4d85a9c8f1bd949783efea67b4dc287dfdd24f7b0c43316ef9d9c8c13bafdd4b7a7486affa9222e157a77cd063eda48f4ea547f05779998eab877afb890c1ef57923e5fda60cce9e7d2b7e944bcd56fc163cb5f1fdcbf87a53b7d7ee2d51e5003de75ff659789e23266988c841e67dd05e3d63e4493c52ec23ed8cf3678fe17f240f2af9dbf77c23a5df1147e3104501f8242fe0f1995ca8c042d5ed042ea8baae7a1771d97bb2c6bbbeebf55be67b493e10f3aeba47cbeb05708f2ab9afcbd92753505fd525efdc43f92e3c95fcfcbdf87a414e3cc3dbfa4dbd370e20cf2f2916e8bfea7d1492bff3bc27ef3772c3afe48d497e29f39eefc989afae57cffbd1617a4bced6ea92ae2f8fd72fcffc310ce5b82d57afc5b46ec14fadb7d1f4c119c66fd33b8dcef35cf3dbbe6dc3a7a5e3ea0bfec01b774ebe073f25df7e239e5a6300afe2eb4d7d57ab2d459503f5dc3fe4d7256fd6a1f264f68cc213b5feed2ff2ff17d67ac0fb192c6ff13dd856214af2253be621ae22f26c9b36659627a6da02046e7fcd16f862febe89a337f356f51c1e05375fd0fb90d38f0cfa9dccecd9fedb70223fa59f3771d396d37b0947efea78f06bc75c7a9f67d1a6a78afc64951fa5501a59141ce7cfec268ecb5cedafc4f7bfbae6035fa084eb4dfc414c2084980aaac6efe8eb97e7fd18065a6c80c39e118f0314ff726ce0ed3cf3a3d8c0dbf9ef976c7f02575f8de9bf719fe15b7044f0ed37e2ea05bbff31ceded55fd03352528eb138aadeed237094e52de79f8dfcf04bb9e3122f79bef25d7d9fd713921fd72c68817bd62e07cadc2ec53efaffd9fbb62e557160ff0f342fe0a5f7f4a34a8bd882bbbd717913ecad22a8676bb7e2a7ffaf0a49082101b47bcfccf9aff3306bd66e212495baa5aaf2abda77f1d5d792b5b2fb3cea49e728d101199d1fd40174fc6fb0fdf78e55c7cf276356c8ff1fe58feff4011ea6510d1fbf9456f7cabd19764ed6cc2c3bafa6b9e7c1b99017ae9533e6c7d11ea9f5735b5e18c5ae50cfe0df04b24773a352b9e7f3fe3575a5b63e99e15b1b7203664f62ff714d4185dc139a08fd7d86ce0ffafb647d5fb7fb9896df29f3b4a6a1d4e7afe11f8e75b7edc5c2fa0ffc5bfffb685362ef1fa5510d992fa1d5ddbebf32ee5d124bdb9dcc707e29b15bc9b2792ee4edebe4f49979e33cfb9de7fa2f7cabe45ccfe5fc6bc9ba6a6e2feab87769585239c7f50519af08fd0bc8310b6d3b939f7fd8b6a3757d5dc6bf297f5fa4cd176cfa5778e13b65fb9bf3fbb568f44dbc0adff9fd8efbceae1ab8376af806b5f385dce9b809bd4fdfce9ebe08fde67083f126721801406351cd8ea975542b366e9231541f6419e18ab0f578eda2cca6e3135f5c88ef0032e23943585b7e5ec2f81a7c53267f34bf59898d8064d3e99c7d9dec231d7fcff4067b22fd804d11fe07f434ba1703a499e2c5a7df42faf2023d89b19d20fa26d7df9ae2cc4f3b1fd623b8f7a279ecd979a4b84b2c2613c18a21d83fe6ac731972cfbf2668ce1857e65cc0e847732df4b01faa08272aca8fc5f7980f62c08099ac303d527ca684eb730ff1f7583daefaf9b178dcb151ec6d3c7dc1e3ae7c067a3f5c3a9336c13f015980bef4bfe6ddb3674f8eeeb473080680edb74802e8ff654f54b7b1d88d6cf5138f81ff3647d865ae3d89de9ba98e271847b88f3d9e03ea6b0ffe15f2a35e9d339dc338ec02e6dda7af5f37c1c08a886e43f7249288c5f3217c0cf27e729dee0d621db9fea3b0b7d0070dce20b4d7f5e1326ea03a9e14fb2bde1c3d7d12f9218fd5829f871a9a1bc1a3593c880543d773f4e3154fff741d8ec2eacb13ce7520aca611f69130a613f90df0b5c4bd891d45a87bdf9bab03e0bd8d1cebe2da98b6d08b79207e7e1c1e371ec26529c10a63fa47e39ec71f6638879e7e42bc32c0a302dc6f84b3b49f44fefeedc9d81a8c6ce2be160d8849a4bdd7193b8931c4f3bf97f466a77deed95a413acf92317ec9f2ceb67a590d764fe3b81f03e6ccbb10578bc953d96ae4ef170ff70c017d63e67a1ca7f8501ee81ae7ed295dcb39872368ceba509799c370c27a33c30c0b0f1f04731decaea9e57529d6f3c5f32cb68314ef2afdfe69e958a08795771be274e91c65fe8f38af793d06da91c1541b7ebe677bf6643add56b6573856d5bb5c465ae704d84e557d4958fef6edc5c76a9aeed77b13f773c6187b640fb8fd3f7b765b25bd9fe93b6107ef19c6b763f4a50f1853b4afcbbc69417f7ae7249ac7cd7f682ed6b9723e8edaf1edc56e69bfac5f75846785f1f80e4d2bdc5cde7bd18f22afcecfbefd7646e30cce58ded2f168dfe681225ac7d9eb618c7ffa1ee8daf41ddce39ed59f399e1dcf7609c6be2caed5be628cbdc90f826b8731f84a640ecef7a48f4af69ea9e177701f7df99ebd24a6d695d2e85dbf3c42a3965f8346f87b6ad0eb14c770ced06795ac3fd36fe097cc325d9cf622ea887029a9dc13be5a399db538b77e78726d0f30f94a71f40a3de9898e47bef4e40898f5144b137cb8d902eb96b682f4f5d660ebcf09962123cf04cbd0825a56d09d47e8839bab05619ea73880dbd60d7c568c7197d9183acf92f1c8fe0d1617a3f7655b80fb73c8f7d3d4247c54b9af642d2f795d477a804ae69ecd39f37509de22f11f197cc9743dd9de81ffa42c6d6be3535cc4ccee67988e87adc1dc67403c99df9302ee60e607643a2ddb378687ebd97f326fe69b93d23da1be4fbc380783499bf181e81ef07a19f367a50c89fca14c4fa07d5c8b7c07b2e7440eb0ac337aecef8c1fd233078b0d4af44c41eed13a7a9d7fd9f71b92fd40ebb9875f091ea2947eec1e506cf05afc9ed1ec9b7dd9125ecdbec93c2ff2656be9ad527a1c23dfe92aef0c6ff2fac4ac350e435791ae17dae585ffde53819f420ffd7bf2fe93c8f54239d57b1ee634598df2bc827c051b729cfa75e33616f06fc50931fff76b8e0d7bd657793f83d21ed9dd26a14daac73d3d6a2c6da423481f21168b378b9d6867143306df7d753b1e96f664e7d92d9e5f72b8ac4663def66c2bf2f6f96fc239ce7758d9c43cdcacee25684e5b1813d83ac1397f1c0f3f57fafa513cdca31f6fa2208e94b1ec7c59330e4c793d2cf8bc086f9bc40e473dd16f7c3cdebc5ae14bcbb40d32df1dd4d207719fc1622d39a36439bfc2b7d87873614f689c94c9f7a4b87b724c57691e88bd27c4f26ea478b6c2c67b39de46bf3f1c1bac9f9b63bf09f2334febbc9a45bee4e878f0ecfe8ef32b894d61e37df87cfa42e27d02ace70ee1dbb497e7b6d5c2fd90446b6e2ef548813a6488377910436aae12d7e1f514a2dfb988934e648c8d8b1afc1c0b67662ed64a629b28f719f4d4cc9792c573d8bea1292e3af5c111d6bf8ececa223b8df0b7857e78ac42dcebe41562b0d9b35c2c369dafd6423146699c8dc1fd1e872f6b738af1f81bd0af61fd644e3b57434fcf5e523ae06f10991ef572f4fb0be30c9358fd13aa7744dfeb578ec9ca6ec5bad97c2ffbfd33e30b90b9703996744c3cce87641cf22ec9ab08e94cf3256c4c88ec59da8769ebe6e4e890e1b517f802ed410e633ed5cfc7b4f74143f037018e7bd084fb486f3f967be86712c5efb8c700d85bfc8d73fa0ccddfa39eae6edc4f6af40828d288d284e4aabc63b0b794916d1d96f675f73a15d29de4ace3a533bcad7a6ab8d4a393d78b8a67a759fff23e504463907990b14eaec37c7786794abcaf78aed74dd0041dba39fa3a93ab13f8dcaf4cff72f0f9c91988c9575c04fe20391f67712fe477b1746be5d686e7f0e4c551c2cead4cde65ef30bd76e0dc9e9e037a6ae2da9323f4103205e7724c776ebe02ff12e9e9eb7135c86c023d176e5baa457a1b64fa989e43ac999b3f0f73b48618b8af470a3de76c2f75cecd67383b2c9d377a1e19f52a6228640dda177d6d761c7a96299c572e9cfc9c83868265e8544a07a29b8286f2bbf4fbe2bd203d08337f33c9cedee96f6a9e277beac9c3f5e0af4929ad093f9ebca47c0d4cbe92891905721e11cf2bb319f9de17bba5338c4057433ecb851ed5b6fa4174a6a74709f46f40f610f25783c5d90f73ef137ff906bd66575aa1970cd6b196e23a43e56bfd3324ebd184faaa68cfb6bc6e3e5c4dad53c8194bdfaba9a74536b75267a767a433e403ee9fcf7553a9ef04f422bacfd7fbcc9cb2fa2701ef1edf6be969416c0be702589dff65ba8bed54719c50f23e6f970afa9b5907ec0f8971b37680b3af54afc8e68665fbe736f7f7bf70cf07c13980b3793dba179846dd7cce1cf7e682fa32677a299d9b51c8c9f7774bfb7975d79cbff07df2773cee1af710f25783e8e2cd521afbaa2acd31e2dc1ad24faf70ff2dcd9b1dc7cdeee732ed6573f61c0f622b37d2a36414e33e73f8dbf2bc7a164fbbab2f9b03e7aece138e0f08fe26d19d8361b4d2adc3b8919e63d2b39a7a1bef2d2588178adb58176a35702f3e663fb81e79bc4faab5047db22f8271486f27d293a8ce3328de84fa9dbc0f6af58162ce4c7fffc0bdcfae86f6427a9f511d3d9c7179604d2627f9bdfd59e8ed77e27b159eee7aa7946679dabfd25e77b42764e9b7489fa4d784ff7ee9b748afa4df82bfd5fd5e9d79f2cffcaec36b7c9fb6d7a4f8fd9aef3d3247142f4df5c0a6265dcb78ba368dabe6c1fd7e959edb4af61dd1dce8157a57923e97b81e4fd69b5348bfc2de3071c9ca3d129ee79bdfb106360e3279ae376fa15f5367afc4e7e16f3dbfd69f3f7f26adb70f7ffa3c2b9f3fda3f3676ff877a1dfe9a16e9c0f1b9781fa79d87e401ad6b26f6b505fc9bf5edc5e7e24a1f52447b018fddb16eee9cb15bcbfae7fe29be18d9fd4ba043eea1a06701b32a42bf0deee095a23e3cbb4ef7e2d9ed9d91faab853d048c33cfe9467efcb6a63dd5c4716846c70c4bd6d2c1e726895eec0176d5829d13bdeff18e70e027d0dff556d8c79eba0906cc3cb7277276a87c8ea7098aedf72e65b4e2c72476494c4366ede26fd59f03dd774cc7d7a4e6be6d1ffb86f81d296dd9bd93d148f8f7427dcc56b90683cdb37c5d13e6dc4f624d927708fd997ea038ce43620592393d787eb9a33770452fcdea9c5608b5028a243f8b7114747207e2e5c362725096b6534d6ddee4eb36692ce9de9cedd68ac636d43a67b886e3b07f79ef917a840ee0cbec2c7dc2e207533d49f265b2fde0cfda69df5cd0ad9bd5a857ef1d723e67f80d62d32806c1f37a7aefa1da5f85fb10523f6800b4853b538bcdab93d500405ccb4dd6d751b888fc69e7b7a1a17d5cbf261d54eb28a0411a13ad3e47e179f37eb8ec3989ac64f140e13c64baf1e7b6f03cda87bae350dd73e7f7d9f7f8df70ae56903bcec90e96a717520bf103e749b3ba8d694b15f3ad8563c51c2dd33b2bd4b721bea9604d377f26f34337887e905777a7d10f2acbb1b5f175f300f51a986f481df76f431b426deab7cf47f65c092f486c13a28bcc2e1779d2011912c89d781c6a83eefc3efb1eff1bda8377516ebf47ea65d2fb6641237af266878fb186730b621d4cfd41eaff6d5b8944f7d596fb349fc3ebab099b7721b9a0eb280cc4ba5693ef27e7b73f9ecb14c60d647a287ffe90edcd1de75519df89cf3b24cedabb874ec2b9d4e6eb0ade14f274c53b12ff86ea32b081757310c2f87dfdfdbc9f9eb2f9d71923b30b5cde08cf4376d6ffead8fcb3c806c9723075e421f3e765e7539c4bf8aeb1f87740074f327e487339857c94288653380f43be87e833ea2f5da5f16ee6dc7765cfb4d91d03a84da577f8aea350926f0e491dd6e9bc6a2cb6cb46a4002d20be497cd891dddfa1da15ed18bdebfd7045ee0c6fd58da7436ef5edc973365097f3cce8dacc46dab8becaf9625d56317f7fc5fa9ada6f26c69aca77be466ce3ee51ae59f1483d78d86169793667597e00c666ee415e897d1b379ec1f73fc2bd36f1be5dce98df9eb03f1e798396f0391c277a1ac7705ff43911e05912de29ded396d41de6fa7997cc0dc710fe12ef591ac71987c7ad6783dfbc780833dd4cf164e4fb43e2b1f45e7d9ae7833bf9bfb6c2f7b23818adeb28d268ac75b79eeec515df16dde527f5a8f5e9520b9bd0da9a8db7a618df9efce649e507f40e3bcf52daf42e25fb3ef1993ad755e938020cea0a7a66f7eeebf2fbf49be69ae707b15c30b5e1f2b9c9752e8dad10bb3f3b5c84b5a2fbc9673050eaaddf91ea27f03fa84f4dee378d66445f8bea6c492d7b7a86cbfceb3ed6fdcf288f1a6cd56885ee3e98a49e9abf5b26a11faacb267efa93d1e8efdc70b1f5b258068e3595ca0db927f38fad9bbf5fc5ca27f83a701f3dd0179b55afa39abdf9a735eb44863647b970660f69fd81d1abcd87442f513b4fd695972312b359afc5b423f24f74e3e4af9a34e674517476ed5534de2f12bfb7de7a7a5f71e17ea2dddf7bd3e7db2a0e7ebfebea69d49bdfdca6d5f0926ecbb7af1fc14dd92f071325d00e9fa3e6aab94ada4d33697f0671f069869d8bd943efee8d41ca7f3fa7416b9874498ce079785bef8d8177f49c55cf6fae9f87378449d432b4f57e38b3a2e1cc0a03c01de875218770fa390d223344f8407b437f7b1e2a4a6314ce1b56afd5b4eccbd94c5aea78dab98d42f76a6e15c0aab88d7be8ffea4cebacad5e2bb1e24b320a774dabd781ffb767da7a6d6e5bd7f1cc7a1a267f4766631e990df333687a680ec636caf1ed525f844bd4c7a72394636243d39c24609a800fd1573ca7a6de63727f05bb1dd6b9dff04fcc95dc21cbeb03e8efe235e64f2b67787aed0d77ae33d98ca17f8cbd8a46886fce2ddfbe9cd0be36bdbd31d89c7dbd7dfb3985bbce93cb78fbf7e7aab96a8ef6c16d043a30f93b31b5ce257d3758e37a8ce7e1ed6d6fe8c4ff52f6562f58afe2285a29c3cf774dd9a718386f6bab17e0fd84bb16938331b0401e7e7b33653f9c1937f0a3864977f773bab2cc5e27596a6ffb5132bf79d3f9cd4d10efc2fbdbd7fbe4bd68f3b423de8bc5eeabb6aaa467c7037a23f31b008fc2d317903f041bbb59e977f839c2bb3f47a1df97620fd17191fef3934e05bf1565233b7773fa383d5f7d56ec4dfdfda039d5dc1a53dd383baa417c3918f164b3d25f9e30ff23ddf1dadc9cfdde05780ff8680dfad3df9bcfc3c646590d3a4fa3e4b9b96a061fab9bf9e13787fbd1cdb8985a07bd3b4cbaf8aca1eccd9991e375f46f5667268a62f414c5d0d6eb71c6efe1d21986be3e5f07a1b23793600db9625f63f9be52d795ee0dd11d991ff6d09946accff61497e26a862660d165bd26c8b88ed55c3a9370c9d651933a7006afa2f07caf53776e24b796509f83fa132fb8e659ec3bb9f6f53416da7852274d697c5b3522a8b55b57c81ab1dbc807c2f910127ba77910e213e1dfcf940e34cebda3357df819744e367acada1a745bafb362ce85fb5d627fac8da71baa1be7ee9d933807f1cf385c20a17e5b030649907423d7b110fe3ac259eb5d799b58f25cd6bb80e0307976ff86ea7c10eededb69e4ac22373e1f573d15cf09f498221e53e3e68def5a02fffad3ce873923984680cf9fe2cc8df47f6d6d3c2652f5fa7a57e19eca68c79f514b9ecb620289844e28073bfcbd74dece78de704e3b8bc79c3c8a19f5b0ace5f915b07816cfac9d0d9aab23d4d0fe615b43cfdaa0b7c7899866e40e30d4137b8bf581e42c5ea9dc211e27774f215e18d9bd35d163c707d6f5aff9372417578a4190e96a747f9b9efd44b9c01ba74773b92bb3897528cd03193d7a4f674dfec6e5b4708c5088ab88e7c961eae1f51bb737e829af5a371136c8f10c77dd7d9dbb6fada5314163f0c7cf6f4afefc36179ddf14439b979cdfcc9ba5591b4beb90335c62685d33f5bf274ffeacb3b6e6978ba159076b7010fbe3d3ee870ff8790ee8c8b735cec5d0bd30745c4bb2fdbb051800e6ac7b916260f52cd01d8aa7bf086d17c96f333603e5c05c67b8f1638b62740186dffb56fc5b9a9be983dd083d066f8fe76752e75516b71c3728ee28ae95ab81459ff7a312ce0727b51a048ff409c7e01bae7d553d47500323915dd48365af14f3affdf379655f95a5d33d79089b82fff6c447b9e085027127e5b57f46f5053fa7c6d58894e33b8db5634c9f4c3e8bf7d3246752827152989b045fbbaab79e306648c73ce01aa8452bd0fbf9ba437a2fa4487fcc43b80e7988300ec9f7f298016f048ba180fb4af54753302fa85528ababc1f979a3775a9b7be4ef65be35c16029e68b48ee2a8bcd31fb836bc7ca714645fb02f9b266fd1a20f69b181fa930175a17b66dc9e67915e972b2ae5fd342edb1acbe5394ab22b80c348f047701b33cd23174ed6bf8aabd7c00ae23ec01d567d97e086adcbacfc55adb342e28bd6bc4d6cc87df321fd0453cf6a8704e44c7fdda4aea9807d9199fcada6d7d71e3098e317f59df71f311e74e7273cae54d50dd73651e825f53790da05732bfebc68f838367b78ed2f159df918969bfed879faba615197ba550878ee7ff57f1bb697cc76ea098c6d9c5633833d1378e9bd56092bceaf967f3f132b6eefd2abd5b57c0e6d28479d8eb9dfc49f51773b79a3ef745ff4cb6a7b1177fb9a6b3729fefa023d5cfa6c6d69d9b6c5d2ed14d65b601fbc1bbaa3adcbaf463724bc35e103f3783c67c5b696f191c3c8cd199e12609688a7a1ce03809a1afa1e5f413f8657bcf91e941d8ff49dee6a5b51bc279f276fa8ffa8d71ff03e2eda4e69f62dccf0e17f973c8bfa367c800f832ad5139ac6c752bc393473e730fe55e4e128c79eecc6670f13a58db8af6b318870738af3f91bfff2bdf77ccf34adfa8be3d94d530200c31e4d76e2f0fd53510da834fed370f67bc07284ec4ded5e16c66ca93b3c313c33f3738af805d247326b13c8c314c6517ff9bfa0a48276c15eabbc9e20b4cfd08c50f13ca54a12e288f512e1c7f2b8d9591f12b9f63f8a25eac2e16caa8340ec6f91d25cf653e8824fe5529f742d97e0c275ec46b72f92ec68f88ff8ae63096e2cddfc39b06c57c92c5798c8671f3b415775ec4321a1e18deb2006b14f82359d9ed0f946b98a2b86db86c4a7536396f88fb1d6be770e50c6f300f1abf97e656ad9d67cf136f26a8cf91e1eef1b222d24332fd5755b3f04db29beadeff93db82dcde6b8fbf4f66917dc0ffa63a1efb071c7d52ff4024bfbfeee035da5f56968713d79f65f29fce2dfdf7ff2f32e9988fd957164b1adfa525f714c685fb7ae4ef15759a4c4d157b2f36cb7da318488a43f77fb25c90e53f2daf41a22acbc1e2ecee538c952fdbd3741fbf4b36314ff0fd6b985c0889833564b263a9c1de0259a518a775cf3d77c825d1171f56855c424c05f2657e7325a8612bd10d593cbf5017ceed27c4404e23677109f238f4b4aedc72bad21eeb46631279b3616485325915ed3b9b472bf7f785321d1e42d79e6c2d7bb813ad8badcf10fb8543d58fdb28fe4df925dbf79d677b1b88ddd7185b68bfbe61cef9710788ff7eb8cd4512349e93713c89bcb8affa8349356d19f992fba5b87675a03c50a7cc633cf031fe096fb3853cfc4fe4debfaa07cb72bba39e247ed5bb14e3fc03de5e806f83f2ec1faee3fd393cd662ecffc2d869366626e8c9033dc0da9b15ed9f57bc67c3c6c848fe18eb9e0fd771f37dec92cb871ff71ba4171de9832fc7dbce7f9fed2fc8e07637dc5ba05a8db7d2fa6ee27379fa22769dc50970efd15c9c09d45e17efb4cd785c75acf3f6ec7be61acf0fdde17f17d095600e09dec134c5f621cc7f4f6a67c477ac98984739260a137b45fbb3d2fbdb20e9e4f605c76e4e789e0ac45d8d5e6e3f099632efc764f1cb6d27f46651e43616b1d123b6ca7b968e99b41fca4f4ae924b1c7e27503269c926291d3bf211e95d32069937b320fc7cc05b63ce333a05fecc5fc7c05fd60f8f817f05115bf5cc695f73cbb64af2ee02be479e4cc8ccff3a05af2de89c5b646b40cf4e8e40fcc75d61b94950d9e6ee5310142abd47f22df457b4bf611f93d3fb7cc3e3619aca43c4e5e11935c828941be4bf0ad97f66247780561fc4f89dec338ac7114c17d50969eac4ea7762a5eec687f358279680f37412342f77073efe03c09f45b3567e459a055f064ce482f010e8364af7c005f61bb0ffd48fe1aed271b77ab5e5cbb1d897aa7149ecffc7711863fd8b6adcbe571d83b457fccee3d88bd92a7693f5cde8eff03bec0d2f6da121c5dd02d21f437f49c49d3b3171f8ccdcd7a79609f1ff61ce39aa3bc900bf8488e91f2673cdc407c291dbb0f3d621398cbca8e76a31ea1a501757de0a701f61dc8efc94b2eb271a1379b7c6cc73a978cdbf2efc191ca638ea5bec71e6176fe10fd8da573016386c7eaa4fb96fbc6057cca714cf7448c2f0fbda26c888f0da3d560157976fb39a387ba0d62be6ea550c750ac0db9e1de596cdd48e637b0bf53dd4cf39482fa10793fdc1abd52b06f013c35b28760eb7624f7c3ec2de4b73770b7bbb6cf95d2e74bbea918e39ae8a6c931881721f6bdc1deabbedd12dccf66e24d198d2f63c0a86dbe41df8036c66743fa29a305d811d6f69cd9b1c93957e81f677acb38c3fb1cb63bf40952f3352780c13d39bac8663c6f561a8ec130e3147b8354f359198f911842b6deac07ee3babfb0023c0863b9ac3c8cbf06c50ad2896a3adeb58c7e086e398f977d335423fdcf085c831f860cd71f8f2980c63ff19cd99e0163bc36c6f325f565a734a7c58018e8ac44728c46089dfcaf50995c66e24fe33d9db147b3a08f3e79cfc1a8d7b650fe351832f1b813e2beda94863b869bd2f934f49eb464a7bba94df7f7df2e3bfcf296e485fc96a4ebbd466723467796c8d7acfe5fb8a6ede7b3416a65ab7f9c778467a8bd2b547ef832ee82bdc8f80b54b0c6d49dd21c61caab09b67cfd9805fb3c3583f793ba9078c9d2cfbbe7af67a35e790b7afa5df7fd7aff5f593bc4fab6addbc125aaa79fd9cf615031f71edc7cf395f4af8db977bbb08e9bb66fe0eb492db10a8d1ba09ed47e2375735c777f3fd324afb2d2fee189339b7307b46ecbe147f64db2ae893123ea9cba3b13f581c6bf329f4cbad2b27f65bdd39dcfc69cdef3b9b53de6621bff189e749ecdf333db82af786f67af71cb92f6bd8d139d09f9395de87fc1c63a3917fba59daeac56f0e151778bacab6e2fe9a79db4a7b1509696cf404b624d74713c70a9958a66ff75b59efe1a06125adf618eeb6392711cdcf9ec6d15cb4dfb6bae3f7db8bafea52b2e7befdf657d959a4f4fbb6baab3b87a0a1d4fd3e9c59183f0ae727c34e51eec22e474fb69773d028a565becf0bf87c4da49b922ee6cdce8fb2df58fd0667c7e58df678a1674de68cfd84c74a9f1928e239f53a79df17e7ea44bd6890ffd690e8d766cdf1b7c2589418d3c2a93f6685bf2ef089313e061b7baae493ba3c5ae24308f854e64308f854e64314e720f32104df071f82e5adbcbea43c99ea54e1f951b637ccd98239f767df42f8b7793dc9c7077afda30fe789fd640371daaa78171b83a3312fdc835d42e3b5c8772ec6b0f23de55db02bd8771ecf76d751b86be1bef2029a5ff71ccdc5fbad07fc7e97d9e314cbb18e3db6affbbaf6583007993d2e7e1fecb1c08731b5a2dc99833c3d253dfa856bc9d97cdb3b41ac127493a163ded4ce25bfe5f41bc4787ea7e7f0a12289d3619b9d3e23b4c5f675cfda62e0fffc1c799f33f821d3af75c7af8c2dc4fd2de48456ce24ba634c26becdec5956af23bb1724882b94f0494f3c9fbb7c083da8eb4314bf2ff521aefbda3e44f1fb2dae8f5b5e5f129ee47cd43a7b83f2447aff123416f2981aa72799333ffa6ede57cdcb00be2b9e78368995e07b84d8d6bb71ffb6744cda935a48e3a423885de4faeee2fa3be999fe6ace76ed523dea6c4edcfe08f7e65dbfdca3475bbe56538f16bf2fd5a38239c8f468f1fbdfa447cd997131e5fe68cbffeff9a32d9ff7edbed71f6df9dfef8fb6fc7fc81f2df2545d7e2e8b696d4e75fd51014fcbfcd1965fd71f157cff1bfdd196ffbfcc1f7dd72fb5fdd1e2fb93ff5a9cae9a5fefe0d59a7c5a2fee5a933f45bc593bde2ab0ed7fd24ffc6e1ff11ff10ff97de815e771875f58cf27d4eaf983357dc13fe607feeff2016bfa7fcc3a01b36539785b0bf2ea458c3ce825da6bb55f733c74253db40bf9ea5c2d5aaa9320af7858f53a82bcf7e6137ad4be370af86ad0bff4340a77c78a791763f9d2b1e6778f25cacbd77baf6a5dc1dd7311d65216c6356b8e8b6b80666c4dd3a962cf78ff8aaf0dbb632d69ed58adefa11abf5bbef68ad4ef65f76b51cfdb8f31c28f291993910fd918d67457acf72bd0f9a58ace855cf0bf86d378ebe4711a6f1d1136d22dfd4f8e4b9ad2699e8cb5cec9c8fefbb0a6f3643c0dd64113b014cf80d300ba3d749dcef310d7530e93ee0d6ab2978df9d987fa0267785adaea11cd67d6d9d3ef4cc99954d99bdb60ed69ca7e367b5b5b8d7562ee14c5d05ed4a56624f03733b40e6668487151cb7415aeed90e93ab54ad7a1f77bddcd17f416ee6bf542eebe97cb42da6364edce681d6f4d3d0d3dab4e257afec0d4ac621ba8f7936522d2d5455d287f96d37fa81e14d712a7f3d8b9cef077859fc3ad11e1b4a23bfc2cee66c95a6be9f3e21a0a7a8ed681703a239b0bbe636fddf236a6c6d81fd99d9249e5f866586f7ca2977375b3c9e52e5a511b31bdf3bda26e97edf56da93f37f3b5bd77f0a55c9717f7a54ca767cf617c869763bdef1ae7258cf5ef619683be5e93ba21f2ef22deb489fe1b123dd9ec6e56fa9aeaeb9fd31592afe1cc68a572d5417430529aaccd5e4b1dce8c764e87434cc5b63681067601dd536275f819ea1e03a877b223e5e734b89a3dc63ee09cedcf6990108c6babd76952bd3e5b1ccc7068010eba097a7f1b7c12ccbe11a217acc3a8daa3b4cf4d255f44cf657c5ad0f335f41879a796ae91ea75caffa1eb0c7752bc2c2636715f6f4b712da7e8cceb422ec3563fc68d5cddd265dc5c7d06f11b89116da137e232519b50a757d58f81bb6b715a3a9da74c3e817765f57ebc9d7d4972b25db85f03771bac4d10bffdb51c0c8bf7a0d9bbd134aeab7cb88d7e02795d5f8f6eb4771483ff85704be2e863a92f92a5f3967d9fd67b6675c0640f7f6edbe97d2ddc0b8aef1fc5602ba2fb1d34969cd102dd17c1f3ccee7d60dc5052e70898c840b3d75955af6e8c53d5a358ccd04b11f71c4c69368ad39a5ddc832adf0b8dc543c3986f593d46bd9e6318cb29ab1f9d76e02ecc91a9e394f69f92bdcbd01bc90ee065b908a7e394dfcfde45bccfbd53fa7788bd379e31ee2d5e4b5a4f7e21df80b57983888dfd3d99d3ced5d0999aa2fc1d159427c86a3dfb80ff49e339e3f085e7dbf34a8f145f5f307c4a78ec80f161b36783e6625b783e9df399e2c5cab1faf0f7508fb35cff3cd13d392a273deecc8befdc78fa22f49bc30dd631240681e9867c9fdfe49bd8f6d379e1d815ed5ff56bcaede59ea1d996e07f75987e5782f9cafadd167cf7ceed7556e4ed3c5e1a83b104eff55a5782af949b67c8f5646364a6527ea75d897ceee0bcc6f0a0f7e937ebd044ac93f9f3afa999acfc812eaaa183a9ce387bf1f327ba1392eb253911e968845b2593634397d0c5a17c77f0ec3ed66ffd6dc0f00fc9db8da6ad061e57243fe7ac5e9dc7c1503eac8c6ebc2e62799fce21bd1767156d4c6e2cf977b2fd8cce5ea2a2f313d43ecae2c6e32692ebcbb899d9b28c573b3fb00cb1f171f6de4a7d3ecce87df46d88fba7fbbcb4d5ec7ec314ebd2f41964a7f2f1f576e435b0cf12d23cd26d39002cd913e457895f81ee34e46921183bcf9fb56d03c7d724bffb3d34708a3aa3becd65cf2784a6fc1dd04a7b8d742ef897885e4c9ff48c2f5e54e87725cb5b5bb3a0816b0030fd27d0af451ef72ed6de4af360d2faa0419a5b14c80dc6b428c80cfa7bc623304738f7030d727938b0c5a063a27183ff371d93e491f86fb176a36097e0d95f194fd0dea2df47f7d4477c7fd8bf2fd217cb1c5b4f40f21fb96f12bf89955f74a7b6d7b90c6767fedfa5be04f61bffc2ffc6f67e52f4b9191f97e82dd9bcc47d79a3ca7bf42c4e915c3eeffdee0ef3dddb79a92f36487e7b0fbe0fba04e63ea8f2db1f9f23b1cd5f9ae35dfaae7aae7ccfde2add2edc47fe4c24e74159afdcbbf7919fb7419f079b0573ef56dee9ff357df41bd8e7db5ec43a48daa3b893b3a5847eef451fa748f74176ce203181a0ccd6101fb4d6dd276edc2683e169e37ba88001d684bad0c30d741d60ef90e7a1de79057511a1f943f43706bb88c43ace5ea37fc9ce5b0ac1d793fe9e8dbbda04ccd964dc784e027db3f1f7e613eec5fbb96a021629b50f78ccf68dec5ffdfe20086f66e336e0ce2fc463da3f50fc3f46fafd0cf8614b38c3d0bb88fdd06d801f01711d6a8f902f896df101fa74a477aa05cf52be497d77bfe19e5783e8e24da3a27f32cb615250bea9d97f5c240bcc3a5b3279fdf4b6aaf2ee74a357a7222e1276aa6d02f8f1b605e7d41df8d744fe7e6eb9731c839bc28e9fd9314a93339c517e4ddb281ee6e9560298690fdb715cab20aa5fc07f139ef9b8b306d91b72975d801b3497dcc33d504c1866af29fd880cf0eb4d313cd43bfcc6cdf328861a88fece6d0e55df36500cd99f9e3edcfd3072ed4918ec176797e0f694d4b9889e7f4d44f353d89e6a35ef7973f536342e9c611a65f5eb87ed2819deb52e1243617b180aef1eef171f204f1037f1ee5c739acb32d8b563ac11f0a39f1317dfa924f7f34563d3dc9053c4fdf9356d5f5c7b857a8bc25cefe2fde2be8a710344e757a6b75ea0437f215c0f5223765e828d85636c4ac167453851424c7e431d37f8fea7722c1c2f8f8d2bc5b062d794c5da72fd32e898a6d651add8b88d4b6271f7e0d967bfc9e693e63a72f3b99d3f57ce04d107eb62118e791dcc72e0a788f02fe713ad47fb2ef4d191c5100bb849bc5f93d9f1a22d933dcbcf29ef2f7772b479af8a396c8b3199127f1afb23ea335977aa2709b6d59ca747aeaf42451e46c0b3c46ef3fcecf1dfcfb0c00aeb2feac83ab86f385efe202e17995ff481f519dcf1e26923b49de4deb7173f7fac326c04ea0762db9061ee4e5b2aaff3f03d927bf8b6dc97d9b66ef579d462f843bc7ec433b37c8c3f9b2f8f1726f69dd2ef2822df4ef26c714ee8eeb77c4f489cebc31260f0d01e4d050c1ef0cd17a5dfc2ebe7ce80e5725ba4f350b67edeb74d751cb64b4ba86b839cc856bdf953fa4d6cffaf9bda3c22d20dfb3bf4089e931bf7775e63518a55c2da4533edbbf261e9059c97a6359d13b96912f9b06e9d8b08f705fb35a47f20c526593536c7953e3f03f68b9bf647c3b898223c14b867ba40fbcfc84651b77358c75518ceffcbb04fb7ae3d813e3162bc52fb05ef31edb37272a7f89daceea0dc0724efdbea65358844b5c5db95de5a8f62b053ab2897b3607dae346741cf48680ed314775074ef6a147a92bb3a5e8ecfa99e0c5f2ee43c42c60ea0cfbb336ce76b386a6013667483fb2550bf93203f15e1055d3764ad23a79b40dd66b0df15eac4aaedac9bb80debd915cc7744ea47f4927934bfee63a53dc8c85a2cd8ff0fa8537977cebc8f2af6176f5e68dde64d6fa06e84e3086996fb5d58fb22ffde5cb16ca361ee25df9bb6abbef705dabd5cc6d0b37216809f9af5fe26fbd35c401fad5bd0acbb16a3e5ce02c572726b51dd8615054db328af53f5b6b2add073ac1b3d03d4e56b7bdef466a6c2e8836cbe899066dc3cf27d9cc6927e485cef23057ade596170b2c21da9c1276b39fab1f7595b2e67ab8d191b2d76fe4bbb1dfbb76371eee0f3c67fdf2b8f5773b6da8cf5212b8f688e2259cc7dfb215e02197c6998dbcb75acbdf1b469bacef00372f9197dcc0478ce0c77521a81b631679d264ba355a39ff831d86e019d9cd52688db70de2ed2ea66404d433296f756df99b72832b5eeb32b98b7886685b93c4637381b5ec733f364259cfeb2270897bfb62e694c42f336cfcb1f192314d02bfbed3e9dd518eeccf0e5660ed4e258db76d977be42a7c4ec5d5433b934c6bc9ec735ed77f156b889c7b6b761790bd7b58bf4d409e36116e86442bdc8cc6897ecc9c69b75a3fc9ea4f315f1547e0e0fe9a8c4d2d627337c6b834d347b3cada0aefc5c5787e01ed239994035a263c1dcc15e2d6f451a95f2d2ad0fbc94581c2f05f1e943b00fb96fbf3fd0a3d99a19276bb63e5921d551ff03f1441fd50fb7d99859fa9ed697d5db24f80c5b3beec49f7fe5f84ff3a6b54ff19f96104f8d9fa116f983f1299fac01f8401d3407c8ddb3cf91bbafb5effe6b8239a29c631fead14bfadb66f321b5478696e5980434c37d7ff1d9a636e6bc205e84e786731ab91ece4bbb7df09ce11130f65d1bb0cc684d1e3e73afc8d9ba696ad93a511d71433d064debe8426c5f403f4477271b17ce0ebe1ddd8246b4f5f539e45d4f7ed38aeea97362faae2655342d5b23f5a7195fd7d43a8aa9ad55399f5aa1375bb03d9098df8c96a7f7636f2ff8c6b47b60f1f7d1193ed31b6d2fb67628ae46689b9d2bd619bdf3f7dfa01f931906aac97eafb180fb66bbfc99c7bc8d356b67692f72fd3e1bc2dd2995394767bf69de6eac4fb6908bf4f545027131c847f8f104cd0f6aab33de43f122faae7bdb846e6cc5b277e56b332e66682616bb36817f6dcee6aa753314f97ebd299e3dd9b96c5c84fe66debc9917baa26f2465fb656d4d7b12bbf190d92fea2f97ec57776bc6f3abd9ccf60bf991ec7a7453b534f752b29eab675bb1295ccf30f2668b885d0f1abf7c2d1b379eecbcd9225b4bea3f97f15dc38ccd1bbb0eeadb316b196bf386a5754af6066ca9157a8c3ecdfd662fb6ae23f846f97a622f9eb7dc599fd91beae796ac697e331bee855d930777d61bec19cb6c9a613f34c3b7323f696735727d9499df16a1155bac1ced503f20c72895212fec6f4ddd688ade93afe7ad6dce8cabc5ac27f59526cc33ebeb587b6999527fc68a3ddb8bbc789ecd8dfd4df7765eccf24deaa719fa26e1e3a7f49b0de82b6ec5ac5c631fae642d6ecb0ad78d31a3bb91efc4ca8d6d5cbd99712d919b86a76d7663a1dc406e6213b2bc86c62fe7b3edd81e6edddcfac9fd38d93ac0b6cc2f96a376989a94df9e13c541dc3f7b8eb90e06c34faf39043d724eef08594a104717cfb17e78fb21aabbcaed95c3d84dd4cb4b599b03a079d9731d78e6f94d3f47efb697b8b61a0549f712c4cffb205edc820470500dc8b9264ba71b05cd731cc4a84e28475b168317d5164c3b975fcee4b3f439a85d8758385da775479dc09ac1c9c67e66560ff3b4843ec4fae2a3186feee4f33924df33abea7fcfe498443ed5be9bf88d5502f1188479c862c0ded77f4172e74a80b14471f945bd1530d67728c681cab0dea1066098d682c69ba3a74f229fc91fa3f307ce1d1770e2657d0db4ea7c22f65709be13d44a29cb5ee7c3dae378395b2b362058bd266099ef567036e841dd17c16249ff0698afbcccbccb7a1bf2fbb555e15efa31d0173b9e67f15dfc271fce36763f19d92b9457803cc5abf6a67afa623f1a48ef90aaafd34e624de727ce475f9be13c1a71b8f58636bf0ca75736be91dd55849e6bd3ddda9a75a28aef3545dfb36ec5efbdf27c8f736a66be1f58411fb8761be2e0e04fe5fa48fb28c68ef745aabfccb5e9986b424f03fe0f77e8c0c76ff43f80de982fe47a28e95c83a48be220d0ff047c9ba5dd86dc195b5b4d30556e2367187903b0378037fa4ccf63e86fd3e887801fc85a226fc0d620e6e687eb278a7f8373655aebd8bf4ba789fa3210fc27fcef34cfee98452c37f4bd1738f3eede1d462768872bad7b7794fc1db7c13fa9a784e7e762bd68d829d7b5f9bb88b8ee4045750f5017496ae951ff02dacbf7105ada4e35b57993ef05c59e07f3f7ff708d93dd27f763717cee52f51c89e33035172fa25e63f25c9bdc1ec563dd6d7b71bf820e2da6bff91db13d5ce3f76b9abfa34aeaf8aa7b411d0bb572f99a0a9c87e5742c1f53c37e56cdfb66023acce4f7ed847515a23bbc927a872a7e91de772ba98ba0636a829cbd74ae13090fb03538625e1d3fde8f51ca975eecc9e6836b9216f9780dfd6ebd3cace81c4573b4427e557e0be7c3d70bd3d8615732ff9358deb3dac73a7dfce8bd36b8ff53f88efe9c2c9d23c230f307723d5b89b7999fe7c16f0e8fef31d4a1f64f3fb7421a71f548937a752c24a656212be6e0b139e5eae8aaefc114e7239ffba5a8db369f411c3d417cd3b52f7f897920e3611a9bcdee803f309e98a7f8b332b565e84e1afd0dafa9846f597e2adc6ba775c1dcbc41c72b1433e65d70df2ec7d7582fb173ac13972fe307e89bf22ec7a7a8c2632cdb07dce7e43e5b21d5c1a4266d56c99b972fcc8bbb7b9fe70d1c572f9d9374fe553c837bbd88f846aaf7be30a69817d5121d5ac287153a07f7c6a96f2f44b29faffda6f4c6b581395d5fab5749d97c6d75f7a76422ed6ff1df93899279fd9b3271f6b46f9789b377977efe433291f624f90fc8c4ae6dd6b113851e14df28137af0df94093df82fca04eaedf0cd32211df39f9509d48be23f20132f89a975018fe94705dd5a7feaec8031e2ff73e787b279fd8b67088cd3ffade7889231ffc1b304ee2b505f36bf789ee0e39580c53558dc96cc7e96e92ac0290f1aebbf2ae300e49b50f704d84a45be4bbfabb51e8df9105917ec33a2d3833528599d89685e4c4d7a55ccb23cb6597f9c420d5be51a6e51ec36265f5fc33693ad9a357a17537bbb8c35514e9bfcb628ca03f0ce94ad5d8998d8aed9321b6f8a298f93edacf8ade5e9c31ae37e75cfacc8fd7fec7d5b7be23aaff00fda37498099b7971c5a0e43c22aa740ee9ad0453996bd1a1ae0d77f8f1c9f2d27616675e6fd9e675fcd94d89625cbb224cb92888547796ec9ce002386bc3c4e488ae7c1f9598a3f2af1554b32a2a24ff0b6be459de70bea13a4dfacf362713d77c50e89f8200bad59cccfafae59d571cc7d568a43ff1275a3cd2fe370ff3ebb04d3592d8f9531f619fd66f365eb6f03fc6c0971dadba695379687f93e08e71bdbfac35d618c9f55d27ea031baed5f19c3d853e5730f07bba83b737f65ee92de5d318eb8b50da6cf9e924f47ffd6b3f10c8ba71231a4fddbda0db6cf59608d0380bd3ddf2fed7758d2db8142b9c1e3cd7f751cf3cd43151c586cd92fc1be5bf649b17486ec63df6cfcf373716c3c56ad70cc5f5c2b16c776b7dc0b6efdcbb250ee2d2fcb6d6b13d41ccbd8fcfdc5bfbcaf92ab1ffad700dd57f49b6d5f99ef32eafe747d19758c9afdd25cc61087b7b7ad138dad2ba36ff1f9c4df5fdcbd9fcae20be1de791b6d6d7788ac16c2bfbd4622eed15c23facd765eeaef416e4f1b3f9c5d0b743fc88d525f7a569901bad23f65b42ddd4715c630d7a774ee528ce34fc3bd5fcf13b19ca69e47bffd3d696ce3eec32d5a405ea487b755e7447339aa7902fc09cbd593bf555f792c2630c97c247f8b9abba5c9f2efc86f88e01e02deca501e7b8038b8d3aab7831a820fec3d03a60748e3a9f97c78fe2ed697e67fe3b5219e4ff437a95ec4f349d257725a4c59fe2e71cfff83fe26d70ffa21dedc7e92fa918b71238fd77037ab05e495807c60974fa87925bdefb1c6a46b31675cdf03db7cc9d6498e4fe8a559eced493ce1309c7b109396dcf85b7227f69e6eab5e599dacf75b306d817f4dadf1b7f55da8bd39f2941aa7a22d9e43a23837b4116fb7767f4cf13cf69c5e577823b59668519c3ffac7d47c8fc5e266fa6d164b83f6136b581643751c7fc23d0ae32fc557d6de4bfe4b24c738ed9bd7f75891f74331c9d1b0775ea7ef17be6eb4ae879cf789e557b2b4879c744a5d48967760d57d20fe159693f1ae5c52ca3d01cb17c1e363f3bc13349f557213f927247e67bec1357b5734426266701976a2eb64c472d6f37a055a9fee3e1b1dc5db2978c78fe47a29898b6aba46ce7fea5b2bcd6f3c2df39316e41e667c4173e1cbf413f9e9ddcf08fcb6e14316850d3466fbef49238d3de26bfc4cbaf373bf4dff96f3175eddba14af2cffde10b53d7358ea7bb2e8f4da9deff85b328fc721cab17a59306dca7c44e4919a8f7126c59fe77f63b88ce47796a0eb850f3bf0e542eedfb8fb70b4bf0fa4b0082effe1fb91e79babb53e5ff23c2969b48880976fe2eca0343e446f117957c7683e3e2dc3cb6754d3f2836cdc5a140efe79593ca7547e1fe2da20edb7eb379113e5c15d75b47ec73c96383a3c5c217f8d5fe3b94e3e578b673dc69bf68d3e9383b31e6e039ef304dee90edb55e720c5e22e24f85336fee56dd59dc15e48899c6fefd83cc8786cce40e768b2ce866d879c113f7e32de9caee73982bc0f9326b2be96f873b646448626c81a59f1a4bc68fe26f60dfb97c4063b2fa17fcff837c8ab134dd697e1b679fd31656b4ed6ed5be281dc98bdfb937ae66ff60fc6b857f7b6ac0d3ee3ee859cef77c9e9fc9ce6f9b468ee7692073e974f206fca617279d11675725fad63b7bc977693bd553ea1ef218ee6be1d2e826d7220f540e9db553627918fb77a1f965b54d173ce51b882fc505efe7e9dd6f9f5deae90930af4a93be8b089bd71e355c26db8d8893aa2fa3c437a361ee6bbbbd6ef27cf591213c3f56e7abe74884ccdf3f51fe6e98f45f13ce53c99482c728575e77abfd81721c9d9246a2d4b6de2f0e19bfdfb2985bb6e91ff33156d36fd8d74beed5ec2a78fd84b52f6a6260a9f4bf866aee6d597ef71459c3d1613c1f2192bfd89be28ad055e639aaf21a72389ad2f59933bf8d3ba4f99fef72b7064de106ba2cb4c81aff54cfee9bd80af037647cfdf5168ba06168f5d2d2fe21dfaa59653c0a807b3b1d4d7aa91f816292f892c9b88df85d4c0fe293d0cec6476373b6d66ab63a2ea5dbde8ddeedb6966b2ce95d3627c8d6bcdfcbc5e4836af65ced4c691ed957320f1e3bf668f1c0367b91838232fff9bc6b5dcd4faee5aadfb7ceff2f77d23efe426b5f1293aee24d973ba018ed1c4dd448b712d0ae767394f82fcceabd4ce68b39c9acb927cf6dc46b8fda46da1f643e8dd677e8e85f4ce84bd79556b5b41ec0df95dff573fff0aea6b61790b153ec8df5ca5595ce3faee350a2ff4fc96ce75b50698a8a13169e5b5bef5b59160b09a0d5f97435cd13f8c7c8cfafb337666a930c0aefff81641ed579c1f29dfcfcf51077923a4f188ea2b696ea3ee7cef1f661ec2ab669c1bb771035bed1fde97c663f1b823aa279cfd9a66e3ea7adeb6febf3cae652bf957583b7e7693378529d847abc5a02cc7493a9aee1a2388f9e7e7c02c835819c17ff9dfb2cd9978c135f62e1f9562f0b5f3f455f591b924de4cbccd83dcb6ccef44f814d361187c52c396c4c1aec57ea16b41f406424fff1c7ca93e5f383ee5697813aad5e1bb6f9e629ccefbb7e5610e36e6fed54374d95ef44e6c986b6ebfff98a67ceed2fb595d6f146df29a14d6ef649df9fbeeb1982bbca7d0fc9f646d7baefc4e56a941349aeeaeb40611a3fb07d4de6272e8b57b017f445df0d53a031bfa6f7b7d8f8bbf6d66da98f598d4a55a8bd8abe9a3938f43fc7d9f716f7c4a7a8a4c7d5b1e898eeb44534d4fb83531bd5ff02ff3f786d15b1cee3f5ea7efe972d1ca62925f767f23f7808f508bbcf1cf347cdacee86f6388336c679b99373fc6de6c3d9fed36c3a7c127c4fbc6d76c33e9ce3f5687b9f312066ffdc7c67e15ce36c3c7a72bec89617b308a6be3cffe53f0161ffdcdb047fe85b898eb4bb8fce8ef9eb2a47bd9f71f69bed8c7791a7ba7537fa3c05b8f43f7f3e5b0db0cdb836914ae3ea3496b3e99b4a6af907bcbcb361c8e3ece96fbb8797d87e4e0bec51d23979303f711583e6bfdad2c91931df66e55d35d8a72ccd36fdca74d6b4dd03b10c5c740df197e448b319c3b34ff2ff811e1fe62902ec3ecfb0a6a9bb45d52cbe1c5fc9de83c44271038d75eba7b279ae4dfa2a9e843f1666311f9a6e5333e8f3ad4ef5b58eb56dcfff83d8987b91f5cbe1fcafda5b177715e164dc877724d0e0f35ebb980f8d0d9be196edfe07c1367c3a6eed077fb4e0ce74a779ff5db8db7046a7ddd24396585c5e4c8f3b7fea62f743d7e17a2e61090ced3dbaab7ff88a6a7fcbc861c2d473f157470096dc3da3a857dbaf4e629f45f6c213eb5b51ab61b9be4eaf239d31a58b28e40f0ea93bc6383db70c1e997125dce4debc345eb63093668383faf9eea0f10ebbb9834cfcbc500dec97ce8fde03ba74d2d157aef9d73c7e88be2d3e67351ea6e90da9a529c8058db31bd77c8fff53bb61c3470a7589d2eb9ae3446689ec7454bf9dbe09ef3cc73b755a3497efffae470beb6c191684f62c4213f8b86bbccd775cad7ea58e193bbea3ec490fb2486bd1dba7006befe45612c2608cd27998e0b8d3d47d787d506946b48b0b3ed4cf309eaffca6d595cbbb8bbee34b3ca78303b64ee18fccbde6dc8ebc579a53a0f53be77577c3dae067dd8de9064158f5bbf170f3e86f0cdccacb8617bebd5a3634ddf653ab33581dc950ebd2f752cb6ade127a8482bc2a70b937fc8efbfb86fe8d89c070d9a90f397cb90d5776af3c8755373fe93f54b723eb895650381f1546d8dd4f9287b86ef67e12ba0fa2297e34d2ed7e8bfd25943fc41c61a49343cc58706c42da83a64ae675aee6ee9fb0e36661eb3aaea32c2d769f1ab819c2dbb5b6f7d2634be46ce97b10c07702ebaabeeec3bc86eb536af542f67daf47c25370cad07a7eaf1d7e01665afffa7d7fe9f5efbe57aadc5bf8ac77cff4aeccbf5c71479d7c372fe4e1a595c6b91bb7e7e26d4acf6a1b97fb82fe549d72b684c07f7e3c0f96187093a7418c11a7e88bdde47e4e5dcbaff115b95fb36469bfe463aaf581c49ce4bb5d65b02f958243d90d543035be1251c5339cfe76595f32083d83996741f32e24b226f465b980f54aaaba7b4b79d6530ceeaaf8daa4fa8709affc36826ce73e60376ce467cc93d784debe69934299e83bc6e4cf7b09c5f2c168be6186e5af2759e0cfe0e26bf82c7d381d6ec057d8bf32581d931db493a92faceee6e3cf218dabf271adc52bddba077ae07f78cda6cf7f216b32ffe47b32f249ae4baff5f1bcdbea0febd218d0595ee2684feb450f527e33e611b28faf5cfccd7d4a9043f509d0e693b17fa82900feabc274dfddf023be4d7f893d9907f6d10b9588ed35b047ad691aecb567d1fcdf4b9a1aabbf17f653b988ead9e4bc7ca6b43ed017765f010937dfafb75ba6f2aef0736cebc805f591bbedf57b98e68e3d7c5fdf8fdb531eda8f279283abdd54fe1b70dbe63fb88b76532d588a9e5718b7df797f1b2f01f836db61d505d1ce7bf9fc28be742abb68f587ff0534878bf430d5ca53eab797e511a90da791c0ebb23ada287687688ebb751dd84c9988ba2278977dc8c26361dc6769f7c08bc652ddaae2ad38adaa6c6fa92dfa57385ca042a3fdc07bd7dc9f805fb94d8a5fcfb2bab45f067ce1542ff8aba0e698bc9008e4391aed369ea3258b4d56c75236e84d633aa262b61ac6a670a592b4cefe1f8149f297edbbea7e97db0aeff48f3e7f5c065ff345d7b7e97cdf991ed7123fff4f689d2528bd36170b72bb2472aeca5fdf230df4461ffb7c7666032aba03ea04443244e5cabb56cd4a0a2b5f4e3dafc1685174b3e5288810edec05e4dbc39b1d1b458e93c8e6631fe5c85c1bbf4f62d5b86f5f4759a3a716dbe7d698bf75249f7e93c5cecd571c3fd398637f3b678316e6fdae3c3e1edd272016fa5827dcc7c5a5b713621b2781b43dcf4f60432f55dbdab19e8f3ab9ab395e6318e3e62efc919c1bb9ce3b31277359822b2759ae325dd5fe7be80038937f25e16500b66ff41dea38858fd2a6da8dfa4343edd13b1e1fb739518759e5f5cd4e32c89538f8cda9cc3f61df3e9fd5c5cb9e0f18ce52a51f1a2b1fe3aff907becb0013ea90f9317488e91fd6a9399e34b79690c9eab891c2ee898393fa0f0e2c3bc4e622d6bcfefe8772267f7320fc9f4ff46e90b3cbb0fdb10afdddceb31e9689b45f19c73f98ed101ea6ece3df6c60ef94e6da8ca734ef39897827708f3f53bdd4b276d3d0f70c6f4dbca6f34b78f74d7acf13ce47c896b2da891db181d783beb7ca95fd2fc4d91b559cade38203439131f7a453a178c43688fad17ce63ec7d8bd19eafbd52875e791ff7f4808f45df0fa3fb8ac8c58fbbfbd1b354994b479e4b539741fc5bbfd3baf5db752fa9fdba1ce174bf735f54e9477d013a1e3c17be3c1e894ded0d3ee336e51b95bf890d911c1e3e808fa345546abbc8ba95945ffa32fad938c93bde2e20f1b85563e9b94d05b5b22bde49099ed990d81cf56d118b519ec835a57fb21e82b2c644c7b8c65e96cbf376665b4fb27fed6f9e0ac6ec88bc0345bcc2de5217f3cc52ca3581c324e720924b42ae7782def7a87513e5f568fc3d299a53c47d77d676e4dc1e8b3bdd5ec9be09f7dbd8ab17e3b8714f40433966f8256cd0b7eefabd485f8b31cdff566c1338cbafee355a809ef39cd722b991b36bcdde8458e64c78239f7343b77720ef19d420779343f6de3f8cdf56ddc76fb4eeca67528b8e3f6a6f69dccef6be17dc568764fdda753fe2a3ff30f0de9c55aff96d787da8ad6ac97975f3e1feeb38bcf5e19e96f41d5c5b07a82bfed724d9fb5bf0cd2c8ffd5e8ef35f93a43eb8b6984dfe30b8ad8ffd5e748a16ab765c5b3f0c6e4d78df5fef77d6c7c134d80fa6c13601fdbedddafd355905c1047ca6cd2c6e3b4ebff3bc0eda4d076a0e0613e732dccefda04dbe3b71a77f0e26ce95fc46fbbc6e924f36e6b01678d175768b26fd53317f3ca72f648df57bb77dba0c57fbd1717e8ddbeb4dd47d729650bf1fe83a79009afd03341bb667b72581d5aac7e1e59cdc9ce34b6fec249df7cf616d555b5d1b35ff0a3173c927c46cfa6dd2f7d8ef46a7b89b3d0caeb3ab0ffed54db2a6f6414eb32e8b79758ea376b25e1df6fb9533f87ced38c7bcd6ea7a3d6a27505710d6305b2ec6effdeef3c3c0019a246ebfd3fa184e816e4d4237ff5aaf11bab5e1fb6ced6feaee1468789bad47ddcc194e9fd3a0ed381569788a0fe3d3d27b72e2dae0668db153fd20fc4c61fb56bda39ffd745d973bcf09ee5fc06aa67059319ded0dbfdcf6d11d4cd33c5f8a74579ebf11966506c4d1eeb74bb8f33992ba3cacaef0e9156aecdd4e7c5ee01f56eac040ac8df19ea129bd6760b2c5d7644b90bd6278d45a75130fd2969e893057b8ffcc63e461cc656d7e4d3cf027733bdfee4f4173c15c4e49e724d1197054fd2110635ca9de2af79fbf5ffc9ee49fd1ea2ea9b9426676fd4fb229e5b87e38bfa2c3fc9a802c0ec7eed29bef86a1fb49fbd3df66106f6ddd07fdeee554edac56f983ebba8b227d3a784b7a3066c3aabfa9bccbe97605baf1f760c0d7cabe6b0a7f11c49cc17dc496bc5550d64f1d4bf6cb2f596c86f6be65f0f02cd9f52fdebc111d076f7138bff57b41f61236ced4d74f7c9eafb5f1e7eb15f703907cbaf9fbf25bb06deda2303969efb5af710dceb8d9bb7f5b66503fecc7c2a8c1774bbab97f09f61cf1fd1dc12f657db76d8ea9d974c9e1c1019bf447c7f7fcee781f5df70f7f4f1a181c497eedcf2c8e44bb0ba0359c99dca77f137d8ac8da0f114743636d984c65becc2d89998237252c1e64bb5a403dee86f39cebc3922f93fa23cb6390f91b1ed04d46d21ab19a6544ee1fcd35cd73ab54aa4b66d26c437c96644fc587f95bb26941dec2537cd83bf07e35a9c1df0deabfa5f7969e2b7c6cf8dd809041b4dd700bf184053523051c906fb5d75b736fe7d300622df63f3a4d9d3f590e80ecc7c271fdf65cc9d732b28dc7629f8cdfe60f7fcf5aff7fcc53d90f2b720f2bd98aa7f800eb3b4bf5187cffa8ad5ffe37c4009218ba58c81b7a8e3119a5eb7194bf0f819bc018c77127bf8340e2a2b6b36ca0e5fc91f882d5af23b6a14eef9fe375a047b2067dcd567b6f28ed2b82d3d5bdc50b61eb6a3283d51764f6f5067ca7644e0b9476e2fbe4e914c3fd1fe4a03f8e49dd559035f4cd004af7b8d67c7b09e10e6ae02cc38623c14d5fc2f12eeecebf836db9eaeeb7f0ce89c70686e0abbfecfbe13e4dba0fd755f7e94c6b8743ec20976baf98ec3bd075b6cf57918daf15e4a76d1e2ce630aeb5503cc477830e9be8ea6609e1974143af7f29ee609a59e00d0ed146c565a9f5e7b9c8ba8fe7e5211179ad59aeb1cd45e41653bf531f80f53bb3d7f13d43ea8b2eaf4bcfc7f053f3355bf4c3e0e0d782e96aa7d2d87d1b1e06908701eaf4e5f994c55cb16f389e523e6441a3814b74958d3ec79dc8a1689bebf4d95d6e9757831f0096c8716ca3d575d47dac059d994aabf051ed7f33f0643982e9fcfa1fcbe31c78f7ac7f673e18dbf732fcfc70568ba6be83e1476ba5db70bb04dee375b95da3b891be3a5e90fbe9f01f1c27fa0dc547e95780cbf4b1b69cbe6d305ca47ae9167c406ff52f7e2741f1e1fd8db5e2b5ce71bca4eff85ae9fdedf8451dbf114d9b19869fc8396b912bde78eb435ee4092a57585e583147ed777ccd684ed72d2e53d89c446e46dbdc063b7ffb78f3dbeadc72182c47abadefb21e6df787258e17c8fff755475b333a26f773aaf241efa3e044f3bb96af55f769ef7767ae65adae2ff67d750b3ac15bd069a27c48fa1afb0a72a47e58d708bea1bc47bf95e1e287cf577fda4765c4ca7b037fbd9d0e87e880f6536cfbb1a5bf168f6df207b3a598de66cba5a1d272d2e07a1ef81db41a1e778fc1ee65743bca9657c1a487e6d7906a66307de4feb1c0b6976b64505bff4edc7c5103e41bb23f65baa57e4ff7e7983a10fb4efd124a1feeabb0c36171394a3f41233b3ce25f6c3bf25a53bf57c11c994e24af47899e26cd55d385d83a18392dedf025fdc284dfaf2fa78921cf25f834a73fff9bef497fba7af30ffdba9577f899b442e66d3927a5794b6791013beafab760faec5a610b796ff6ddbe1d4661f466ef4b73451b73b6c85469ce428ee97d015f637fe472ae3bdf419dc357c42f32dc828fc5b1f6c3fbd03ca11a7ef9993786fc88f0dea324f68cbfc553608faad62bd3f909fc6ce0170c9f11394762df58bc7b311e1d73bf9af16ff8dc157a17c8c8aae38d3c539ed058c47bd682d05d5a4722032b8d83d182c677deb31e7157da9f32ceb5e27e4b6f7f5d7a973dc683e0cf2bc039833b3ebc5fab78dda62d6caea62eeb5df6cbc5e0f0b2584b6f6cccbc0c30ffe5a2ff0d64ff8b99fb6f370cc14fa6c4599138091d5e44fcf59793d696f9a0747c76c350f3d5d60afcb8576d0de01e84f8b4521996b5ed8ae62294da3a24efcfc4d6769682df49a697fc3b823b1dcfdd46645dcd7e4b6ffe111be7f1739e27bc27e1be11b11d70afa5c032efea8ae84aeeb7e4b9e4f77aeaf762186a5ba55dcf9a3fc7256f7d49fd3fc849e2a4f93d8d3c6ee313e5894590f2f92db07998f483f1f51881a2f8011507b276b718e5c1c2f60ecd1b25da1fc779fea7a2f61357f7d14abf1b7a003913878b7c5c9243b27bc1fa129eb3c0b5f0008f2d5260ca3148842fdb65f426e34873caef96b5ef8570d4b61a3fdb7334d582ed1be7b1387caece63a12bf648451e83f17f91c7d2a87d178fa55ca654e331d1fe5e1e037afc3c8f01dc7f9dc7547aff311e6b8ca603c163dde40e1ebb1cefe6b16ef2ab3cf6115defe231687f0f8f89f677f3d8e5f80b3c0670ff7d1eeb26ff0d3c26e79adb417dd83bf4ad7a7cafce45c6673c88f14ab9def5dacdeed2bda0fd3dfa97687fb70e06f45078e91e3d0ce0feebba984a6f0b0fff6bfa98889ded41fdd38693f354feff3bf80aeec6ceabbb798bc1f935fe22f767c7fbf47bd6e71e3e53fbdccd6b8c463fcd6f0cfebfcf73c63a7c39df0d0ff3740939d417837d526b7d923a1a877995b75cc69b5d780fa3e47cee4875546e27a8b9b38bc28605e64cbc295d2039558cf767e8186bcbeff4dd47768e4277037e83e430bf25dd0778cbe24593d63e0e07fba86dfd7eca63b4661f96f1d7b137f85fa8733e6c37e85810bb1c64b107316fd19ec4fa76e79ff69850969f9cfa76a64aae7295c6525c03a139f3456c498e3414fe70dabf2c8fbb86fa565b8d6f0b3afdf3a833abf9d3e7db60e15868e15c82c3a0319acaef7af99a539ff7cc039f4fc118cef2b8bb15cee536dbdb7179dabc4e1f657e113179e00fa474f97bd2a0b9a2079fab5a71bd7a6dff60f9f431fd88b6a3f5108e76182cdf7e05382c6f3f0e0b622c21167301f11b01c432719983cc17d60eeee86eaa1c7d466b02001d80af61cf269e714ea8b86e2acd81e172d73c18ad4ae742728ab79498679a57aa961c9e3294d6721e7243b613b90cb602e9afe045fbb16f4563a36d400fcf7125630bdce6d6f9dbf01f79d5db8a7583b8fa8b0ceb9b3f695efa5d781fff1f6c7ceb773126dc5526a9afd5cda379b2483d19944ea45e6882dc71d15a23701f7c752e2afda9dc84f7cedb476cbe790dcadbfbc542f7db0b9cdb870f7dbe4afd1b74be8be01d748617c4576b8cbdb08e7d2919db983716af3f5ce4b9e234da905cf8b47683469b82dcf874ac4a70c3f939d2d61962484607b8ab96d7f1d9f2ce80ae51d8d8558347df25a83215de98fec3df2c6caac0c5da02af2174698bda15187dc5f815e0c2bd7e08efb307fb556fb58f4c79ceeb69d0b754a0db405c1bb9a3c0e0836ebcea0d4e10bf10dd3587271a5360890da4354050dee2b18108af748cf78a857ca5d57580dc223b1416c2535a5fea1b70f7f1e159a7ab3a27913b5f91b3743c5e5ba2042f3a46bf0c961457aaf06d495ca989dff2f0047927307b4d87795bc1fbbb6ef06e5bb7e43077969e6a6f18f55f2ced24789caf703e81fba3197b7304f5e0ae2bcb1a569543467bb2de63651da96d43e5829d6f0c9861d17e90f623e58f025c51de1879c65838afe178e2724885cbe5878577ef9145e61a3399a1cafcdc4f56411e217467f581de68fc5af91c168c27dfec6b5ecadf82fe496d7c8d16e3ed8b6dddc9de5ce639a1547b81becb67f209d3999431f29a77d76238f979adf233cd4fc0e445e13e823753d1b6da1e226d37e6fed1e019f254c7ab82dc05b975b09cdf1a1db1b3d9c0af4cf652780378834ef853dd33ac6e3b95f716b96aac5fb9fc85ef071b8f1aeb4865b465af92b16cbc5ca5ce0ea52fdc5712ffbeb5761fc4405ccb6d30d477266050bbae6187c36a046eca61a1b6b10a4fb565551ddb987799dd64d243d8b2e538d72bcda3cc861d794534ab341fab8d6adab69ceea4cfe848ec54dca6837bebb0f149c650657fdef7d0f82c1d1f6d23ec3bb8f727e3b7059e763c2cb4e8a4d5dbaa74236ff46578725d340c86f5bb3a2e795f369caafe08e64705ffa68fd38cf4436230297e41caed6c95df583e6abb6dc9ecec897d1d589d577ddef1617e5c16cf9bd9bcdffc827516e33fd9c79f948c3fa964bbc11ae4e7a5cab75c4f30cec74e8ad960ec8c43cede42d8c6d94af534e44c45c628d44bad3051db86c245cf4d0b6cb4adcd4685fdbb3ccc9d9537dfc7a87dce6154816dd48dace41f20720ad551e91ce03e687c7d090790c3e79e7994e9a7dceeb2f05b25dd4db3632dbca6da6824a6c8f4c33078089fa9fd8b7c30dabcc8de65f60a6a575a7c303a7e65368f028feb5caa4fb4b2eec6f114ba6015b8dc2eb0ad63b1eec67c04783b09660559a5d857b83f8fe15a555e19edc9da9abe17c5b62ce02503eebe60af68fb15b509149c517ee9a4c67876bf1e8eaf455e29b0859cc179fa1e996559732a5bc6767bb6506e996b00ef8296de8cfb0d2bce83f36a010f94f1bd580b9bdd62da4a20b3dd55f7a1c856c2f430651cccfe4661519bb8c81e2cdc63aacd5bbcbf984d8fee2d15a6217f0dfccae534b5e7ad3a804653f47cd7f12c93d5124c9b9f53b3ef2d72d8c0b75c5e4bb02dbc6bac2be2eb317c0b388f17d42c0d6ecb3a8df9a270ddddd7df2f43dc2bb50d8f7638a81d8dc362b6ac1dde1fbf677677ff5577cd84265f74df1c3e7ff59d33c4fafea17b6777f73577cfee8edbc53dab5d9c15dac505ebf14577d06924ecdaafb88756c75f58c7bf948c7fa96483913d819cb185fa2b6a7b15fab0adb07ffb9db4bbb3e8b0142ea693da60636dadb63eac2bd735315a0b18f7dc0bdf77371d877ff87edac66f5a1d3d8baec1ecb4425ed3ed43c0d9d4e7987e63f299d6bf8a8dc4fc09b0c6a82e47c7c4f533133f54d7b3c0b3e9714c6f14df27c578de715f0d785a7438b18e983d31f2547ea8607794cb2adde631747c816b557965b4cfe5f2bed8eeb3f352351fa1611b92738cf14c01cec5b68118afd43ea824af54d85cce5878fa1e9985aff97fc33d36e5bd3f71974d78e037dd6703ac3f70a78dee2d0da6217f75fc2ac8697acf6cd501349a62e7bb8167c5bb6d80296ce422bbb7d447cad6b35c5e0bd8bfe38ebbd25b3eb66792fccd87dd16346c69f4bed5b80b976c806e9232dbb082cd79c7ddae1d9e6a17abf699396f61136bf4bed346c771ae348fb2fbea4e5a44b34af3b1de432377d8987d6ab193e05de239ae59ed79faad707cb48db04f2f476a9b0b3c17563cca7c1615daaa748b0efb8f58a65be7fdc2f3675f1118d6efdab8b95d9cd9ede266915d5cb41ef4fefcc97e7f3e29ba3f7f2c58076ad7eaf30e1bfbc8a335b42cf366f7d7d6fb7975fc8b7dfcc792f11fabd960dd043d638bf457d4f6a26b8ad9e845b0239beda7dbd0c577ebd8396b8589eab0142eaa935a60a36dadb6fee52874cd06466b0ea30a6c7e4fc3eea1aad9fdb02fd03b233a077827e724873de4e7dbdf358f92fb226ad759f98de91bb88f47b3d38a794db70f0167439f63f00c5f8d69ff56b091983fe172b4e8724ca729b18d187e98ae678367d3e398de28be97e069b1cd2d702d3a5cd5fb1266e797da1d1564956ef3183abec0b5aabc32da139cc312bbcfce4b06dca860af68fb15f5e1283897d80615e2722cf8e2f24a85cde58c85a7ef9159f89aa33e42d5ee2f925b05b67f999f509d07e7d5021e28e37b692d70fbc6b447417e913bec423b0dd1c39471b07b701c161647a2daa2c57b4cb9732ed95fd42644f79666ffeaeb64e047e952b09eccaf60d301749a62e7bb816789ac9661721b795e64f796fa4829bee5f25a826de15d635d0bfd45d4d6c7797cfc608f2b7fbcfa9d966273438e8dafb6b901c6efb4b9194e7fd2e69671fe6fb0b9613e5f6473438e97afb5b92167cb1fb2b9816e5f61739371bfc6e686f5f81a9b9bacc317dadceaf85f6e73c31a607aecefb0b901f6efb6b901267ade52b8967316857d97cd0debfae76d6ed8177fd4e6b6f1db17dadcf518b94361f0103efb799b9bacf16fb4b909bc3f607303dc3f6073db78e7b7d8dc80b3691728f656012f1970a382bda2edd73f637317c8abdf6973c39aff37d8dc94f7fe84cd0df2eb77d9dc00ebf7dbdcf8de526122716f1a7e942e05eb496d50ab0ef085363781f9876cee02defd776d6ea2233fbcad78eefce823f69e9cd181e4bed77980b5cdeb0bf4c0b67b785b754eb4de808e533e16cf1709791817cf101b6ce06f8d6f9f269e886f27f3843706f7cc15dadf375ffae6b86cce767f45e28d7afc1d3ce04c629f481d0231078283c2a73d4769cf6a3bd0dfbed1fa10363c21ee84e4d3177441720a578c6b80feb9cf1af840cc81e060a31bb4a7f51ef81c8ef9df563c452e6446172497a8fdfd833fed67be466bd83bf7d01adadf49eb7a5c89d6ce19ea70e775672efb65ed19e4d48752ef53fdfe6da9e72d869ad3e1c3b7e1627e835a33446e8681333a3c69b9409f73f954a15d29cc1e32efc55cabc16bc03163b6ae6e1ad7de4d1c9173cf327773cc5a799bbf270d37f1a0a6059c1df3ed8aad5501ddcdfd4de8f9f9ba71d5b130fea475c1ab8c59de06e426a9fd05fba1c6dea791ba8ab0cf6a09423fbdcfdb29ea820e84e53b35e214d9fb844ff0a7afa0c67f18a8f956497de5fd56e711a94d2a72b25a73b516c17d5b1e891fd18916a9f87d7b12bfeb343a527f53f87086f71caf50bf47c9659aff5638e71ad41b4d1b4abf8de011f65d19a3a3cc0feee9b72f35558f0639f1b278e3b581647a44877d3d0a9fbfe779499ba216782765b5afbebf6e205ff298c4c0f81d3f93c6a3b5715c744c692c5643a778dc59ebb65ab4c89a24d7e6fb8f5e311d7e4c5a9fc9a6f92ec316dff6df8747f0dd04ffbc4e4cbca5fabc8c66dfb17178de59b5f6f9d5efb90fc363701d860fe7b8b757facab5d5f9b9b068d5957abbbdb5c8e97a7bcf78adfded7b9ab715fb6774a0fb679b6ee3ee3e1b1de17dd6f3bbdfceb261a7f9e1b777dff3da54b394da47fcbd13af6394e7bebd251bf7333e5c1ac330af7b4a723c4f4f6ff0de6b75987d089c76695e0f302039f3599d548537890e3f3e2d798ee3fc4e4ee0c9fe96703946a7c4db43fee8efb4de7fdea6b67a7f598cf7c3456e5781ae901cdcb718ea29796f6f11c81e5a470bde72bf749f76f91ba5e6d99fd2fa496d560fa9758dbcc1db0f429b2c1bb6eb59709b9d8cbd47c7c5c6ccc76992bac8fddee0b83c3c727a0fb78fee0f63ff07efcb700c6f023fa07e685c237e5a32fe68db5cfbf97837cb78b56062ec59361fa68fa86362e7fba4511b4efbee6b8de97d7d7760cc334983698bf32556cb6975756a81a8155d0b26189cc7cceff136249fb0390eb539d97cf0b3f19dcc59ec8d2b02ef7d389d65521bd76f636d12791c6cdeef74dea23ef8b49919343aaca97e21dae1679ccbe8902ee10e86ed75744c97c1266d5fba735afff1314370c9dfd41e93d49f36b3574fd18b2f7ea7e5997784a48df7d276e0fb77a2ff879c16f09ba7db5ac36384ac2332f63142e9b63aa8361ecc1bf2e4408de8c8db9f91fb8974d576b261bb91be4ea0ce229579aca6a63ebf837b1d4e9b1fc3835b97c7653a3d36fe72838e2fce1f13c6070a83d5c234da3ba9bf48c1577d7c09eb78edb69e738e17cfa93fc9cef16227b7cff37fdbeab6117c1fe95c9e4c7cedfd36b45f43e9c7eae04dad35f3d2b8ed5c08bddace45a2577e465be139a97f9469808fbd6a3b57ba1657696cb2d6b63ec986f6692b7dae948fcff87cdccd70daa7f80f50fc2dfd3e68bfbada2f5f7bdb1c87d380b71d6d9fd036abb673a3b8df74dc7d7c2ed7e1d4a77309ccb5b7c0596e503882dfad7823b018cfa37d9c34e88935f727752bdf07ed9cefa5f634effda36dfdaec3e9339dcf18c1ddda6f43fb35947e62dd2fb6358cdb8e43f9cc91e84675531b3c270da4bd8fd6503cb8ce703aa3739a4bb8103df286d3ac9f064456ace5f1a90fe0d9066783c211b8e3b0c20c8545fc1396f925501357ae5ba1cbc463cb2132f4d86a800cd56b1520edafb47d5d6bcff6b971279f74fba9dfcece496f07757d453d03812fd227a37dd65a1f8b6c3f364156f37656f947f07da4f83e19f8dace84a4d7276742d25b2b70389fdbe16d2cf0f83eb7c2ec66384c2ee32cfd40fe4a6b6ed9471995f1d9eb4469cfce397c6ca0c386cee988d201efd765fd766a3fc103f8be3db672394ff86d20d38f9d7338bcb673936980ca45c20b3e5d9b405e1bbbac3fb6aeb44f5debc3f8ff62c5ff4af1afa1f85bfa65b4df5aeb5720ef8f4d90f7bcad552612fc9f29fe63137fcb39017b21a07b33e8213c6087b7b1c013679e0d6637c361b273cfd6afedb80a1f60f298d06146e960d66b416538e18319e58339c607b83ceee6e705f041b040f9c0d22fa3fdd65a3f8a3f36c78393c65ba1538e368e559704bf26d379e53ef4ec4b6db2265ef4d3784bf5deb02eeba18c0ef6be21efdbd0fa0ad938b1c855d083c23ad57fddb36f9e8776b813f7ace8c016de013b20de52dd34ac9b7ab0b55f9ff5ab6bfd249ae0ebb0dcb8677fc3d601d587ad7de11e21efbbd6fad2f3c236df897bf66b123da636bdd83d832ccae726ebb9398fa0b208f4c3b07ee3eb543375241bbce5c6024fa28505e6870d26e30dbc1fec17a18b8e368e5507055a305d59eec36951c81b545f0eeb0ec61b36591687bc6f43eb2bf6cbd4227bf3fd42f566f71cf4109ad8e04edcb3a46f7e1b4e033b4db80c31f4e7d4a20b037fb87c5e0b8c3f7078cb8d059ecc1f38cc0f1b4c4e0bb49fc37c4bacbde5fec475f2bb96ffd0b5a2f72e0a3df0780c90a7f99880d75a87c7696281bb2984cbe962811d6685b073da607dabd4dc7648fe405ff09da9c383fd05f7e5605f00ddc8ff65df11e123c356009a91b1c97e5ceb7038cd10789b427805f6491c668530291f99fd2690e74ff2d76cedfe1db8df80b6646fe8fdd819643be3184da8bd48e68aed2d5bff50eebf33fa435dfe55f721b5fb995c88114cb94f8cfc5fd22ba8de62859fe32bceceadd50f4479869ed3e4ffd2d947f69ab5ef55ea5b37fb16db349c46f4bc4e3cc5b726d3c8d29ff2103db3f5fe94872c73a7fb49eccf6f7e217d7c893ec6d97d29e4212a0f3478625fe1703785704bec1e4e1b0b6c4a1bbc6fce3be27cdcda7d49748f39d21e93cfd5423b88d127906440d043e83329e61fa647e8fd39ffd8ec29bec7a83e41fe3f36f69875fe39beae4427d4f6003d98cc4d9205f29949fc055bfc8c86f88478e2b0b3568527749e5b217d2c70057d70d871bb1036f517a27d33761670bb0e796b92f49ef91900f61c3d03846d6639afc07e16fdd6483feb1d49b6cce909f72419e557d9bf27f18cd997d223efdb36fbd23d65ce979e43525bebb9c169426d5c7a6ee834b19d7919e5978b34c78b3c47b6a7acf0bbfd62f842ee58e710b78be740e964edcfce118977f0fd7b6c89b30b7c227a3fea7f1816d3ea2ad1ea8ad30aef4f7989fa00cdfe65e757d215e717e763c5b7c7ce781c3e3b13243a5d0a798afacfe85960d4c5c5fb527ea03e34b32fdb6b4f4534ba4934baa134da3e15f1d24de2a51bc24b38def46c92da5b6539a35120c922d5374764d1a584971c89971c8c97acf0bbfd62f8d2793f2cde77d639305ad9fae7fc24f9e26eefb7425a493242f1a9e5fc64e92bce24c64fc102e3a7a0889f5c899f5c9c9f82221ab9128d5c8446e8dc21bf783c517c6545fe351abb4c7d6c665f7a4f60f729e6f289f915735aa9be454aab02bfe4521963678e21f8aac0cf98d17e922d12d6913b73fb3c681fd91eb1fae1689c38f7e3e5b00d9b241d96d14db20bd4fe826e96b5dbd0b5a377f8743cd436b18ef1a18cd136c7a0bc66c583f61777db5babbf8ef1da4d996fcde0b54b911e1e6fe9bdb80e57f6ad15d3cb0a5fa257a1bd523007c663787f0a53d2ff8b7c798c5e92dda2faf418bdca784cb65d543f26e7b1cbb0646fcaf68b3286b4376de786a09b64c3a8be4d4e37db3c681fa1db6fad7e3f463757a19b29d36ec57c3693f9cc45f9ac56c86756f8129fdd8ae9659d03a317de3f87497c78bc8fe56d18b3fb844f7027fed6f7261e1fc8f88c8c2fc911153ea79b651e61bfd23c38ed2c7389db95e692d30f1bc3fedea5166cdfb89f13dee794fa3921f728f33b92ff57f37392b1e99ed5e014fb398be0897d6ac20cb34298f40c30fb4d204f72653f27694bf784da8ffa60ac673ba309d531c85c17264decba81dc7f67f4afe4e724f4a4ba05f9bfac9f301bc8023fa753353f27199bdd47c2ff0d9dc2d6f72af5ad9b7d99acaf17d388ea33e4ffa82e6197f1a2ffdae84f79c83277ba9faaf839095ebe449fea7e4e0283fa1a3578625fe170378570c5deba14d2c6029bd206ef9bf34e553f27692bedb1bbfc9c4013a62b90ff6372c77ac6cbfd7746ff4a7e4e4257aa2390ffdfe5e704dc2bf939c9dc2459102cd2f4ffb1f76ecda9eb4ae2f85799e275652fc0401256d53c0408c68410020183a7a676f9165be0dbf60530ff3adffd5f2d4bb6f10db3ceaef9cdd4390f24b6d5ad4baba56e754bea9a764e5c06912bd7e5257ac1a56a7cc5be4c782e9a834aca9686956557db39892c48ad99737339ac97a90c00db029101d9f572011e1957c4ce99c7abb473423ba89d133fdf61e74c7087795c32a6f2f5257228055b6e67a4342136042237b23429937927c22fd4c6982d371e53a5e5b35c75f9c9bc535a0769585d0742a7527c2a4752bc533c7ead4122bbc0ce99c5bb6de704fa503b277e2ea655313ee1256ae7cce1d7b17352f915f3f13d764e221352743a57f214b173125990b3e115e3127e2076ce3cee4d3b27d085da39f1f39d76ce047f98c7af926364dca56d7da57339a511b533125992a3d10d5ea236c66cb9312f9596cf72d5e5a7e4fdac7adc95d681d2aa0c3f1a77f5ec9c54aec475cdcf5125b8894ca2fc74a79d93ca24ca4ff7da3913fc611e9fd0a8b0ee91bcbfc3ce09f4a47b09f3b8d42650360f12d99fd8398156bf61e74ce571c8e751d3ce19e1a5d622f7da39235ad4b57346754ead0beeb473a6f0b502fc846e257d8748df513b6794dfbd76ce741ec37c1e84d74adb41f0ebd93909ec557d3b395e3b57e9e1b18d315b6e1d3be78df253f43a57f358691d288f15e393326bdb39a3fa523b671637a6d72d1e4baf5d7ec3ce99cae390cf233536cbe44642b7d41ae65e3b67448b7a764e027b45b7fc9c76a9e6336263cc965bc7ce79a3fc149f5daae9555a074aaf62fca8ccda76ce389614b12f26b1a532342bb12d123e4bdb16f3e5d7b073d6a9474cbb92ba48c35a75f91d3b67ea5e9f16becbf9b69df36c2576c7b3756577acb27342de74cc5e9773c3ce59515e324ef365f2a7ca32890cc8e3c1fd51616d3b27862563e21a8fd8604a653ba509d531e0799ba749b96e90c63fe4f0ebd939cf5662e784e7b47e42d74025e54774aa69e73c5b899d139e733a45196e98c2ede671e95cdfada611d567804685ba44c51c1fe36b397cc243257527e3a9969d13daf89ea2cf1d764e564e6c8dd7e525e3aab85c54596e32b6ce95b429299bd0a61837e29dba764e0c9b1a63f7d9395939b173c273d1bc532ae3d3f8871c7e3d3be7d94aec9cf07c979d13da5ecfce096d4bcd0577d839711944ae5c9797e80597aaf15556ee8d35a12f0d2bcbbe61e7045adedecf4965005e2fc373deb6508047c615b573e6f02aed9cd00e6ae7c4cf77d83913dc611e978ca97c7d891caa6de7247223a649de865026f34e845fa88d315b6e3ca64acb67b9eaf29379a7b40ed2b0ba0e844ea5f8548ea478a778fc5a834476613be7d9bad3ce09f4a1764efc5c4cab627cc24b618a97eeb77312f915f3f15d76ceb355dbce496441dca7f7d839635cad00f7a69d13e842ed9cf8f94e3b67823fcce3135e2aae3b914d29f8d2b99cd228b633c2bc999f8bce377889da18b3e5c6bc545a3ecb55979f92f7b3ea71575a074aab32fc68dcd5b47312b912d7353f4795e0263289f2d39d764e2a93283fdd6be74cf087797c42a3c2ba47f2fe1e3be7d94ad939b3b8d42650360f12d99fb273b2f2b58d92d2aa228fdd551e877c1e75ed9cac9cb27346edcae90215f5203875ed9c51bb53eb823bed9c297cad003fa15b49df21d277d4ce19e577b79d3395c7309f07e1b5d27610fc4b9a66956b94c4ce1895d5c9f1dab94a0f4f6c8c99726bd939abcb4fd1eb5ccd63a575a03c568c4fcaac6fe73c5b293b671637a6d72d1e4baf5d7ec3ce99cae390cf233536cbe44642b7d41ae66e3b27c6a969e78c60afe8969fd32ed57c466d8c9972d37cd6a9e4b3d2f2537c76a9a657691d28bd8af157a9987c75ec9cac7c6d5fa4efd9b159625b247c96b62de6cbaf63e7ac518f987625759186b5eaf23b76cef3fbfee544ee8af7717c82941db1602d0632a22bc5f72342dc8e9a77246ef5e40ec34c39f1982d286f872acb8bef832828d3ab2c93da1872782d7cb7f995ae51269fc93de854c667f0c8982cd553084d886cc7cf453a46293e4ae1f772f8c91c56aa1b48515fd2bb65f0737e3c96954fe894c8d5b2f512e5192ad3f173de1e5c8c2ba314ee30874be7ada09a46c4c68a9fa785342ac1273c44ecac39fcca7522194f89edb064ad45e94365387eced2e7bd928788ecce94978cabe27277a8b2dc646c55d2a6a46c3abe0a7123de49eb0ca572968c312aab3378c486f71a548f3122a3717d0b7485fdeb0dfe2177d164f113fe39cfaac718bd8f063fe76d7965e5133aa5e6a252fd00d78ddcb5889f73f7d214cbd52d179541d63a99f292f573995e50556e429fe2b2f95365d9556b4299c8a1d41a323f97839d8aca00b053111990b55315e011be21f73fe6f1e8bc93b739b2113da96d153f17db130a704f295cad00b74466592f54f6c4b0a5f3764c13725723911b599a94da2927a47dc48e902d371e53e5e5a31be5c7f34e691dd853751d089d4af1a91c49f14ec9f83da564d7495de5f0a88cafa615b13312795944ab627cca4be43ec91c7ec24bc5f3873548e457ccc757765a2ae38bcb2772294da7f74a9e22774c125950fb9ec904b75b804bc7dab99246c4c68a69d429a4d1b99297889d358f5f21c7c8b84bdb0b4be7f298469f291add7717259583b49ef349012f95978f6e949fc8fb55b772dc95d681d2aa0c3fe2a7b4ddaf58aec4b422775512b992a355a78a9fc89d95795cca4f972a7ea2365622938af8e95249a3d4dc34df16d0a8532eefd376c22a1b238da9945e7f5cd918637b1dd7ae92fdb17d1168756d5fa4b4aab0515ee5d12bc82399cfcb6db61ec54bf4a46b9b6d62b32ba9c78ae0a4d723ab6ee5ba2dbef3322afbae7b2f53f8dd02fc14dd8afb2ed2bd135b6b945fe1daa4340f89d289d85bf3791039b8ea56d3acde9d98112cb1634465cdf3771f16f76f48fa97de55992db7ce1d99d5e5a7e8f55ecd63a575a03cf6bead1a9b89fe5f65dba3f44aaf5bae6c8c945e37792cb576b9b62fc63c569a077f9547af208f646c96db6c29dd526b986b9b6d4cb71b7c56f36ecd08f66a4ecbad65caec9494cfe8de896cb9293e9b57f25969f9693eeb54d2abb40e31bd0af15b24166ccdfb377159a9bb30e3f7faf770c6e5913d4b05e5dfbe8fb34e3d62da95d94b4fb5ea12d1af280f1cef8ac66b86d85f10b3d6fdd83bba80635597c449fbf2f17cff3ef2e9983a7d30713f9f3e98681e7d1ff98f33de080476d3fdd8bf423c2d07e2e6caa86d28e6265026ef117d2e7e1c5ff80371e88399ea3bc68778fb6dc9fc84d8b89ed4e11e655341bb9546e2639d4e6fdb16c461edc2397189e91da33859104fb30f31cdcd1ddf76a4c9e171dd12e6903edb4c0da103b1e238ef8bdf0412fbec7df1fd60c7cf8fb35788e93b3f4aecf8321b4edf457e6ac826c4a9eb07dc2bc4d77a46b3e174247536a1c86f42eeb5672821e749102bac13c5b7caf60fa95b1c1f5ded2c0d75f219c7914de2c167f8c38ae094ce5497218e0f6bb48455fb02318d852f27903a537fc79fb263b1256c751c07b51c4f0977db9ccd38c2db2e4385efed77dba5a1a6637b2571ca48fee9b48d07b1cb64c6b814c5fa8aea11d301af9db234288cf549da4fe365127c1a07b738ae2ac189e3f766ca298cd95be77e5a5a17b85b03ea3aa174a0f1870be3c2129ace7dbacec8b69bcc0fe5783c891f6c65cb2b8e2b5c274e2ca1a32ffc465f48fceff587c4dfdd27a571583fbe0e21f1bd109a24b16333752a8cc54a60bc784d93edcb9238b229bc38e67aa6bce2f8e9f5fc48a4cf75ef37da02b2f277da72153f3e535e495b625c4fea28866cce7599d9d0bab664731cc88c7094cdd623cc0dc276badf6d4be7c4b63c1954e60131236fe4b1df6d078ec28e3d71eb1894b794c95497acb90973e407e4cf1a2de037351f57cf049903312331fdb0ec23f19bd3df47f6e57df4520f97dd842a231c212ebcc290587026f0ba9ea7673e0f32af9fa9fcead0b86cf3d14bcef69981817d1e9e90af7ba746dd91c49c0fc2769a2b17e7991b9f71d911edf939c5bb2813c313be1cf2fdbd646e8be484ca80aced871f686e0ae6ae0476108a7c5b972de389ea00dce5e53467a6a69099ff76399cc36314ebf2dd2b4a13592cc7aef3fd12d07b583c8fc9cc067494c36e3bed25757f677617b93d6732f33fff4a79f422f0f3a3642e4bea3337764c3f10d871a87e39368ebf6bf97b89391fe5bdbd9f8f0e2d61bf2e1bc317859fef85edfc92aa4ff8c1be76e6a375697d280e8dfd39638bd2741cd33795eff9e36bd77b374bfa14743cb39fa2e56b67f735451f199910b71bc33fe7e941bee7fb6660cef7bbcebc44c628cc38944c23103a09ed0453d8bf7fbd74ae69d7d6733817c79498795b0ecf4569b93ed999afdddd3e3f1e090d0f3bbe675dd59d59eedf2febd67c55468ba52e998a21eff3f510d87120309b30df1f737d7e191fdecdd792fe3893f8e831bcf9c1ee7a82f95ac6171ed99791ab03c8049883727dc2cc91309a1f7625ba2bf0b498ea8ff7cbe765f7f5792aeb0f0c5fd017e47bae1fdebfb8f37cf4ca94f4039298657a9c86104ff67d7f28a157bbad406ce75be33a869f7a3b7eee46f14b098e958dffba764aea46f4f256fb7d42dbe390d8b65c7bbe3a85f3afb5f7be5fb767c36e414cda883744d638e078f64caff5b17f39be0f07fa475c9765b8e37bb05e0841967f10d9988933fbb433c77b915142a9b30984619bd62592c39d812ec31a6d04716fa70761cb3dbe276d38c0bc46d747b0e690cccd53515a494c5b4ac750e0c7aeda518e32acf3200632ef3d92ffbec2f443e16acc7f3e45dfda511c6433c29f6d317e7119dba921339b642c984620b29b50dce6fa9611b77367679e0db513c53316f9b3a34c0e8f8a695c4488a99ced0bd308e2b4ad4ff00d4fdc2e7bf1ba2923a762dd7602b2a8d75243db8bd7dbe8f4f8614e0dc1ec87c296e8735b1283f8521413be46fc60fe15e246b7de863da4b05d87ea4902bbd94b9da94ed6f66d88052e9b67473237fd1d3f45b2e1b7c4edd21336adc70f736c829e8e696841ccf7cf470ebdd81c1ae8ca6419be4d968ec29e8db7d50b7a5b4dfbf2646aa8d9f5a035088555d46fd7e3a28d24b6bf173be3b6d4591ab3c900da9ef0dc76d0e526c0d7c6811bce7581e5da3bf313fc1396b06a23d9dce862d876246bee08ecfa71b7158e22bfec2bac6eecf8e593c01a17993debea97d3c271b1576d5be0c790b72f74a647653be88b7cdb9a4d96919f9259533e84d8e017915f1a1fe6989118e3f0cd6be18cd52eb3c9e049ea4c4f223b0ee48b0d6b5f07e80fcf14269bd78e1740078cebf73981f4cd93cc188fc297a3bf77e89c5574aef69a876626ee075de47b302716ad91b2bc1decb602da6da7697a607efa5ef5d08e5f5ae5347f3fbd8f0630efe098e12a8d3d8ef07ce1edb6838bf0e524cff9f90ae37193e56136ec913e18b4c4898f600ec57dc0f70e33be5a1f247d47ea383584e1a0250c4f81c28e21ee38e8bfa1c0826df9535358fd89be7fecc7277538b848ccd911862f7b81dd18efe6ba406e44f95ccb2dc7914cdd904d235d07d0015d6eb2c4fdf5d689fecf86b9f443c137bbe81bf023fccfd016cb395adfa4fc9720e26d221b434ccb5c7d3faaf2dab6de66a1bd873e56b6d3bdb06eebf264e048a6b2591f4efd9dd93f2a9339c881531483bab7973bef81c48f4fdcd03e361e1a8ee8aa96dff8f5ff351607adf1abd17868cc455385a77ffce3a1a1215f0fa49fb26d360f81e988ae68354d51d691a5baa1227afaafe611a9270f3240d6b70dff15d51791813f59515611c843c34317b5f1abdbea3f3e344c5b511bbf9876f7a9fbdc6df79ef0973f7d84119816f3f847bbf547fbf9abf5fcabddfdd5edfe7c7c7a6e33cf4fed8ed0786820ef4f05b98d5fbe1ba80f0d2fc4a58dd463e3d763afc5741f1a9c65377eb5dbed6efba9f3d0981bc83a347e310f8d775c6cfbf1b9df7968ac91d2f8d57a68b0e4fff6cf3f1d5169e1e7a502b9b51e1aab54a507c621dd868161cb07aff1ebf9a1f1e22313eab052e5c6aff6539fe9b4dbcc73f7a131f7e0cbd3e3539fe9f6bbbd7f3c34deab41e376fee3a131ac0fbafdf3cfc00a3c5569fcfaafd643eba1f5dfff800ed45517aa350262359aae6dfb4dd35602436d3c3438d3b15d7f21fa7ae3d7ed9e4e38c31491d578688c6c19d8e4a1f125ba9aeac7f96b76534279e4a56dfbf93abc8bbeac377efd57e367e3bf1f1a2b5f3454daabf865a98a9e6d357e35a40019ca7f70a3ff30916762a487066b8f91a17a800e75faa9d98d878673d054051eff9bb61003dc6c5f53b62dcfc6954ac19aa27b90445ff59a90af5b990820c8d29aa66a426346aa834b96826f041593425f8551201b760015b43543c565687653b64d27f0d5a6a9faa222fa62091812cdb214cb575d4b349ab6e323db128d5b7047d5f5906d95803981e4055265625374d0b15d0d1297a620cf779114f8b78a4c503c5957814980e6b2e9c05fdb745cd5f39adf86e8abe90fda055d015c0c842b6f5bbe081ddc3490e7930fea193fb9a1e3dbf143538cba067f6dcac8817113bf2be944c51393175556f4abb7ab4485e9f5dafdd407c3408e8fe4e4cb3772bc76b7957cd00fca77eacd1453c0ba735093b7985092ed224b2b4d684a12aa48f50a13612cf8a2e5e3f9389fac5abe6b3b61f3d8fed9fad92a00c8b52b9b724df0a2d4a6269b55100612ab72909066da4a0580acabf2a1225d7125ad22f9bae78b923db12a3dcb1b051027d155bc7bc09adf4835aada7ccd5df9e42b76cb259b46759b4ce3a0567599853c5fad2a2002687e23d1af80722b2be1e922d37bac06e85427f7da4c154020f9865a01e01b5e6506905e51035994f58aec15d5f19a204a6c5751dd1b70b213dc80d06c4595820a46c75025d30001d123015f926a5b4658908a4cc728f8ec8a561103c3e7c04745185ee85d23994a2ff572cdb31916bd4674e56eea258de6e962fbeaed8ac5ae392acb40597ef18dd4b4e51b5e8e605700e75e2b35fae1ade91cd0b9f1d0003541123db5e9fd653415171d5537fb95e6dc7868a8966c2b9198a08f4dd1b3dae977c8adc364bf3c76afbe204b74c3f417d93ba65f75f59c7edd83f696792faad775027efb3644cdab06b11dff06c409b96a0e0272277ac075c2f1aaf58e6aa65fcf26aeb0ebda2e2ef4ec1c452039d413fe99feb576b844b22ebaca9b653b4dc3d6b488fa2500b12ada3cb6eb413525513ea896e23545f32fe75e1c25b444d356a47bf15431a705df4642dfa2acde8b64aa269d0aefc3b32dcdbe17c90a0ce35e1c575590773f9217187e5d2cd73ea8ee7d1d4c50eeea278ae3bade9d289aecc44b857bf0eee208827317bd098ef7575d0cd9364ddbaa0d6c7da39aa3b9898d0477754884710f8d228c7b2864d875ebefaabe1bd684f545ef50b706be2bcab5e7c42648ff4ccee2c96b8a27ef0f4f39fca1d9f0782b1d7eaaebd60123da46359c6c20b09ed5034b2fee6fc0dbaeaa8b9662a8ae771bd85515d5f29168dc05db5465c6b50d557655e54e444b716c64f9f7633aae2dab9e773fa2e7d746baddb9b267de8451d46f3130fcdb05aa3253bb5b29e16ee7eaaa7f05aa779bb13cd523469c1b7048032bc8b15b09192b4ec842f5003de580ecdaa026581aeb0213d5bc1e70e0d6adb02ebaaa52ab77131c1f0c257581434b6e7ac8d20cf5db409a5edd878e8b8ea2af361dd7f66dd936ee024e74d99b735521aaebc8f721fd15a86ef81b28d1dffb2be9de1a02398cb36934cfa671b3284f758f48564bb4e01bc0f183e847364df5f7d00be47b096a4e8b298783df1d19fb5e5d38f815642c89d64544d8840c8b1c37f0fe70030bec057f7cdbae29fa7e56eb9154dbb59e9a8eea1ebce65f8168f9286b74975c51f946fea5a9d9741550b21c9055d747dfa8a9d9e4299bec8183ab793e838da27964ae9315cd0dbd0360ffe1aa96a25e8e769021887a140d47b7252492e6fde9a9564e1ffaf62cdb47df61fc709daca97e84d574c5a36afd915d9fe0f21554a8c05da7c5134d2d20d0533db0a9593eb1d1d4c001485fd4ea013bb66dd48484515d0f343f7c3390c7e71bc9715e75e16ab5faf87c47c38fcff7b43d0d9d977c15c065b4c27288fe3f766b8094335f216053b3696deae3c0df02e6f77c513e34f1df6c9a147c7f8b86ddd4553733f435db102dada9b976e0e099a169b841210826bf147c17f74306c40f1dd5ab03d314adb0169c12b822f17bdd068699d3f345d32984f62cd17172a546ce5442dc9835aaa10a3b1b3c80c099b2e93465d3b9919c30a182bebf6b0353b35e4de8c0928b88578a70148d20c729183a08905294203ac86b6ae2190463564268aee8e87f6836f803bde8ed2f233f7f97408145c576bd9ac071138a4c11b7b1d4b32aff0e4ed3012398fb5ba8aeead9c651940cf5b7d03dd550655f55ee452e50446f23813fd914efc53a8a06528a866f05a66b7b8e2adf8193b3c5944282cd24436b905c48b65d07b8ede48a4e59b266ff6106868f30539601e9b25191847fa2e7df027144d753dd9b502e26f32d304f162dab0698efcab675bc05e6db07d5aa028205558d0660b01a55c3700585ee4dd573445f87498c3e5f431c54c353431d1691f649759baa752cb23b1e0c31f01cdbf39bf1f6876f4fbd09a307dfdfad9b504502a700ece2f94a2da06460452a79e3a170638e66ff5160ef2bd9b7231aaaebd7830c14540fb290d0776c1b2ac916fe48b6e82af5a0a55a607bbb1e9ca9fa2e92ebd1d455ddc0ca4d4ba5c0b905510924ac3bb3c3c51025acf9355559b773aa6a9caa61a1d8946dc376ab4172d536450dc9b6252217743807168aaa57b993ab32b14017cd42c45c6e8a8e5767cf581d98a66a4aaa520b1276a0d581f37cc5ced5cff72d989430a5f3a23d4e479ee8fb996e8744df71c1181069ac7f52fdf64ff5ecab16182bbda623e5572d26f2655d350c1d93cc7703d90fb254b61d584463a741fa39a78e95c33523a76f3dd81c1f39aa61a8203f813cbe6d669ae01cb44285cf716d53f57535a08e803f13dd9f24dc8d1073d8fd9800a3fbbe730b1336e81a4dcd2e878b869b7a7672aeef3c543c2224e44b817c50fd9fb6ab354fa7a6668b816f5baa762b0b5ca15220f02d7c97533e4a4eaa7107689e595d5bfa465a53766dab79ec5ca779c80d1c4fb588b92893e87cb73b4df15b7533644d25e4076f9428e7f42ef2dd96dc8c5a1b25ec4fa2e1abee49157d5d754d31a38044400ed9bd904b3822273b0f7abeab8aca490c0b1cd25e20d91ef2eda666fb6a560b3b8a46281a22deb6040b7ad5cd9b4f28ccb7e8f9be6a3a648b670ae2ac684d4f76c50c75f0676ca2775c15d7cafe89f70028126631fcfc47b441a62945db516e81e03fb2ada8725de068dbad5717dc3dd58504b3401d58c74526f2d1f116b07a241ecb0a18cafa37c0e29d16b7609af5a8835f9ac063b26dc9aa6bd586775cf5bb16f0c945be5a2f77620dac8048b65057e573c6fd73ae074518cfbdd58b67d29e78df577de0a6a828a0b4df8914f8fa7d18b26d59b0e9dbd2eec353544f76619bb46ddd896879f721e034bcb3ee77f16a327606df963de74e0c474d6c88f5d152eee1fa48beedd886ad85f76151e35a7d0cd89967aa9e276a84d941559355cb0bbc9fc82ef8144be4aab4a62f6a74c75e115cb40822ffa8d7be12c8716d259055b708d031020d594d5bd68833350b0006b2c0958b5a08f66edf2b4da86c6d0401c7aa8a52e3992bf399da8e0a132acb8b2060f1246a293e2c02c27fa17ea9a27cd55081a62180d9be6a54243541ec7a5500f98a96c3342551d3ae38ac0256336ca966b6b8954dcbb69d2a704394aadb0a6f48be0dd1b4025352dd1a80aeaac1519bb00ab4b01ff360197ec9030456644db19b4435b59bf89456ea117b207d574444e7682ab61c3fe0b501d5465226373b6df2b313e3195e35e199e8dc241ba245196f4c2f4b6ac2d24226e6a102987826ce2739d241f966b289960a562fbc79b829fb67ba8eca82c077fc470b7caf0ca030739cd0d419b922d1217eb26c32522cb1f07bccb5e05c525d62fdc88225fd9d4a80c5999eab69f415a8ab937d6a251091a3a63231a91e9808607489b26c07965f8245c14b92f727af34259725f64eaaae8b9d8885899e6a8a8e6ebb6a3e35bd44b43cf15bd55551c9530200030b9db3df310f79aa1cb86a53420a72833ca1308cef8a96079b290a53030bc18c89b3a804b0680ed0233f5370a283e296dc046822d3515dcfb6e8045f08ec83ba62bba50076a2de9527c765de80f602074e7936a520e1c422384c470c19cf08d560b150af068b49a3c86221b8a65ad80c96f6374216a265d9be98289075f09068921302b58a21872aefc0701d196b2641fd3ac112b5a99e1db71a237675e3536a7f9aa277284628233bf44733de7455523f8081ddf6f6f7771580215a54a7ab84c047606a80019221d506c4d87f1ad29fc7767d9c44abaa0677edc0525c5b42c5632602c6e7780c3bc2fd337eadac50a29a95245b16f83d8fc80f2ba0927dbdb5809aa2e1df01190fc7df40c1020d5f63f01bb8b0defd9d3241807aba485cf0bf8f4d37ebfd4e2e6448034f6ab2532f879468bf098b257039e8d582ad1222d9c2539c130013bb7d31006df26d88dbb3480a321aa8862d2a75c0e960ab038bcda53500651d5cd1c6a50e68d23335a0afdcce37606b931fe3d18d6e35404117ab094acdf5374061656ea4cd4635c11dd1f37cddb5034daf8146c6646d025649de6bc0d09345a34e436345a51cf6a0aa8e6850db712148fa90433184a356f129a55f39444d52255693d2e42a02fa62f1f4162b27d7330d39255a1b9e1c37ad808ffb05ec9cdfe68ddcafa0c969d77ae07ee9e8ca43c76d50d4efa368dc8b45ac789eeadf8b492d55f7a0a8e7dac5c4ced81ac0dfc850a14bee008f5d3335c0e9a6c71a596baa85949ab0e4147d1d507a5f401d58c7153553ac095cbfdf3dbf7677a47d2ba5d0e5ca400ce2aadfb0c7302ae176ff5e819397bb3052d6b77294e8804494477ca6a22efccd1e27bb8e891bc5761da90efcc1b24f16ec69be079c6e6dbe0747351dffae42f07211568bf720c5fba8099273d07e22d886807e1edbe92fa1681a3f8f60e122fbd1e05f53044b42877e6dcaae9cbc7c63f7b6eee3fd1ff0af99f257c70c2c4ae8ead513adf4bb84bc88b5922f603a95bfb5ab6fa1af8a46ee131dcaf1475917655d7c26537ef2d93eaaaea8a94dd797ede3558a13a45f61f2830d8806ba6ec4b7e97b91e6107fd26cd195f5eb2ff40a91ec27effa9b7a76541799aa95c9d0cec0c12d62d75f92d920fe688897d05535f5eca4bf9a19325baa4fcd9af137dba33b60e34fb0db8058d8926fb6615cbdbb3690c95565dbbda272367f322364694947b1e8db26928b52646a86cca5a867e4ebb67d284ad30af3d2646c442f4a227bd20abefb7ad177c771edefd897914b86cbf78a3f8382da3490159cd300602975917df5e9fabc64f23dded29bfe048731b3c425eb83ab773f3a3e98fa865566bc735dcde44a2a4becb4e9efe4e6b0f813e41a2d71924f89e3ebc8a4133266e1a8d176b4f708d94d6493b50a8a764147d9824d86e6d0786890de229d03ff9ad17d5ee4d1a7a9f4dc50fc1c1d7733a3ab7be05f136fff76443ca0f187bf02db5715bc0f9becf4b3b030a7ce86d423fe43c7d2d547d288f85baaf2b96f4dd193112a4ca12e91e294f84c4471b2f77d246996ea235a6fd0dea98e0069810b1c8ca71bdba30726ece87834aeafed3583c80945b663c3bf78766c3c34c8d8c64f64ee892621e0385f0446270320796aca9a9d7aa393257d4fb6e5d22f78c0a5de29d53d03c9d87543e6e46478244794c940807fc94c43d8bbf1d0008aa4bc6bf8d51725bc2d26f99088b3abf7c845075051fb886721796a06fe77fbf1fa1d8eea0516fa0b1fca8906043c44db058eaaa5d86ef3ca6941fc6f9148635af5a01cdb08db9d56ef0634ce1a76a1d585a377245500c77c486fa4ab037ba3bec0ac8ae53515cb4bb6489401669d8d37e11cd73e8737001337631914f136162583cb2bba7aac28b5c4f1550a9af67f950265dc6037e18837eca48a07b8abf34bf5fcf83651b80c28fa14df1f1a7d7ac707ede03ed53b2e4d7d179145af342dbc8695b5df6d25f3b989b7dec01061ed0d517e7e35da3fdbdd06dcea0ad3527267ef1d57b792db7aff115d1f46dbf5375d021c9daff8092a71f57dc029387a2970a7ffd8a697027799d6efdd06fc2d1ade8deb803bed767c1d709b5e07dce9b49f9fefba0e38aaee6f5f07fcf8dc7a645a8f9dff3dd7013715d531ec307b2b70c209717afedadfe472dfe442df88b1c87dbea45fae2ff44ddfdf1b4167465b747f6e3218ff75c6677a20c543b5b1188e5fbf5af335bf198c39566f49fce9c7707fb615b6ed71ec59974cb9cf999b80db77df16d1cde23f86e845e3862fcf12bf69ed5683bdc88e4388a2b0584d379bd79dff31e4b230c18e6f1b8bd59411f94d07dfac3ee434886ea3b09b4019b510b9a1be2fb01b46e4e7068d50361bc2cde3464be4c7de6c3bdfeff8b3b1f87a0dde536528acd192d8f58f2f7ebc17212af176dae35e97bacc68da6c351828fcd25186837789919f679d281ac142b3dfb8e18bc68dce38aa2137991ad2a885e4ceb227b19b8bc86e0c65c869e2646a08fb169a75e6addd76e9ecc2fe5eeebc1f4b6e4147dca8fb46eb15e5b5a6f5c43f887ec04dbc37fa4e7f38828929785247f6d591ad45910ff3700aa33b0abbf6a36886eb476e92444ef838d957b0dc70d0811bf1675b1cd9ef9163af22623ca5eb857f6c5b5787717401edfdf27292275a215c36e229377ab982fbfe8ceab218f649e4a7eedb62d8c7d115169afdbc33fb077535d8434445f9d242112f8c21c24408bc46fac67effea3eef56035b3637e662c51db97d6fbee3e77614dde0a02dd9f14564a7c7dd767a580cfbfa627f3a8aa3d71f438d94cf2e1d050d481d5a288970606b02442c18f951e440d4761476e3cb175bdb99e38bc043b48bf5531285b19d8a423980e8297b8838206c971d81df041f61cc8fa45c2d5c1cc66d81355adcebf9285ca05e5c5c2fd2be83b8dd5de1e1ef13ff89834865a66270c3debbb89db7d69da5aeb09b0b3769f773f080337c013ac5ed2c88d0ad41a4bf8f30c5d397fe4561c72de1abfda4862f01e4cded7bf133a667babef8377852c38121999fdaf7b695e6cfe719b37494b8ef4e47813598789c419b58c3e4d8de5119be044bbe87233f08c341fffb3347136dc79c75b9f3dee7ccb1276ca72d8e8dfe5f45c55a713f36ac6e702ca1337b3e0ae1c091c3c141dcce49fe270de6266e74de4bccd25898112c4436953a536331ec47dfd1e028a1414b64d7785e124cc3e226cb23cc0b3b1c55264a93cd0d44c2bcccf671fba0ed9adaf134813f5f8455a63dd0eee1d214f93344f8d03ef62d6d76101c6902d117726dc791e93876eeedb6f3cb6235c5ef33de082498d7569c46e6943e67168dc15706c62b8706beb05dda12f3d9e74c05cf633b7e8ea395c218da6d370771fb8968dd53bf6791ef9d94eda7a64c8c93f0d54251d41e2ed51f388a1f445d0d94c9f4280d07be8c5a2187e2395edf75964719711a8cb31db3b1a308725c9f4bda8dd4d5208653ccb1a7f0eb3e6701ad7a2ca155f0c9431f71c067857595998d27f0f3569e5e38024a2831270d22f2be234e93ccb10feda17488e798ec6f743eca93a52331dda45e88fbf1c99f3d8e1def71441cebfd79c6f44ff8b92c9feb313cd87520cf5ed5f825f306c9170d981d7f6e439d81b76668d0ff5e7198cf447ea709db576d865e0e826978107d6776207503b8eddc90d8cd1e47adc27cd93b40b90b4cdf2ed0b35efd5988fc94e15142fb59879431cae32f582390279b163759f66416fa15471542f7f493b2dd38c2b080870c90eb9eb6d96ca6cb6139ef44e99866f9f9757446ca16c64c37e673b9b341126fb438949249d632e0d8694b42eda3b25d829e60a4a204d23aff58923e580cfb34dfe2391de6bfb88ed3a5c26f4215e68bafb39ea20d1d4fb1cc8bc7eb61ee09fc38c4f3b255dd3f6999299a7d2487dc8f15fcbf9c8ebbafab3e7b9e455135b3b289449449e841a2e0148e45127526dfee385dfbb1610d5ff83a1d1556ab8423e31e7435bba02c80d31693a5bda03cbfbf9de706f484cb6db825e88aa3db706b737351f8736b31ecb7c4a23100dfd98d2e24fa60f203da82fe73dd0f6573c718c688c857cb7e65c2fd2849a3f43ae0f1cf9e8f3b66ece1be58bd049f9da901519d85d7718bc8fc9650d09ed4ef59613f7fc03c14cd39fdf0f7f0c85c85f9215e5314fe70dd4bf820f5bbd21315e8efcefb11ca53b6534fde6e0c18b3b3431f22e23a9239f6d273c4829d1f05764dea5700f3753aee98fe41f8ea3ecf98d7cafa5e8d77b6077df8216c95cd666dbc72c3de02474ae67bba34de6c566bac473d635d0209e16e3b0d3fc2ba6de9194a3bce032df644c7817e4e9719f54f94b6c7e39fe86abd325daef04765cfec80a3029e12da609dedc762328708b5dec28cd2b911cc6b7a28f0bb3e87fa3862bb3c296a4726bf1547fa215b0ea97b87944375b0eab930f98d6af29a15cfd563d015317fc33aeb0bd30ba2275ac2eae520329bde6cf8720019c48dec7e1a1eea3f657d239adf6d2cb7a7134197701d9d34dd9f679d38efd4b71b3c3f3ab78411c67104f412ac3b9bfd8ed95ce410ca3bc3fa2448c994288adb6a40fe27d15cb97d6fb1ba9c70747c694fea30991bd086c504a2b57753badf541758c5e0d8e87f1c9d1464e0613cfd7addbcc27a0fca5ea097e768bc409e2fc1723b0d892ef73c6392b116eb2655f307f45be9fc36b8aa7349dfe2f911e6118e9d1fa5a85d7d6ebf4beb2424ea6414ed93dbf73ea410a20d4edb92f5aec9268e887810f8cfa86ff635ea5432ae60ae2c98ff215fbc6689d74ce4bb648e196e326fa960fb584d616d7392d871ef030d4c89dd743f9828fafb8c375a02dfbe7ca00182c8d878cdf0e5fbbbedd48228803bb3df52d8beff815ece730baf05abf4146eb79d7fcae686a9a2eb2eb586c7fa507a7dc9446bad4587e866dbe945198fdbd83e43c648bc163292efdcbef73c35cfad8f70c0ae36d3afcd28a7b324bf514e77ba14e84e4de0b59901fd29247ad7d67f2ad4c756dc8fa87cc153469eb61e4fc79b7d711dd23c9ca3d128370ea3fa4d48f4dceda625f09f68610c7481596ba48e10f9f143e1c1b640c64b07f36b555f8d94edf442c657059de2b958c736b1511f8fcd2f73d3add92f1fcb8dbee68682a58c3c53e87c3edd6f23e8e9b18de07a8d01f6211c2d11ec433386d822b5fffccfbf2fa260f35b4446e0aa5e0d7fc235287529f4dacf4c4d97c2e3af6ee767e7b1dbedb5dafddedd2e85dedfe15288aa5be252683f16fb14baad0ef529746e8418bc02252d2df1299480fedba7f07fd9a7703d48fea5dc0aec8e373c050248e36565044b97d00b16ccc71ea80dde62358dccb2dbb92df09b565a3cc9d8ac6bec67ccfc22877d2431fd96dc5982b93e9078239899f09dd3629560742626deb4281a9c6473ada979f33f0ed42f98e7a312b6bb1f68a02bdbe5b1c84d10051d6f83b8da0b5f36765310b741defc3fc1ee035fe94c75195c152488310ebe3d2a706df0e32ea5fb077a69bd8f0627f50a6e4045e733b859b09a138980c8ddb43f63b19012a3d814bfe3a14fb8d81cb160b5f3629f1673cf687168eb3b46c76e05753578dd41c06cb3afcbe6275ec6814be2fd2b71d9c032ec9f50b5fe52b6605e147ac4b41505c3c6eae6dc98f15343992886c0779f32e6c767700d2ccdb1234d36a1b005f3a21666d5c0b4b939f90dd24b92e08b352eca18bbaa0c392c5f0e2dd8d7c4946c0d74815d3a9229fb78693af97ce4463af4114ad1b219f1eaba9f3247fe889fbfee538f16c37edaf511bb0ee8bb60f64329a55a46ef6d47c241c8d728d54755eaad06f384c8f60f8bd5d412b69f2836e162f554d045fedc96cdcd25d71fffbc19bcb5dbcedd2dd3d3251eea3bf076fcdc10d9be0781a717ab29513b396d671d34bcc40fa3f98ccb9a7eb1c9d3ffc069d845d37dfb67cde0123f6eed18add094277506d855c0c17275b281b6182ad06dc8fd586e757dd7f9ac341f1799b0613e114ca3cba141bc64c526b916d06703cb0c53dc6adaf7b60dae3763d7591a425a3dfcea5198b2b2cb4cd27f475f1ab2353dca463f9e77e4b0c8343d3776dbe95e1c0e22f8bc6b638cbf1fe200ed97a2fe94d83112f853aa7eed70f6c5218e158ef2be8562933f2f8412d3428b03feff3c63cefa0ee44dba4f476747b2066d653868a9db01b4e500738382cd7f6d4f62e6ba548f168e30ca97cdb1d07f6bc0833aa014cfe0a5c5d29a1e25b2a41aa2348f44754df12c2dc7943a1ce4475c45ed9614829beff97fb67ddbe5313d96a530d7976b292c6ad7b4ad4c9660daa4f5b928fcd407f37daaad4861bbdace5a0633bedf56583ca7f9f264ea483cb835499df67469ca3dcf18926f86576a99f947a7239e3f633987c76047dc2e6d3047828ea09886a164e5c5e87c2a378f14cff512bb09b8616f424c7db07cd57010ffede0c2b19867c8321774ae5e20f07889a80920fbd8cd819be0b9ee52b1d45dcbd626d831e316c17dc6a6abb4dbc61c1f60394dfefb0a330e25135ce1dc0f19f8010d3c916fc39610d80eb117d9cd61361c04607a953ab226b0fd0e7505537e885cc17343580d74893d69425a5693e57985ac3b65653971a5247c6945a69f22ba82ab836c9bb8fa4e7f90bec032c2abe132d984b2d90fcbdc16a4df5be2a837923a9ba08e2b64691aa1dcf9d4d6ccc6a8e38af962c7176538f8826d1335eaf1b5e3db27d892530396b859bb6574c0df092d73f92c264b6a7eb8fa4e7fd0bfb2390e80b7a8c9ba52c79a2cc3b2bc689d85d1f5f69645b4cdc858ac85c16a7ddeac0f6b13eb06ed7e248bb6e056de84d284986586bd35e8195fe0de26f36c91ce40b7c6fc5dae1690e78bb44c33e68ec0f47405c604d4e3eb74c43075f262da784ca5c6c2b579cc5c0742d8a5f3862e6d079eb08ef898b4ed22f1634f2ae30d524e7d93fa4bf0c9f4db9275b7293e9237e3a523f1c02382235bf3161e1728ef26299f5b715ea1c09f616dc8089bb2fc4e4742bb1f549fbec70d27b17dd84eb71641af1cf6de77dbbfb3aed0974b3cc6337d4475fd9a74ad6c4b7e5c52fed8805b6c89f52bbc056cdf2d75a9cd50810b10e55d7e94c665f37cf657b72faaf2b8967d9ff1b6a935de86146de5d8591b58f704f1760b93ea2069bd07b6310c40ef48cb434d60c7adddaa8dd72a331eaf5562fd2633af5058a2dbb62fb00ede6d976dd98c718ae78204771fc914b2ae39cc8f0adfc36e670eb65f99734fe4e7ee62358ddc2e87787d16e91343bd25b2c6e56dc5fda0ed57581db67b45ae457013105afc4d2e41e0d1d239bc920f70bed3a330395ce50d3c81b7557c159bc009df5f769da9234faeeb350b9f35d866c1b1c4bdca1a17d89e06fa10c74e7b1cfb0a5b17f72283d321cfbf2486f338b6ad2b2cde32095bda6c6135c0fd2d8703a4ae223d4702fd901f7be2d6318478fd12e98822df25e5cedbb235059b46ac17a5eb27805e16c95fba4d2eb6912db10ef8620bfcc929dafe0a6ee5b7e118d657a6b89dfa6fc3e9ab140e7a5267ad29cc3880f58b140e56982fd00be28640fb538079723b3794612fe2ade18bcb0d398d432f2ec76ebc9909368bf145d87e064abcde5c6b6fe10ba2ba26c71ab0cd47df313ee623705b2c42ce795b0df2b62ffc033712f07d7e6cc77d98fa49eca6c5b1743df3d22f6a3ffe4d22d9ff815e1076a78e37abe58af34aea90b62f3c56b4b50c9fae69d3e338978f8075e2e9570a269fdf24efe28cda1cc9e84fbab5d77ad716ab1732f6f369b0852197377b3694e1602f9b1bd8fe7af8e4056c3349f299830edf12f8e540b0c896ca1557529f187628f0b0e5695d944f9c565c1f98877523bb8e7f5b0de8164f4ab3ef784b336ef72087539277b6ad4ec23bbd386dcb8c4dbc2e19b5b45db285c7cc8ec9c27ccd0d6c2b35d2758ef318f751429b410e87dab793dfc014b6cbbd38d4c0dd7b12577de67dd5ff4b62a6170e9d0a6cd2a43f885d0e787dfddaffda0c4bf91c6f1917b653706b978c47807bd1a6add8e6ea6fc0d6b3921fb9a1a2cb1358efcd413f394aa8ff97ccf4836949bdbe57a71cefe0f7682e7fe45e9dafaf5637903bb025ab6781adf76de2c5f3e1078a69aa7daf0e4563c5c9b7a1075bc5823798cbc397fe02c1fcf21e88d6fc2821cd79cbcbaf00e66309e900a72dbebaa574c6f32aceb747e6f443292cae0bd9669dc129a6c98db9b06c6e203e044732974725ec619d39ea9b1ef68b70a396c621c243797eeb17d103ec013b46b361ec71a3d68fd23916b67f005dd93519a71cc679fbbc510e6dc70874ee79286e07ad85f63fe2b2c6e7f76bf8ab5370d459dd7daa7dfeede957f7e96797617acfdd7efbf95e6735d3f93b9cd5dda78af36fa5ceeaa7e4a85aafd37dec775bfd3267f5e313058d5b5ae6ac2e06fdb7b3faffb2b33a3542fea53cd591253ed2c0c9ff417a63684bdc2eb14739ae336b04225ed59ffa9c1579da644637220f1af50846878744fefdb8ebcc2f33667a943acb8b3299eaf2b0e78b7c3780ef1c7a49bce2d46b979ed5274bbb68b626f478e446dce91d0e2e9d4a66637ac80e0e7e910dd78b485aa756bfb1d665c828a11b1c14034f68bc612de40a68a823891987c2886e9cd5ceb1f5901c568a3da9e6d2913b036fb73d3c72ace0095bed69c79f1d81e9fae2766948db773884a783c70eeaf2c1386db9b37404ebe027bc606be4709fbf33372d052c327befda53373adbeffb64f327eddbd5763e12597c58a9ffbd9edbb061f1ea60d21aaf0c4fe9952bf680279e57e2c5481de42ab3b41cce0e58788007400ba9dc7846fb7d353dedf8e50134a7d976ea088cdefa402f5d7268296f05ddc60756d0f5e195820dd8d72be9e4fbe8faa016b5e2509e045a288cd11287311d739bd6eeb4d6538de0ea3bfd61abf01e5bab23ab46b4091c2c0df6624f2cc2e98db3f9f11a6fe406cb055814e47db78cf62d79df8d0e5524ab047a68a1cc12388ab5fe2db6806abf6f058c5755036598b358e2b656595852b4c2566e991ce04836699f0d897a92db298b5fddbc4bd228edc0fb40f8bbd2b3f2bbd6ca2959f1c326f21db138e3038246f43db2422fab0e7e647fd41b56e720e80fbaa19fd6836efeccbe27f3477bba6ecfa75f0725bdc1f3115b28874b628121fd0cf528e3cbba56b4d48ff4e96f6c108f377fdb8b031c7e215eb27d62edff62fb7b314c0ea3548e2968eb4471145603fec673ec2733f6246c41f2cae7c15c5d72b8d7734089b5faeae084517af8a370bed87d112fca015b8612efc63fb1993d37cf30fa5136976bd84924309b906c98bfa27701ccedbad4b0a8a6bfc7bc0d07a84c98bf37c47a98950f59195e74b0966b611955c013051e7f7a08b0d8e39f1c20441cdb0f76ccd9d9315e7fda892cb94406d9c4a29e96114023341b0ecc1d1e8f076b6150eb2fb6941b42da029e9fabfe294f81c4f4f6126becc54bcb22d65d6295d750e1619d15e7719365a8f06b677ae951dac3dc5fba9b81ee022be3bdab7e67b54be4f5f62f5887b974ff19995dd3c3eee37155c3138de5550db848bed43814490ed054ca2132af2432b7cc7b8fbf5fad657ef770e29a7812aec7d49d72b6fe7c97f401c8266e7fad2b257ac0e7d541a3badeefb42e88f3aa68579147b5c0eb5e4b2788f59921d1378807fd567dafe897d6190b0e5bdd55973d39b8322197a590dd5cd9c3a2dcbec8eb5da0f345bac06d8f2ff016bbf164667d4b8ea6ea7a079d86c92ea1e86283b6af804e3ce47e7cad8daf75cbf8585efe5fd2fd76d935f4a54abdf77fda6b59e015a4dedcb6c21bb0f30a6442a15730f222b50db933c707a41664f7d5227c414bf65953f97e9b9b283a782415f659a35e61612b1872b2abf628d0dd5c647db70839ed0355e70116f658ae126f9b5c9cd76d6f66d286bfcdab28981bd8c16bc3612e993d1c95fdeb91eeb49b859cf686753698ff369bf5c118494cfb24313df0dedad1e50a07ec059d11bde35fc95b038787e13404ec3afab79726f2d2e4db0bbf81276c3fd3bb1dc19309ff03995c8020873deaf1b2c1bbbd402fba8c7af101e3b755cfdf6d5f48da209c599bb6c87f62defb209e7c19dd6843e405f6e1c0f28e570cec21a2baef1078fd05d64a97993535e4cec65326ef01ec44153ab0c3b595ae7ffcfd6d15ed1490985db45b20f27885c276de9626cbcbcc3c1b92a9805d08c3119d24ef11c63fbca33daa8319adebb630ae984deb6d95aaf7d0eb7343ac17c32e8c3db1d581edc5c33b37c24147c47616b8240c7856b184ed147664c0ee188363370cb6ede29d0b9e264787be0d016c30da4dde8aca1bf793f2dafd0b5c68755547a0ede1ba9c1d7f06997e50e18229e8577e0307a1abda01bb7270de65f5023bb66c7dda12bfb9c8cc180ed4bbdc5037e3535561cf13f89e05eb6c98efb76c4f263b820c61e4c8b0bbe92d1ca4e1ff921823781b4e21cde146b6c621ae685c52fb72003b7d6726be10039f7800fe91d8be2e0c35a7e69cb0df61ef37ec08e02ac738d87765a6ef092bcaeb18e7663954ff841d74b17cc5a7a3889c06bbf764e9c3eec7bfd1eb882c453dd7f03aa6e0a8d7b1dd661e9f62b763e7b1c6b59bbdd6d363a7cfdce9766c3f758bdc8e4cab7b97db91d4b7c4efc8748bfd8e255764bedf02254d2df13b9680fedbeff87fd9ef981a23ff327ec77fb98b36b32768677cfba8a2369ccc2527656b9431bc1bfe38db56d7ebefbe00f4efbbd8b3f222cfff2da77253a764a34b3bc0bf2d5973d841e77fa0c17eb71d380a1bed9ca53e95d961defeffd9fbd2e6d471ecefaff2d47ddbddd72ce17698778124c484a403044898fa579717611c6ccbed85ad6abefb53478b2ddbf242eeed999ae97e4102d691ac5d4767f91d6a29cbf4a320cf990fb84c103f65dfd3d0cb3705fb5b307958064c52005019da12b9ca7046f4f5eb05b5ba364e37df5ee6001c0456a34beaedf320d7e3fee7f4be6d69bbb3fae076eacd06e33d5a5e49da4a004dd5db5a60249847d81c265e34a07b09992c8fc8c43296e53fd4eb59ea195b362ef93551e505fd53c97cf830df603fe83170d9059b4f92b93bc5d9fc39aff306f52d03ecc9f7ff790d6bd49da5008bf332c0da41db708f470ada73b37bef8245f132e6deef3066630fcea26843bd9e0b79847e5e08732aeff930287a047d87fd41666eb0672fe0d9ebb6041d0cf91d69ab1e9c998e2ae873c14374dd71e2c96abc277aa7576c3dbdae99b77edb3147db3df1d405d05b9b80ac82453e4543b0b0380e79cf59acad66bbf5ea2af5eaf5585fe63dd26573a4e0099b030175b7fe7a347374d10b1d00b5ded482d7f02b7d5e047e2dd699d821a876012900646f2dc3ed83f703f39ee66d12e6c45cb574404c000072980ba736f1b2937a77f37d9682d71279202bb350ffc2bccab6013c9401741abc20fd75f1ddf2b995d3b990f949ed6e641efb09883393d1cbf4b7b0ff80a57f0800d3b97e665ef8d3269ed39cc78ce85d58527f19a8aebbbc02305ef5a3774fd0056e0f7bde8e4c5d585d256d3c3044005bf40e4efa6609f66b6b1f0d2b00711d4653f66e62ed4bd1157ef83c7461bfea9fde3aa947806a0f80aff958afb67b7d3473c0db7fd1596e0df719736f8a023a44892745aebee1fb6aece882fe832342403d013182c9f0a235f1d8577f229efbaf873d4323a859db8487688127e16445e42961b3be7076927727fb03ff9dcc19387b87bd91e1f6a3ef424e00effe8fde423fff7bdb678eaec57d682f41fad8cbda55d0db7bcfed77173cd8e57a7bbdbb6c11449a39dc271ce00d7abc4e2f7c1f037b10566e66aea46ba9088c5703549ce1dfb25ecab7795e83ccd12680c6b7d5ef1464bbf64bea317856477d906192801305903a1918de50fd694a8196c11ece13f3bc0cfb84df4feeb2691fd5d996fc284077f05aa0637e0bc0d4cb506b53f9f7d473384a0406bd97ee4dfddcfcf96e506e6d0540f2e3b63e0a23f1ac92ed57cb0a241bd073e86ebf55be4f4bf8af37615ee4788c867b9b6dae8ec164c5f5d2d3e89d7a565523a794a0c780c7e1fa8de8ba23ea417db8b80f136fab617b4bbdd67e50bb989756d5de56727e7d176a0a998fa776e22d06485750c7b57cbcb87d5453f0f5eefbdb3886bb63b28725764ff7a13e1c50be03d6d3b9644ffba4fd53019125eba54ce615d517f75e13d4a2a1e5ab1f89bd538a6654639b959f67bab704fbefd305e70ca9cfe46d0cfb2bdc057cfda3653f01887e77e618304eab5eaf74cd769f80d781b277e27d83c907612f3a3f3d94dc2725639af4b57c4c8bf73c3b636366673c99efe9783f0e77c2d817bc76cbecca7eccfe07ba456f194f56330710e164fd381b2dc1f63f54c1bbec74653dbd2ea392be6c55f4a57836512fb88fde23fc2f9e6329b24f7a2e2567e8e34540feb7c704b03e7996b3a59cec9631b10359b231001e1a6cce3e0afb09a0ea7c689df11e0de9b86a23223f827edfaf3b66b85ec0da4d3dfaa9ad5ce95de5223bbc068838c4a66ed1713cddbd6f81feb0b22cc66357d2649107336879191b36d9dd999d7b7ac7f4f4d1f2c570b3f23c156c4e888f0eacd5beb7ee1c1da67bdd135a085092b1cd62fcc968790484274a7363af47cbf3fb898004bbea79d779feb8f93563df3d7ffff8edf5bdfd741b89e3c0d12f2afa87a3ea15ed0dc5400865324f99cd51329f873327cb6351b9a5040943326fa3ac8caacd9011bb121929f595289491ae2bc72dd6056480d4fe2ebf7fbf037a6527b2f5ae25ee31446e30e1f7d162001372b7176c6899fd58f5bae0f55f0f2576ff674c6d97f3b2fd5722dbb7b388997d66e7d497d953e66dccb3752da2a594dbbd09483b395949a58d20b34d041d497a5e7b44ff0188197b1d020e11142de8af9b585cdf4c860b791bd4ed3bedfaea7d41b89f46b2ae732804d45f66ae36f1cf2907081f167cbaead196606c414737ba6f696f6ba72250481ed1a7a2bd03898c7e1a2f3a80883b6bebac2d79fbdea4ce35086fdaaae7ea14dd2d294f1df17d301bf084d79dc8f22f08205136de558859fcdc1c8f8ae766f55871be34394f397ff5299f82c7e158ba9e1fe70647d2a9e49d24bc4536ad1c5dabc41675f02bea2ce375513f92b53d2776eaf7761659b9b7fd9e807e55f2f34c3d9b0642a8094070994c047414373145d49b350a54b019d22005b39d335fb4a772ddc49f50c7c5c3d8319d264103ae5cfd61fa2b0be8305dec96ab021f5b36b7fec4000a294f0bfcd833a01d49e7f5fbfcfbfae987065790f4d3cbb01fbf0bfeac2f0fcf1f86eb1ccccc5e3570cd55ef83a1f252742d9bda5f4bed91c9bcb7b62c10e2499f5bf5b6d2cc56f08723273d0cb60864caab29d89e12442a76cfc2a0cf8336fc66d3ffc46eb70aa1e62f67eb9cf49d3fb9a9413829b159a636adfe59ef5ce1c779d13e77e2013ae915ec25e76ae4159853c4169a23af486dfed3cf60ab8f96273397a7aa9f0df0977f1bc7729a0465bec276176ca61ada5e0fc7fc0e70065e7ffd007aa2a9f5fe3665e8633d40d4bd83fd82db699ba33b7fe2cdf6e06f0af6540b36568fc39d7f619dbbdaaab7d3bb464c64906fc4bf026cb243bd73bf7b1c16c769231b3bf79920c33d826e89da6797ad5b900b9df5ced19930bd15cd53f79e41a2e326bf47ebb6eeb2009cc487767bb75e1db768be3dbcbf39b4eed2fd88d87f3bfaf0a6af8e66fbf7ee3282bd7602c14defa0dfdb709f1ea285b37b1c32f440bbbda365ab7e198a973ebc71c7f6c0d1873daa879983fec689603c847d076c4a40ae03fb8f8cf6b16c1e0a6534ec938c6ce4ac7797a7f7ce42ba4ed6a3fe8749da3d8da96cc1e132c8bdb183fc963df1c89e1c2508f1f62e0679d10c302e68fb61cca14d4c5eb3dc816dbdd1e9b70df7d999b8c09f3f07ebd534d387d9bd3a73b694cb44256da0fb3bf803cc60efb0783dd7ab1991ef1827891d36399be8d995b1117f6df5d5db1be97b72eb16027b917baefa90ea85d532df03e073802712c74c368ee423b6ff2a399f8c33b688cf0dd8f1cc0f96ba033469676fce07dd77b09107746926e3a4fe0c8342fbc05f22535f8e5e787b6d67f6f19c2f47c6ff4ad2bfb2fe4ae6efddfdc970ef7b138aee8e3f33e77e4be790fd38dfc5faeafef0385a86e4ac00b4418feaf39bb621b1f3b9633c945d31b7b2a88b1693c97d53d97d682ab119526dc99e4bfd4f5aa95eaad13ec4e4f1b43f98bd89cfdb5bf28e3cfa1fec393bbdf3dcd657cbd81cf6bcf5dbec5e1fd1f74e3d67bc1e5a12fd0b4307b47731932f95f44f11f5f073ef4bf43d15ed23eb56e8c35d829a37c9d8e4dcf4193227897851524ebe9f6a51127577d905db6473b4c079c4c4c779afa5b79cd7d73604f407a478cbaf7d2fd3b53540518cd76f0604aa6fade7b2fc0ddecdf7a0145df0b1741f12cf9311e86c23caa7ecc63d1ea8f811fc88a8df8fe4aca5691390ab117df2ec6ebd5a6fc1d70bf88a22bdf00e7bfb6b320e1fb864adb642b512dd15f8be8345239440e41e83fb5e81af65e373e81df61cf0875cf5767c3faee02dd87e7bd317f75822877d78c299bd49f08b3346f73dea2f74d3cff13385fdfbe534f8959f75fcbe02e8f1e0a7844ec9b87e4b517667895eff713ee897d6c1051b4d78ff606b78cfce0cfc99dea699bd7add59b666aba343d6f4ddb8375f4d31d745d3fce9ba95bc87f1c74077f3133d6353de883defab0f44ef28991f45df3d7616a63e7ab7d73ff64c9423fad6fafc825f5c0e2db9aa3c61eefd67fd7e59e48f50760e32bb7e66178a2dd519bc2cda53bb781f646d001d20f0f6675c7d0f1a9ab760ef44f68a25b14bf27eb36fbcf737d08b3afc4eb2a7feb186bc5e0fadb2bb35c1d0facd1e3c2e5afddf261edb1b3b8e9bbd2b1c6aee1f657754721f23f76df9ddabe0ebfb6b83bb23bd17958e73d6ef3193678a4be9cbf9cf648e489f4bfd1b5d82dd47508f271d7fffee3dd975fe87ff463fc7d2bd5ce4a9d33df760a5bea3b23b3bb1a5c72ae8f3ddb56fb82dc1d774577e4fb88cdf2fc896261db2af024e4fd99c89d66f33ac77a6c0874e5fdbcf0bd52e93ed80dfd7cc31bca76fa5f52565de7853eed3356c2f4067303e636bdcb93f18a3a39f8c7f87c871bc927af5cbf6042a93c7d6dc797e79b57b6713ec625c13700b31c1cebba33611a2bce9efb549d666a1bdc29928f82edff48bfee79c67da02fffa933abc3f4dc006b0f3ec18dd277f02f6824396f6a0c6e44ca5beeadfd87979aa6b03b9233d3cc3dd7f67129c8ba41e8e39ba237ed7d9f4c1d61c529f7d919f66fd05b2b3d81cf59f081ecc68f94ae789811fe73750a732df7926e7b36c7e0e0bfef8a2bf393def477d97b70f6489e608b0490e8c1760e73fc99f69d7dfbee5dfe75bfe2383307f603d6ce05a9e9271cff2ee55efd72678d6bffea373f58fd6d5d77eb7dbeeb7dad7973a9677fabffe083c6b5add12bff26b995b79ebba73953880f7afaeaeba9d4eff5aea569e25e50d95ba959792feed56fedfec569eae8fbf149a359712708b486641f327a257fff590a5337d0cde60dcd247d486bf536f093116af0b37c2c9dbb38fdc45eabdf0d11b500f0b408a33b8755852e65f3dd631b370112db298155be61dd755888dda6d6f3c2b457b4c9126cd7224636a11eb56a0518285f02dc4e1ed0302e8d93857d2dd01da58b5d528e7b82acb99af576d585f2009acacbb68a156fddee5625addc6f238922272a6851ba16666bde7c9ad5b6ac5598594c92c1f45ebcba2d5659de5a480306912cfd176e2712e5838524b41bb8094b823eb0ebc66c4586f6c3d14ace8aad12b05c4cc31b39669ed8d6ae445c823ec876df09412ac59178732845ee143bde6536ba24332976596f8f90fdb93d3753a38e91d1310c3ce93d5786bd8aa05de7f2cde35a0f101ea9ffdfea68a7b64526766ade480a6957996a70812b9e7b93d44faa9a1693a3fc8ba6556928d2c786749ecdee67916ee3282f8fd54cada345f3dd2689339c7115e67cbd9805b64652d6c135489d402beaeef4ad252abadc41a3f597faa335ebeee7ae3d79d61832468b6e8ddcd17bd07556e89f6fdde4c9d16b3167d7680575b8fc0b3298becfcded9122ff305684a1f96676e39ca2d4627c31b40946f1b9d05b51ea5dfebad47d3f3bbda8a3da5f3d7f6c0313842c9c3f20c7d345d2e5f678ba92db5c41c7ebf57923ecf7b7e7da27f083222783f32846eb05679adf4feaab4f0ab9c53c3ded86811ef8bce7a5e93e77be7cd6a46e686615fde27ef9dfbf8bdb30c499fec9eb78066a8577bc45dd42765fb4719927f6afd49256425fce375392a3ed94f40f212124b7ef0b47bbdc9f2d2c34a14077a7e96f00265d6fb22ffcdb4a2242ac0272201b0a80d9273fc3f301632beb9f27d45a462d8a309df2cdca152a9569935497eee8e2822314b976a09ca3570d74432cad14d190a2f476001ed9cccbaf47f4a2abef9ef97883e16cfa19b783eba3f4f57cfc423664eb4adb2f93f38afdf66c44b47ef2e5b8f60f546c64e1aeb10a2e58075217e9c1faca78f9bc3d370571af9e03f147b30d2c25d03496d4a9660805ef53add26a2da1403b47d7dddbdeab62f15d55ef77e84a896d5b74456dbedc884b59fc700654d6d82019a92fe2dacfd6f16d6a64be42f25ac4dddce4e4577519e970b525e46475f774158b20c5fe663eae2f506e6a304ca2c617ca870cef998749ecfc60936c17e0b20dedfdf6e627de5c413179eab96c80870a84a6183055750c90132d89beef2b4768f7bf3d4be029878f36db66f02f9693c8c1d06b5593c501f4c0c2100276f1476f237fb26d257fd6f93b70b61452fa55f5d4735f5da1bee94418e62ebdd1b3befab30323bfdd3fa367c2c734500936449dfa502998f72e850f27900d35f80218d5c1d54a5c3415b1ff6ba7a771cd0be87df12c66404f093d3880982bea9a3de5e771745ba8754d0ab8f96ae49ea343ba04c9b18b3e0f64f6b304305f52f4083dede1fd07070d63b477f3dbcf9588f96ce93bbe848435092720a750fb5f9a0aa4edfd4dbf1010d6f0ed232fff2612db37b460ac578d3cc7d99b9a6f3307aecf2f3b7c2e1628543e682250df726b81467c3d709eef365fb7f46705d1af6ad0296e1a2105ad3b2b4a42fa9fb79c8207117351773c1a57dd87b4d5dc0b830b2166ad6d7dd35c0d015dcc7e402c9d48db5f2c25c2dec2d0b0d2486254c85aa4c315315128a8d6d1e92c07ef18aaef725b449bb1245a30079950fd3046d07fe00cc4b4d77c9fa87ad77894098ed171caea141fb33502f64debc00f477762e67842670bef2d04d55420e2e787e857d7875ef25d02f3c9c2483367aedbc4be79e100e9599f4f75db19ee41de0f23e3a92f9abbac04f2dc1359ac1b6a9c97e5257573ebfcae65a1357fd2ad8a0a1fd6f823bc8ef3b73350d475806819857f424f06700e9125a1c868bb8cc9ddabe4efa7961e9ab03c04555c1ad15a0bc923248ba5a021f4361244151a50ddbc40c5eb553e1c40ca0a93adb44d0c7f23a6b517826393f5e085cca329440601197adc98aa503cc7a57857e0065179ebc81cbded416f68260bd5a1e0092487b7b6261b76e5c75e4b40c080b4c42ea24e162987be6005c99ceeae88e9a12de9333c47e71efd3670f0cc24906cf5705abccceb97701f28a8d4dca1375eedbeb91d37ae992b614619ad8599087cc48e1c7b610fe7a6f783b62d020cef9d2754e5c17d9dc2b0a7eafb5db27191c644befde08214893fcc0c79cb37dd210ea8bef79d93b65fec3ce7727aa3f5bad9f4038d6283c65352c9740474d5dd7ab99b3fef3c358968d493378a8a41f1a85d3ae5426e615a5ac5f2fcc3768ad576d801206971d5b4fe6619bb8dfa97602832315d4cbda44f671714c604f79731ca39b866c049af5db5d12be5a1d8e47d385f3b45c8eef895273d5db0b706344b02ac065c47a776aa1d5b29581247b5bc65aa7b7373b1908accabe283bd72a150aacbd93ee8c0b55d3e7e2d966e785b3194571c263375ccbafdaca8cdf89fbcf22b39ec57d240f6325cef59707c6bba77b38db03d4cbd784c0d7933b175bf78271403eb469193f55e05740200ecafe7967d9cbf32c1307c216c25ddd9cacdf76e9f896dd098a3cdb629aaf2b83e75ddeddf131ca190f2c17d3645fe66764355f94efa30598b777c7bef9b013ee3eccc0214dbbbc3d0eb8158c7b2ce440b16c77d9323c878686f84cbd69fe445121190ff27e0299b5eab73ff38ef98af0ec646e16cadf39e4bececeaa8bcba621b2072204645236ec418550d4af19883b8f841f7da0e1850bb473f5a79711857893a68bf7d6ce5de62e76e19a60a1bde13c8ce8588af7ad4cf86f81e6b2b190a7f1f906e394b8fb559ef1bceed5e74f5166b2d33acbde6478b3033774f516f7d5614fe5b039f9bdace243e53319c5f62c48f60a06c9072147215c0c79171d8f2a83a8a66742fab9ad3630aa1df7aabea3b28c179d9db146b7d4505376def3fe8e5f3bbd571e7a93dfdf327742efd95f777aa0ec063932c8eb127e4032974be656fd3e56338ff0cbaecf8d0e3fdbcef40e0e7bf8c3ccd1df0621e33bcec2b91a01ff93dc7d25fbfd9f389eb72434e61db8431d894b3adbaf9af35c3b1a4e74dab90f75e2821cf2f32cc3e319028f3059c17d34b4d561f1fd3f649c2f0b132d0be79d37c4642e60dc154c80fcfee811372c805682f0ccebd192ec01ea83308f5fb931a5f06cc1c26594ec0312998ec88bb1337b70cab585ef11c233c267e4f366daaadd3efd347d1b7b60e00adfbf877fe3ba80cc73fea17b08e1d12ae719a55b10594b3ddd8a402331feea4fbc830d0cd7ac3a139aec2b657caafcfdbcdcd1347be63bc49dbd39dcab504e55fd589f80de91bc8718197a33706774d4ee3bac57dad78c272272c566e76dde883bbfbe489824c1808ff136c53522ee0d957dfd997d6398bf3bb51e65e54ae68c68dc95dc8f927a4aee4ccb4effa475489847e97a14d28b72940751260bf7efc12191294bee8c17ec65afe6a87f30383ceded9f03bdac3e48c268c88cfbcaf89edb23e85e531952da571239523aff16a37b02155ab586f591449f247c8afc8aa0235ef5602dc23a99bebf8db971fca3ac1cf6b93657879f2acb7c9b9dd62b52e61cec08d85a68eb15735bda86f2b32efd3c14f8144167dc6e19eed2015e6ce1f6f7f2b956ba76ae27dd9c7c3473368d81cff34a7496d7daed82dda96eac8cde98865f38f03929debba09fd48f1c4c762e3d67700aebac5d9c3f648c045d643a36ea47a37323dbbed763a8bd166560c21c8e74b7bf5bcf535d088c67e5be07fb592e8c4a19bdb8074d3b5b80e7bf15c2a297f63fa59d5a029ff789feaa6eb709b26fa1ddf56d62f3ede1b920774af853277d7fc5f9d398bf7bf796be3e9a5a42bdb9a1f25bb2565a4dceadec5a119e8163c1169e17f4520df8b8197164bc71df57edc36f1cb2ea43c2d7b1908ea05b505de2281951bd11319e3f9bab71a4bd4dfbe30ea4cd06ba3b634e975bc704c8ace1c15285bd3e0f8d4e0ce92d5c7df79e1fac31ecc92cd42be141e57390d539ebf8c57454545e92da7c493fa92e89855a23fd04724f2382b1198f76825e1878e619a4c5c9f904f732d9b3644f256559695919b9ab25f26fb4cd94bfcdef43a5219184cf0b819786ba2cb712dd58121e466823d7d139a0539a00c4f809ea77b3d3a01d73d55a8fee5beff336d76f923408d92af09ab6fa30de9bb0a63fb8931dd4c18817ee7d774961fcb82c31a99f647fe07c945cf65176e64b6c1a2ad642adaed4702bf66f39dfc4fa979e39eae879af0fdbd17ad8ead4945335ff4e5cee3c59450e9aab3f2ddc7edb047d482b7250497843e927d5e1360ff9c8c26395d4251ffa63ced34146ceced7cafee3763d92b44bfb380dcd7d791f4388c1bde13a2dd2bfa24cbc3cac5a6d98b546e1afb2fd2bd6c3023bd097b97a506d080f66c19a6a3f17fb7c9ae629092fd7644d35de43071f7ae710e9aba9dc4e60581a028ee9dfe1ac984600cf6a50dbbab6ee4ded82bee0dfdbefa7f5dbec8420bd0be756a6ef33e1ff26d97a16c394e63f391d7fe3f1a8dce36ad644d93a28190b8dcbcf4f6a46965eb3af5c13bb2638a756771561f678d8c0c2bbc0d1b80b6be229b9cb73a8b0670299c7ce3db247c15d49663f58da7f257bfa9af0f7e54e6e25fb83638eee6caea3abeb97ef0cb7983b87c1fe5d0ce14fedda7fb36f4ecfaf8362c88f9a7df593fc51129696856d64756361e43e7a2f863da030e89fe81f328f866ae339c4a0cc6df56110821dba36ea8730a7a05e13d788d5dbbbb6fe43fbac60dbc579df12db2e736bb83db09785390375b6c6ee7d64dc86564da89aeb4987f0dc3fd5f0ff3b0837aabb257280dc3d077c128aed1d34bf5fca792c22af9b76b6be3e82f752fb4e2eab48c1220086f4b803b86b2eb7af9763c89cd797616988a38c3ce3c23ee3f29892bb210df5baa54edccc661320bc550f207641de393e30390e7c3ff37bbc6aef487f34b4f59ec3da615091199bcfe29d908fa920e3e8927bd579d295c8d5529b2d266f9aa5b28dee00f8efd8bc65e17098ec2b9b97f5775ea79c0f7395959308737a200b91f628092396ec493c34161f839c3dceeb72f17c3b5b3ebf527b9cf1763d329dc91bd7393ea7f7fd1c6001ccf1d9ee7efc7abf9ccfe670c76f733b66060c32cee64df209ef5939107e20a5035b455adf88871e8550d5e2b8319946de5688f44bf6775ae6ebaebf50ef9fef5f9d596e1d948701a2fdc4c29e0174f6700c21a35cd24faff576c98f19db47b007798e40ee53baee52f9dc02640fe0140b3aae46f3dedeb6f4ce354064c7683e6072e30387b7a7eb0e6cafc1766e78515d04bbe6d2365702c194afbd067b8bc45e393fdfcb1ce03321362f7786ff7b4d35595377eb97e5a27727969584d26367a49826dac4ae474b575bf54a75f3f97166f61ee5fa11becf16f6d664ec45dddd16606261cf28d387c8eafd3f1e4a8bc1d14fbfb1ff70af075b6dfcd665cfd96f7aa641980dbe6e85cf68ed005cfae350dd1b943ffbcb401fa750d4bbb00e58a004a48184b6a210e9960c4eb6108e0b1dfe9700166ef693d3a0a58d164c2eb33caba3fbd37a3e38133de2e81e42fd61b0f302fe8b02d62c01a003f8c633e115217dd5027d411bf406faa80fb6c5a44c6dd56b3d7f4c2dd0a3bf779c33f449191008b9abdedda7a1c659888cc97050b1b62f0d9541619fb5d5325c3f3c416817fdad30e634ac03c886618ea8f791592c67bd37ec1b4cd63484d2014864311c8d47c30a2e58081ebd3b1ee8a30356ed1ebb7b827cba726f61d0e9ce99427e6f85b057bb8661db2c5b5b913cfe7a3820c025ea030d7d033e3b0cc219caf5d7c35d5579a5fb1c9b5ff2e7308fba4fb1f1003e3396874e6322b7553fb007202db2f5bc5e1d6222a77f7b76cc618fd03fce0152db0855161e83e7075a264bb41e4f37de666ec8e67ea16e6bd771f4d1ec3cf1066da363e1f1690c7e1e67f5c3d7c7a79b80d75b73fbbe6e5bf664a8faf0be71bbe53d96edebe95ed9287c0f93cb24e10b8530412033f0f5b964bf20fcc736c973c1dc11f2340eade7ebd0df2367a78e08647d1a96221702a62c745549ddfa25cff99a4ce68bea267d6aff661b00079eb6a3b866139ea1b24c2fb173df805d6ce55cac0ddfc265c14c9f716a07ebb79dfd384fd769a1ada3b5bf5e1d2184cac17c8333dbb08d545ecfda79830d8a2931d6bd69f37a08e50875b0360f07eb69d8b02e44aeed6cb85cfbbbea9393914bebd464ccdc0c4fc4ea34a0be78d4678d84c52cb68fecf520fbbc644d027d3a86c57946f6e5f5db6cfb3897d595c83bbf917d44160e540c49394ae0fac78b565f80ea978d15690b0ba7d828f45956c6d97c7f61efd8c9f6499656d53e1686423a1e9fe7c3a93d07e553c8de09f65ba2fde9fc4651ed3f21a448e7fea0cdfb9da739bffb50803121d4c3ff34cfad7a60c31ca673c1f5f7daea0aabf6c1a6e7f2b8afda7ff1302334cc88f439c8b5c4d0b34df936be060ae1f429cf2686b363a179613f823076e4ee11ae976928bbc7e96575fe2f058a136502b9b361f06bd9d9d0309c6dceef7bf758c6f3801fa67a7b4d78d3c4077c7803f7f82dc8461bd6df375ae4ecc04677269fcb0fa0ef953c87b4210fd945c3d13d3e3cb764618233f3da1eb40c4f1212f3a165c11c78ef58d6e3481e0637578efb0ebea585b995c52eba20ecc9631d981e4560437208b63cf6da05b061ae667b5268360a65666145b78b9919685bae0e0cc0ed9f5fbe7ef9bf04c18dc2a36501dcf4d876ccffa7defe3fd70e5d92494074fb27a9d3570b4397ec2c64c2d7ff1330defe59df3ec5c05e8849a5045a570b76ba16a150f177160a2a1381c4f62cc5452e348622cafdf38b1e6f6ca8987e8a50f8e5e72f868363a820b61c44de6161c5c0ae1f47487151a41104363999adb965295e84024f7314ec4736f634a78e6ecf70e4e4647eac87b15e52064d5434dfdeb7ab4992b799761805b61e4775af4cb384c616c124813e375c1ffe62d70f50182a1b478b90f8c03adb1982b36393ca632fd2600e2a8e1d46ec013a926fc1c98f70f245d1e8d090a78a61fb5b14a4bf4d31d10cb5f40732cc6de65726d1ecf47aedbef0c0716c3fb28df4c9c6f6c3f6552b7db0dd991be197ab09c45b7f87d25f4947e938b03dab3441d175bb22359426c25a88342f22018c8ac9c88b02ec9f947dfb6beb6b4b425068573e25dbe1b254c532dc2a0ac7d6aa4ad06dcbc5660581b145c6ae22dd0c74ab22393bf2b2e450ab4acfcf0d09c5410bccf01232656323a7aacdd9d9554cce4cb742b2eb54b7c97576a86ac83c3b8c50d50b2881b2b1b5a8822aa8ac44b8d53abd6fd504ddeae45ebb534510eb91832a082227ac2c00d22b6a6068c6b6a27813f9a10247090e4c14d4d0197e5c43616113e971c544275425db0023d9d203be24157bce49926abbbe23791c689e6c02c3e338b26539c25398cde49a3de14776cee6a6683663605c093fc46ce1566b677e65a6587646e527507ebe448eb06d454e58e8b00cc1b1d712563ffc52fc9d7dfc42815a752d444af887a39881bd4741fe292ff9cbcf5f906760931e13fcaba2855e5bfc0da5753bf927dfae324f6c4f0b4ee21323dc8b3fb7e828fefc00ee2df75b56af6c02f9b571342bac26c17e544371b00354a080d2191f904dd8675aef2357fc79744985830007e4a5477faf4197433de19f1b65b9c3996d6cb5c07cf4b0af38d8b268ef971024aca8b26f37a35274cdd821cf0c15cdfdc3bf348f79f234179bfaa5f99056e082eb33d91bcd4097667291cbb7c2cbf261cfc297660290e74bf304c8b4c3cb3385b11335cd15e01d0a2e1b6096e5a271e2798220bc308b65f8c955e1927c17cd0896e7a2fe6679c23f9ae630b0eb62af31b1b7b11bae668500b55f342034c7257d44735cd2430e6e5aff0045c1a9212d408937ad41146846e33d5181d33f57b2760815ed10fe129abb5f2c0c5febd2e18382a00919e336aae90cc706f14b3332f1725f438f03b4d53cd34141584f1c20137991ad3917d12ac8e804d8414680cc0b337aa68f6d2fba3ca71f600385e1e519c3a871a6fac13542b796c6441b2d76a2fa1722a3d3785879c7d5971aa03f6214d64fac10854c885343675b2005d95f5552268c93edd9cd08437367e3c6a42e481a9b1233d6bc19711c34adf0560b90d96874d33c11084a9a129f3c43096dcf72d0c6b1ad6df518fa81bdd722a4f8018eb0819d8b88535eb676af92660d7ce3b24c7fc428387d220bfd7b792583ba2550c871741de5e83ab5af0a51b0b70d54c205d710275fb488ca34d1e7b24bcef792ac052ea69c0e3e17141c854de9e0232958d7bcb3661311325c728238fc25883d9017fcb2c181ab45519eebd1110ebc5f151f05bb50f923d6bcc8ce0bddf54033377674562ccc6f0125d701030591bdb1150bb36ff9e41002c32bc723c828947d279b6c5ac129dc41ee5f02e499e8bcc771ae43d05e73fc2dd66d8d35eff71079057e68137a38b237a7e44b36d94211cda504da1e79bfe4ef27e4fda62d65e0b269c946d38808f8d410646a5ec464340df20065a459cd887d8c9d8694b0aa9b9116976f8e727f5d939c94d594ae51abf7d717347c7f7d49db45eae2c957415cd657e41ce2fff7570d48ca279f9450b130af4df33cf05732f9c34833760af99b4fd3e3cd4673b0421499b93447f32cc50a70ec939d417182584a42ba5f8f37f271c89144271f854d6814cd3b35a233e340637aaf7a62d839c348737d2975e869be5f782b0b12453b37991ad554d2c1060d20cc4cc3f515c3f56b92d34968da9b4d63622ed66b481d7b86acf34a33ec35272ecc14421dc7b6294bd07c3b542ced080763fe84b002cddffe6261d00786f4d71f4e71ff2ea102890a0ec286c4491364a288fa5ce8888ccfe4517c1068059fca1aa0103b7b4d77d0a7b287c8414684cc4b334b18d1fa4ca04f76b54b73ed35c73665cbb7226780431f1917e429c8624a29416692eb6b38b96c03073eccb643a0f965c916fec58d9dc82693b28c686b381549e4a385511d89af05210a6aa902d2cd7564a1a1795e03b22830b0b7af238bf00e79554470a16ad00042d6a06a844ef2d20f178510b80f3631fe3d4bb1434e884e5bb844e2030a14e4ed6572c79da3c5a18fc34849cc1f3621aaa5d9c69b4dab964a76e048c8ce616436224a171665c9bffc2c35ccb1f02f12795f89dd8ee6a0206a46199b76334a69475f603654522cfcd1b11698cda8f546641fb8199d8ba2c0369af5698082d82b6c4ba5c4850b510925dc3bf3cbc5d174c2f929c8d8e202ab9aa45ae450540cece0a09aa4506d57b36c037b9a1d000fe7c345118595965c9589125e344f91cc7257f3c32636634d6814e4eac86c440916684de8c2c8c485fa4591079b12e9e9e2d19ea4dba11645b96187c4c80f40184039d6df397ffb3b3a46c8036165a8f87af1d6e2da91b1458eb3255d1605b111c5f95ec63e5ca289d240fc5e60c7cae914aaf46d465b98473e721c04e727744f84dd5c13fc9d2565f8fc00bb28daa2982b027e4f797f9670718664865d9e1368b651e4d7e584f8c88e62e1723abadcd0d12fa8be8b54c98ad0ed488f8d1d8abee2c0520e07c5c25a1c610f597545900a9512816e6153def33439adc605a4c5c91a607d635b8a11604fd977b369a11dc47e883c262eca25fa9b7657d13628c875ab90505cbc34d128f05dec39d6831c5b4b133e0e9a13a1e080b4688b0257cb312094c867d60b8584bdede7f7c1300a90661eb49344211dc63a0eed082b168e509e0bdb6bce49733462b604177a1414c5279c66a38551845c9f99780a1447d3524223d072bd431e1311bd1f20522bfc95d800983a9962e4fb2fd44046d1a9394a1d09a133b0898ca6c4d4ec366c4a1e1c9a528258a009ad1fd8ae1dd9fb3a62b4671acb0a1a3ef56bc8124b8b3a1aa559ef901f0acc31037b060abcc6f47e80368d880f811da166a533696005456a425d55ce918ccfb119159b7841dd281e597b12bbafe6c48a669ac0b45f98298eb697e530b0e781d1b7675d96cf44a111809934f62ecce88597652069c4b2eeb3f91a4eec5c7e6c84fe85397c94ca109b6713d4c3cd3345d8c70eb64e97e5e2c2b5e639c032cf4561a8596cb203ab66202f8cc3af36963c4a4ee4aa3425d22c6eb127a3a39720f68f6bed2b89fc009bb1810219a1efc496ed29d8b09832354f0002b23830642d04797714962654b6965240f47e596ab273e51e73d99134a1f27d94022e4f9a25cc431911f90bf5135e152107419f9e800c47c8a94852e0d80dab088a152da75174cdb23233ac82d672b0deb058d24ac5c3d8af227734bdbaadf0cb36ea29142f76751434200c9005ae36a72a52e93816c972f3a548107b549a8215c69a62857869095f8906320a349bf11c8a898de40bb91b706e4410b96151e48753e119b935919de8a8308368cd2086e965490a5c2d0c261e92d0243b7131c9d777e6a6934ff41048bd88f1b06244477e8fca93c073f2c78aa3b08c405a384950b61da322d1677ab27cb26d7a9af479326b41b9840226fdc893a5e32d24c0e56c5ba8297d0abdbb65766a25145451539998560f4404b0ba34c3c0b11795e4e2e425c91f87b034a55024d14ea220204a446962885ccddfe2001553c52ba2176a1bb4459a59ec09208c3dfb987f4ee650888c38408a6e9b7610173b8ad04481e685604c214d8d3d1b764c52442581c74b8011f92ad069be9db4a49640b15d1f0521f6f8062f258e805dc14129014ed9bbf2e4e49d35d461ec8397a7a2c7e94c94d1917e2494c98e504d961cead56449d798862625b79047c460a2be118ad03c0f475aca4036c9676b2ef31068f41ae65479418ec03708671237af135c511574f483ea1c89aa9b78a9fdee6ae14e9ea1acdb613c94c4e8aaa47e4003d6f678b3a92270348ff3749514c405a601196472f4c68424f7ef8efefbbedd3c4fca5555930738f6cc00ebb67ccd5062e2c7e3609af7f7e467658552d6ac24d9f340efb9b7a35305556ad7db8848d19ce802ca64397e220b39d06c6f833f9317eebb9fc9070768b8d5980afef3b9b9b1de674a614b1ae6a465f8cd4a108ef65a5a72029793662e6c9514a9098fbc242066727b39016f723d45fd2e2250d285ea60cd6c42ce175b135a222e6d40686c4115ed9c9b90a623d3803aa376aea16ddcfd241f37746b400abc5843522eaeaf21859bb9238a8d1a92fb5a1846db00c7d6b64136b6261b7760d5c99b253c8586e6346968c2a894d3ee10f23587cb8ea524a293839cc24755f394f75f3945c3ae4aa526a5c9551d1869f2ed2d614eb23b0df3126d4ccfdc4d2be893710139e7c6ad293d43cdbc5d9b9147a5abab489db4c1449bbde65c9a8b49f142145d9a934baa2ec9828e8d5f9328631b106f6c07c1905c409ea8661a9073a3c706455bc8b3cd86b4cc8bbe0929c70b6842eb079ae56a0d899b8f7b18351e0e51b7524a5dce0c242401da808d217d43fdf866c8d98f8b7208d2b7f22cd441829691f85434a5af1d716675ccd42838f0f526f43b0f1f3cb069be849c9b365f9207b97e74d14bc875116e8b97644aeca859267f677db5c10cc1feba6f8b4f4e9aeb7cdd83848bd9a3c13f45034942973f558cc0487f6c887a7b1b11fb0ff8a708faea64026bba9df9196a9ef85bb7433ab5d227203a353656e6d929429a5378c49772f2d0d86ac656bb665b7efa18ef51a05948092203ef33297e2cfe84cd0f0c101d3bdb888d1b859473481e59580b8c6df6098710c93f0ab3cfd0d14781ed222f5720ced1014258f649ba1b240f1ded7c0a90858ebef8d4cd75b387222ed64c9ee1905bc0268fc0da8049d8d267d87132bf030cdd14200307995ece97cf76847c5ff255ac45d8b50d598ac1c590851474b4a32dc63b599a252dcb3288105d96c46cd224cfa3adecb9ef077893e8320ac9e1495a1a635015c7f6e2a3480092d2c0c69947597fc9f47962d22b3e0267cc7ce7b2fb41e67744dd078567b446607a8e72a5b2ca3239adf89c2187258fa0547ac5491fa58aaf7d474cc8898569a331b53db2b162637657b1a915342d166432bc842f3f7f61a3c50607fe2914cf8b7d8d782af71b4abe5377379742f7c03f85987ffb1a59d0e4c11f318e9049ecb099a59f470e73ae6c10be923f7c2d651eb24624cf84ca179e295a68d8b63485ab44e429894f843c39dcec599a87229bd71bb877ce23405a1cc00c26db0d0eb9c304a6eed1a4be385462aa8462e6d8f02fd91dbffcfc85ad6df28ded3d74138219176930d1d90248bf298685855f7cb3e4bf53b35cfe842c38e137eff5d0b10da2ba617b72ba3c521765b610e05fbad3b0e9fde5e72fd02382768dfc8c349d98c5a40fd2e32cf39baae8808ab68f6916d26f4a1c6ddadfb2bfc1552ff6ec3f88530e5d10f0859a0bec9167e240c9282d98fe8d1e699d56332a1f3ba776b7d5aba1264583155a533a8e9154419ccc438e48d784b6a6be30594d2f544c2f4c4d24ca08f3cac65a3a3fc0c7530d61aa662ca362da465932a8bc28f4982cb544f1554a2aeabf4a89726ab05a3aa60d3b206d07589daf288c1234510003a28f12fc50fae88938da019eea05a0a94f9aed714853290ceb083f6133f75821a637b0444678c9989f7f7c697f6d5f7df9d7bffef5ff010000ffff0300d73cb42ebbf10500`)))
@@ -0,0 +1,181 @@
+package rerunlog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+const (
+	// dynamoDBBatchWriteSize is the maximum items of a BatchWriteItem
+	dynamoDBBatchWriteSize = 25
+	maxWriteAttempts       = 5
+	// sortKeyTimeFormat fixed width, so the sort keys are sorted by time
+	sortKeyTimeFormat = "2006-01-02T15:04:05.000000000Z"
+)
+
+type dynamoDBClient interface {
+	BatchWriteItem(*dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error)
+	Query(*dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+}
+
+// dynamoDBRecord the table has UUID hash key & SortKey range key, created by the migrate subcommand.
+// A rerun with a new UUID is written twice, under both UUIDs, so either is a key lookup
+type dynamoDBRecord struct {
+	UUID         string
+	SortKey      string
+	ID           string
+	OriginalUUID string
+	NewUUID      string
+	ETA          string `dynamodbav:",omitempty"`
+	Actor        string `dynamodbav:",omitempty"`
+	CreatedAt    string
+	// TTL expire the record after the retention, counted from the ETA of a scheduled rerun
+	TTL int64 `dynamodbav:",omitempty"`
+}
+
+// DynamoDBStore :nodoc:
+type DynamoDBStore struct {
+	client    dynamoDBClient
+	table     string
+	retention time.Duration
+}
+
+// NewDynamoDBStore the records expire after the retention, zero means they are kept
+func NewDynamoDBStore(client dynamoDBClient, table string, retention time.Duration) *DynamoDBStore {
+	return &DynamoDBStore{client: client, table: table, retention: retention}
+}
+
+// Record :nodoc:
+func (s *DynamoDBStore) Record(records []*Record) error {
+	var requests []*dynamodb.WriteRequest
+	for _, rec := range records {
+		for _, uuid := range recordUUIDs(rec) {
+			av, err := dynamodbattribute.MarshalMap(s.newDynamoDBRecord(uuid, rec))
+			if err != nil {
+				return fmt.Errorf("failed to marshal rerun record: %w", err)
+			}
+			requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: av}})
+		}
+	}
+
+	for start := 0; start < len(requests); start += dynamoDBBatchWriteSize {
+		end := start + dynamoDBBatchWriteSize
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		if err := s.writeRequests(requests[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeRequests retry the unprocessed items, they are returned when the table is throttled
+func (s *DynamoDBStore) writeRequests(requests []*dynamodb.WriteRequest) error {
+	for attempt := 1; len(requests) > 0; attempt++ {
+		if attempt > maxWriteAttempts {
+			return fmt.Errorf("failed to write %d rerun records: too many unprocessed items", len(requests))
+		}
+
+		out, err := s.client.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{s.table: requests},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write rerun records: %w", err)
+		}
+
+		requests = out.UnprocessedItems[s.table]
+		if len(requests) > 0 {
+			time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// Find the records of either the original or the new task UUID, the latest first
+func (s *DynamoDBStore) Find(uuid string, size int64) ([]*Record, error) {
+	out, err := s.client.Query(&dynamodb.QueryInput{
+		TableName:              aws.String(s.table),
+		KeyConditionExpression: aws.String("#uuid = :uuid"),
+		ExpressionAttributeNames: map[string]*string{
+			"#uuid": aws.String("UUID"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":uuid": {S: aws.String(uuid)},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int64(size),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rerun records: %w", err)
+	}
+
+	records := make([]*Record, 0, len(out.Items))
+	for _, item := range out.Items {
+		dynamoRecord := &dynamoDBRecord{}
+		if err := dynamodbattribute.UnmarshalMap(item, dynamoRecord); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rerun record: %w", err)
+		}
+
+		rec, err := dynamoRecord.toRecord()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func (s *DynamoDBStore) newDynamoDBRecord(uuid string, rec *Record) *dynamoDBRecord {
+	createdAt := rec.CreatedAt.UTC()
+	item := &dynamoDBRecord{
+		UUID:         uuid,
+		SortKey:      createdAt.Format(sortKeyTimeFormat) + "#" + rec.ID,
+		ID:           rec.ID,
+		OriginalUUID: rec.OriginalUUID,
+		NewUUID:      rec.NewUUID,
+		Actor:        rec.Actor,
+		CreatedAt:    createdAt.Format(time.RFC3339Nano),
+	}
+
+	expireFrom := createdAt
+	if rec.ETA != nil {
+		item.ETA = rec.ETA.UTC().Format(time.RFC3339Nano)
+		if rec.ETA.After(expireFrom) {
+			expireFrom = *rec.ETA
+		}
+	}
+	if s.retention > 0 {
+		item.TTL = expireFrom.Add(s.retention).Unix()
+	}
+	return item
+}
+
+func (r *dynamoDBRecord) toRecord() (*Record, error) {
+	rec := &Record{
+		ID:           r.ID,
+		OriginalUUID: r.OriginalUUID,
+		NewUUID:      r.NewUUID,
+		Actor:        r.Actor,
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, r.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rerun record created at: %w", err)
+	}
+	rec.CreatedAt = createdAt
+
+	if r.ETA != "" {
+		eta, err := time.Parse(time.RFC3339Nano, r.ETA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse rerun record eta: %w", err)
+		}
+		rec.ETA = &eta
+	}
+	return rec, nil
+}
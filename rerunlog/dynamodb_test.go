@@ -0,0 +1,100 @@
+package rerunlog
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDynamoDBClient keep the items sorted by SortKey, the query only match the UUID key
+type fakeDynamoDBClient struct {
+	items     []map[string]*dynamodb.AttributeValue
+	batches   int
+	unprocess bool
+	queryErr  error
+}
+
+func (f *fakeDynamoDBClient) BatchWriteItem(in *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	f.batches++
+	out := &dynamodb.BatchWriteItemOutput{UnprocessedItems: map[string][]*dynamodb.WriteRequest{}}
+	for table, requests := range in.RequestItems {
+		// the first item of the first batch is unprocessed, so it is retried
+		if f.unprocess {
+			f.unprocess = false
+			out.UnprocessedItems[table] = requests[:1]
+			requests = requests[1:]
+		}
+
+		for _, req := range requests {
+			f.items = append(f.items, req.PutRequest.Item)
+		}
+	}
+
+	sort.Slice(f.items, func(i, j int) bool {
+		return aws.StringValue(f.items[i]["SortKey"].S) < aws.StringValue(f.items[j]["SortKey"].S)
+	})
+	return out, nil
+}
+
+func (f *fakeDynamoDBClient) Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+
+	uuid := aws.StringValue(in.ExpressionAttributeValues[":uuid"].S)
+	out := &dynamodb.QueryOutput{}
+	// newest first
+	for i := len(f.items) - 1; i >= 0 && int64(len(out.Items)) < aws.Int64Value(in.Limit); i-- {
+		if aws.StringValue(f.items[i]["UUID"].S) == uuid {
+			out.Items = append(out.Items, f.items[i])
+		}
+	}
+	return out, nil
+}
+
+func Test_DynamoDBStore(t *testing.T) {
+	client := &fakeDynamoDBClient{unprocess: true}
+	store := NewDynamoDBStore(client, "rerun_log_table", time.Hour)
+
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	eta := createdAt.Add(2 * time.Hour)
+	var records []*Record
+	for i := 0; i < 20; i++ {
+		records = append(records, &Record{
+			ID:           string(rune('a' + i)),
+			OriginalUUID: "uuid",
+			NewUUID:      "uuid",
+			CreatedAt:    createdAt.Add(time.Duration(i) * time.Second),
+		})
+	}
+	records[19].NewUUID = "task_uuid"
+	records[19].ETA = &eta
+	records[19].Actor = "alice"
+
+	require.NoError(t, store.Record(records))
+	assert.Len(t, client.items, 21, "the rerun with a new uuid is written under both uuids")
+	assert.Equal(t, 2, client.batches, "a batch and a retry of the unprocessed item")
+	assert.Equal(t, eta.Add(time.Hour).Unix(), store.newDynamoDBRecord("uuid", records[19]).TTL, "counted from the eta")
+	assert.Equal(t, createdAt.Add(time.Hour).Unix(), store.newDynamoDBRecord("uuid", records[0]).TTL)
+
+	found, err := store.Find("uuid", 5)
+	require.NoError(t, err)
+	require.Len(t, found, 5)
+	assert.Equal(t, records[19], found[0])
+	assert.Equal(t, records[18].ID, found[1].ID)
+
+	found, err = store.Find("task_uuid", 5)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, records[19], found[0])
+
+	client.queryErr = errors.New("gotcha")
+	_, err = store.Find("uuid", 5)
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package rerunlog
+
+import (
+	"sync"
+)
+
+// MemoryStore keep the latest records in memory, meant for a single instance since the records are lost on restart
+type MemoryStore struct {
+	mu         sync.RWMutex
+	maxRecords int
+	// records oldest first, evicted once maxRecords is reached
+	records []*Record
+	byUUID  map[string][]*Record
+}
+
+// NewMemoryStore keep at most maxRecords records, zero means no limit
+func NewMemoryStore(maxRecords int) *MemoryStore {
+	return &MemoryStore{maxRecords: maxRecords, byUUID: map[string][]*Record{}}
+}
+
+// Record :nodoc:
+func (s *MemoryStore) Record(records []*Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, rec := range records {
+		if s.maxRecords > 0 && len(s.records) >= s.maxRecords {
+			s.evictOldest()
+		}
+
+		s.records = append(s.records, rec)
+		for _, uuid := range recordUUIDs(rec) {
+			s.byUUID[uuid] = append(s.byUUID[uuid], rec)
+		}
+	}
+	return nil
+}
+
+// Find the records of either the original or the new task UUID, the latest first
+func (s *MemoryStore) Find(uuid string, size int64) ([]*Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	indexed := s.byUUID[uuid]
+	var records []*Record
+	for i := len(indexed) - 1; i >= 0 && int64(len(records)) < size; i-- {
+		records = append(records, indexed[i])
+	}
+	return records, nil
+}
+
+// evictOldest the oldest record is also the first of its UUIDs. s.mu must be held
+func (s *MemoryStore) evictOldest() {
+	oldest := s.records[0]
+	s.records[0] = nil
+	s.records = s.records[1:]
+
+	for _, uuid := range recordUUIDs(oldest) {
+		if len(s.byUUID[uuid]) <= 1 {
+			delete(s.byUUID, uuid)
+			continue
+		}
+		s.byUUID[uuid] = s.byUUID[uuid][1:]
+	}
+}
+
+// recordUUIDs the distinct UUIDs the record is found by
+func recordUUIDs(rec *Record) []string {
+	if rec.NewUUID == rec.OriginalUUID {
+		return []string{rec.OriginalUUID}
+	}
+	return []string{rec.OriginalUUID, rec.NewUUID}
+}
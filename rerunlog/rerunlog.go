@@ -0,0 +1,140 @@
+package rerunlog
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/sirupsen/logrus"
+)
+
+// Record a successful rerun, keyed by both the original and the new task UUID.
+// Unlike the audit log, the records are always kept, since the result backend may drop what the dashboard
+// needs to show afterwards, e.g. the DynamoDB backend resets the signature holding the ETA of a scheduled rerun
+type Record struct {
+	ID           string `json:"id"`
+	OriginalUUID string `json:"original_uuid"`
+	// NewUUID of the resent task, the same as OriginalUUID when the rerun keeps the UUID
+	NewUUID string `json:"new_uuid"`
+	// ETA is nil when the task is rerun immediately
+	ETA       *time.Time `json:"eta,omitempty"`
+	Actor     string     `json:"actor,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ScheduledAt return the ETA when the rerun is still scheduled to run in the future
+func (r *Record) ScheduledAt(now time.Time) *time.Time {
+	if r.ETA == nil || !r.ETA.After(now) {
+		return nil
+	}
+	return r.ETA
+}
+
+type store interface {
+	Record(records []*Record) error
+}
+
+// recordedDashboard record the successful reruns of the wrapped Dashboard into the store
+type recordedDashboard struct {
+	dashboard.Dashboard
+	store store
+	actor string
+	now   func() time.Time
+}
+
+// NewDashboard wrap the Dashboard recording every successful rerun, the other methods are passed through.
+// The actor set with dashboard.WithActor is passed to the wrapped Dashboard as well
+func NewDashboard(d dashboard.Dashboard, s store) dashboard.Dashboard {
+	return &recordedDashboard{Dashboard: d, store: s, now: time.Now}
+}
+
+// WithActor :nodoc:
+func (d *recordedDashboard) WithActor(actor string) dashboard.Dashboard {
+	rd := *d
+	rd.Dashboard = dashboard.WithActor(d.Dashboard, actor)
+	rd.actor = actor
+	return &rd
+}
+
+// RerunTask :nodoc:
+func (d *recordedDashboard) RerunTask(uuid string) error {
+	err := d.Dashboard.RerunTask(uuid)
+	if err == nil {
+		d.record(d.newRecord(uuid, uuid, nil))
+	}
+	return err
+}
+
+// EditAndRerunTask :nodoc:
+func (d *recordedDashboard) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	err := d.Dashboard.EditAndRerunTask(uuid, edit)
+	if err != nil {
+		return err
+	}
+
+	rec := d.newRecord(uuid, uuid, nil)
+	if edit != nil {
+		rec.ETA = edit.ETA
+		if edit.NewUUID != "" {
+			rec.NewUUID = edit.NewUUID
+		}
+	}
+	d.record(rec)
+	return nil
+}
+
+// BulkRerunTasks :nodoc:
+func (d *recordedDashboard) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	report, err := d.Dashboard.BulkRerunTasks(req)
+	if report != nil {
+		d.record(d.newRecords(report.Results, req.ETA)...)
+	}
+	return report, err
+}
+
+// RerunGroup :nodoc:
+func (d *recordedDashboard) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	report, err := d.Dashboard.RerunGroup(groupUUID, all)
+	if report != nil {
+		d.record(d.newRecords(report.Results, nil)...)
+	}
+	return report, err
+}
+
+func (d *recordedDashboard) newRecord(originalUUID, newUUID string, eta *time.Time) *Record {
+	return &Record{
+		ID:           uuid.New().String(),
+		OriginalUUID: originalUUID,
+		NewUUID:      newUUID,
+		ETA:          eta,
+		Actor:        d.actor,
+		CreatedAt:    d.now().UTC(),
+	}
+}
+
+func (d *recordedDashboard) newRecords(results []*dashboard.BulkRerunResult, eta *time.Time) []*Record {
+	var records []*Record
+	for _, res := range results {
+		if !res.Success {
+			continue
+		}
+
+		newUUID := res.UUID
+		if res.NewUUID != "" {
+			newUUID = res.NewUUID
+		}
+		records = append(records, d.newRecord(res.UUID, newUUID, eta))
+	}
+	return records
+}
+
+// record only log the failure, since the tasks are already rerun
+func (d *recordedDashboard) record(records ...*Record) {
+	if len(records) == 0 {
+		return
+	}
+
+	if err := d.store.Record(records); err != nil {
+		logrus.WithField("actor", d.actor).Error(err)
+	}
+}
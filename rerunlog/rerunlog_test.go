@@ -0,0 +1,116 @@
+package rerunlog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardStub only implement the rerun methods
+type dashboardStub struct {
+	dashboard.Dashboard
+	err   error
+	actor string
+}
+
+func (d *dashboardStub) WithActor(actor string) dashboard.Dashboard {
+	ds := *d
+	ds.actor = actor
+	return &ds
+}
+
+func (d *dashboardStub) RerunTask(uuid string) error {
+	return d.err
+}
+
+func (d *dashboardStub) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	return d.err
+}
+
+func (d *dashboardStub) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	return &dashboard.BulkRerunReport{
+		Results: []*dashboard.BulkRerunResult{{UUID: "1", NewUUID: "task_1", Success: true}, {UUID: "2", Error: "gotcha"}},
+	}, nil
+}
+
+func (d *dashboardStub) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	return &dashboard.GroupRerunReport{GroupUUID: groupUUID, Results: []*dashboard.BulkRerunResult{{UUID: "3", Success: true}}}, nil
+}
+
+func findAll(t *testing.T, store *MemoryStore, uuid string) []*Record {
+	records, err := store.Find(uuid, 100)
+	require.NoError(t, err)
+	return records
+}
+
+func Test_RecordedDashboard(t *testing.T) {
+	store := NewMemoryStore(100)
+	stub := &dashboardStub{}
+	d := NewDashboard(stub, store)
+
+	require.NoError(t, d.RerunTask("0"))
+
+	alice := dashboard.WithActor(d, "alice")
+	assert.Equal(t, "alice", alice.(*recordedDashboard).Dashboard.(*dashboardStub).actor, "the actor is passed through")
+
+	eta := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, alice.EditAndRerunTask("1", &dashboard.RerunEdit{ETA: &eta, NewUUID: "task_0"}))
+
+	_, err := alice.BulkRerunTasks(&dashboard.BulkRerunRequest{UUIDs: []string{"1", "2"}, ETA: &eta})
+	require.NoError(t, err)
+
+	_, err = alice.RerunGroup("group", false)
+	require.NoError(t, err)
+
+	stub.err = errors.New("gotcha")
+	assert.Error(t, d.RerunTask("4"))
+
+	assert.Len(t, findAll(t, store, "0"), 1)
+	assert.Empty(t, findAll(t, store, "2"), "failed reruns aren't recorded")
+	assert.Empty(t, findAll(t, store, "4"), "failed reruns aren't recorded")
+	assert.Len(t, findAll(t, store, "3"), 1)
+
+	records := findAll(t, store, "1")
+	require.Len(t, records, 2)
+	assert.Equal(t, "task_1", records[0].NewUUID)
+	assert.Equal(t, &eta, records[0].ETA)
+	assert.Equal(t, "task_0", records[1].NewUUID)
+	assert.Equal(t, "alice", records[1].Actor)
+
+	records = findAll(t, store, "task_0")
+	require.Len(t, records, 1)
+	assert.Equal(t, "1", records[0].OriginalUUID)
+}
+
+func Test_MemoryStore(t *testing.T) {
+	store := NewMemoryStore(2)
+	require.NoError(t, store.Record([]*Record{
+		{ID: "a", OriginalUUID: "1", NewUUID: "task_1"},
+		{ID: "b", OriginalUUID: "1", NewUUID: "1"},
+		{ID: "c", OriginalUUID: "2", NewUUID: "2"},
+	}))
+
+	records := findAll(t, store, "1")
+	require.Len(t, records, 1, "the oldest record is evicted")
+	assert.Equal(t, "b", records[0].ID)
+	assert.Empty(t, findAll(t, store, "task_1"))
+	assert.Len(t, findAll(t, store, "2"), 1)
+
+	records, err := store.Find("2", 0)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func Test_Record_ScheduledAt(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	assert.Equal(t, &future, (&Record{ETA: &future}).ScheduledAt(now))
+	assert.Nil(t, (&Record{ETA: &past}).ScheduledAt(now))
+	assert.Nil(t, (&Record{}).ScheduledAt(now))
+}
@@ -0,0 +1,75 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+)
+
+// maxBackoff cap the exponential backoff
+const maxBackoff = 24 * time.Hour
+
+// Policy rerun the failed tasks matching the task name pattern & error regex,
+// at most MaxReruns times per task, waiting Backoff doubled after every rerun
+type Policy struct {
+	Name string
+	// TaskName glob pattern, e.g. send_* , empty matches every task name
+	TaskName string
+	// Error regex matched against the task error, nil matches every error
+	Error     *regexp.Regexp
+	MaxReruns int
+	Backoff   time.Duration
+}
+
+// NewPolicy :nodoc:
+func NewPolicy(name, taskName, errorPattern string, maxReruns int, backoff time.Duration) (*Policy, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if maxReruns <= 0 {
+		return nil, fmt.Errorf("policy %s: max reruns must be positive", name)
+	}
+	if backoff < 0 {
+		return nil, fmt.Errorf("policy %s: backoff must not be negative", name)
+	}
+	if _, err := path.Match(taskName, ""); err != nil {
+		return nil, fmt.Errorf("policy %s: invalid task name pattern: %w", name, err)
+	}
+
+	p := &Policy{Name: name, TaskName: taskName, MaxReruns: maxReruns, Backoff: backoff}
+	if errorPattern != "" {
+		re, err := regexp.Compile(errorPattern)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s: invalid error pattern: %w", name, err)
+		}
+		p.Error = re
+	}
+	return p, nil
+}
+
+// Match :nodoc:
+func (p *Policy) Match(task *dashboard.TaskWithSignature) bool {
+	if p.TaskName != "" {
+		if ok, _ := path.Match(p.TaskName, task.TaskName); !ok {
+			return false
+		}
+	}
+	return p.Error == nil || p.Error.MatchString(task.Error)
+}
+
+// BackoffOf the wait before the next rerun, after reruns automatic reruns
+func (p *Policy) BackoffOf(reruns int) time.Duration {
+	backoff := p.Backoff
+	for i := 0; i < reruns && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,239 @@
+package retry
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/sirupsen/logrus"
+)
+
+// the automatic reruns are recorded on the signature headers, so they are kept with the task
+// on every result backend, survive restarts and are shown on the task detail
+const (
+	HeaderAutoReruns      = "machinerydash_auto_reruns"
+	HeaderAutoRerunAt     = "machinerydash_auto_rerun_at"
+	HeaderAutoRerunPolicy = "machinerydash_auto_rerun_policy"
+)
+
+const (
+	defaultScanLimit  = 5000
+	defaultMaxPerScan = 100
+	scanPageSize      = 100
+)
+
+// Report of a single scan
+type Report struct {
+	Scanned int
+	Rerun   int
+	// Waiting tasks are matched but still in backoff
+	Waiting int
+	// Exhausted tasks are matched but have reached the max reruns
+	Exhausted int
+	Failed    int
+}
+
+// Retrier periodically scan the FAILURE tasks and rerun the ones matching a policy
+type Retrier struct {
+	dashboard dashboard.Dashboard
+	policies  []*Policy
+	interval  time.Duration
+	now       func() time.Time
+
+	// ScanLimit the failed tasks read per scan, MaxPerScan the tasks rerun per scan
+	ScanLimit  int
+	MaxPerScan int
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+type dueTask struct {
+	task    *dashboard.TaskWithSignature
+	sig     *tasks.Signature
+	policy  *Policy
+	attempt int
+}
+
+// NewRetrier the first matching policy is applied to a task
+func NewRetrier(d dashboard.Dashboard, policies []*Policy, interval time.Duration) *Retrier {
+	return &Retrier{
+		dashboard:  d,
+		policies:   policies,
+		interval:   interval,
+		now:        time.Now,
+		ScanLimit:  defaultScanLimit,
+		MaxPerScan: defaultMaxPerScan,
+		stop:       make(chan struct{}),
+	}
+}
+
+// Start scan every interval until Stop is called
+func (r *Retrier) Start() {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			report, err := r.Scan()
+			if err != nil {
+				logrus.Error(err)
+			} else if report.Rerun > 0 || report.Failed > 0 {
+				logrus.WithFields(logrus.Fields{
+					"scanned":   report.Scanned,
+					"rerun":     report.Rerun,
+					"waiting":   report.Waiting,
+					"exhausted": report.Exhausted,
+					"failed":    report.Failed,
+				}).Info("auto rerun scan finished")
+			}
+
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop :nodoc:
+func (r *Retrier) Stop() {
+	close(r.stop)
+	r.wg.Wait()
+}
+
+// Scan read the FAILURE tasks first then rerun the due ones,
+// since a rerun task leaves the FAILURE state while being paged
+func (r *Retrier) Scan() (*Report, error) {
+	report := &Report{}
+	due, err := r.findDueTasks(report)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range due {
+		if err := r.rerun(d); err != nil {
+			report.Failed++
+			logrus.WithFields(logrus.Fields{"uuid": d.task.TaskUUID, "policy": d.policy.Name}).Error(err)
+			continue
+		}
+		report.Rerun++
+	}
+	return report, nil
+}
+
+func (r *Retrier) findDueTasks(report *Report) ([]*dueTask, error) {
+	filter := &dashboard.TaskFilter{State: tasks.StateFailure}
+	now := r.now()
+
+	var (
+		due    []*dueTask
+		cursor string
+	)
+	for {
+		taskStates, next, err := r.dashboard.FindAllTasks(filter, cursor, true, scanPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find failed tasks: %w", err)
+		}
+
+		for _, task := range taskStates {
+			if report.Scanned >= r.ScanLimit || len(due) >= r.MaxPerScan {
+				return due, nil
+			}
+			report.Scanned++
+
+			d, ok := r.dueTaskOf(task, now, report)
+			if ok {
+				due = append(due, d)
+			}
+		}
+
+		if next == "" {
+			return due, nil
+		}
+		cursor = next
+	}
+}
+
+func (r *Retrier) dueTaskOf(task *dashboard.TaskWithSignature, now time.Time, report *Report) (*dueTask, bool) {
+	policy := r.policyOf(task)
+	if policy == nil {
+		return nil, false
+	}
+
+	sig := &tasks.Signature{}
+	if err := task.UnmarshalSignature(sig); err != nil {
+		logrus.WithField("uuid", task.TaskUUID).Error(fmt.Errorf("failed to unmarshal: %w", err))
+		return nil, false
+	}
+
+	reruns, lastRerunAt := autoRerunsOf(sig.Headers)
+	if reruns >= policy.MaxReruns {
+		report.Exhausted++
+		return nil, false
+	}
+
+	// the first rerun backoff starts from when the task was sent
+	since := lastRerunAt
+	if since.IsZero() {
+		since, _ = dashboard.ParseCreatedAt(task.CreatedAt)
+	}
+	if now.Before(since.Add(policy.BackoffOf(reruns))) {
+		report.Waiting++
+		return nil, false
+	}
+
+	return &dueTask{task: task, sig: sig, policy: policy, attempt: reruns + 1}, true
+}
+
+func (r *Retrier) policyOf(task *dashboard.TaskWithSignature) *Policy {
+	for _, policy := range r.policies {
+		if policy.Match(task) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// rerun record the attempt on the headers, the other headers are kept.
+// The task keeps its uuid even when rerun.fresh_uuid is set, the attempts are counted on the task
+func (r *Retrier) rerun(d *dueTask) error {
+	headers := tasks.Headers{}
+	for key, val := range d.sig.Headers {
+		headers[key] = val
+	}
+	headers[HeaderAutoReruns] = strconv.Itoa(d.attempt)
+	headers[HeaderAutoRerunAt] = r.now().UTC().Format(time.RFC3339)
+	headers[HeaderAutoRerunPolicy] = d.policy.Name
+
+	actor := fmt.Sprintf("auto-rerun policy %s", d.policy.Name)
+	if err := dashboard.WithActor(r.dashboard, actor).EditAndRerunTask(d.task.TaskUUID, &dashboard.RerunEdit{Headers: headers}); err != nil {
+		return fmt.Errorf("failed to auto rerun: %w", err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"uuid":      d.task.TaskUUID,
+		"task_name": d.task.TaskName,
+		"policy":    d.policy.Name,
+		"attempt":   d.attempt,
+	}).Info("task auto rerun")
+	return nil
+}
+
+// autoRerunsOf read the recorded reruns, the header values are strings but a hand edited number is accepted too
+func autoRerunsOf(headers tasks.Headers) (reruns int, lastRerunAt time.Time) {
+	if v, ok := headers[HeaderAutoReruns]; ok {
+		reruns, _ = strconv.Atoi(fmt.Sprint(v))
+	}
+	if v, ok := headers[HeaderAutoRerunAt].(string); ok {
+		lastRerunAt, _ = time.Parse(time.RFC3339, v)
+	}
+	return reruns, lastRerunAt
+}
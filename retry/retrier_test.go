@@ -0,0 +1,169 @@
+package retry
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardStub list the tasks one per page and record the edited reruns
+type dashboardStub struct {
+	dashboard.Dashboard
+	tasks    []*dashboard.TaskWithSignature
+	edits    map[string]*dashboard.RerunEdit
+	err      error
+	rerunErr error
+}
+
+func (d *dashboardStub) FindAllTasks(filter *dashboard.TaskFilter, cursor string, asc bool, size int64) ([]*dashboard.TaskWithSignature, string, error) {
+	if d.err != nil {
+		return nil, "", d.err
+	}
+
+	var matched []*dashboard.TaskWithSignature
+	for _, task := range d.tasks {
+		if filter.Match(task) {
+			matched = append(matched, task)
+		}
+	}
+
+	i := 0
+	if cursor != "" {
+		i = int(cursor[0] - '0')
+	}
+	if i >= len(matched) {
+		return nil, "", nil
+	}
+
+	next := ""
+	if i+1 < len(matched) {
+		next = string(rune('0' + i + 1))
+	}
+	return matched[i : i+1], next, nil
+}
+
+func (d *dashboardStub) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	if d.rerunErr != nil {
+		return d.rerunErr
+	}
+	d.edits[uuid] = edit
+	return nil
+}
+
+func newFailedTask(t *testing.T, uuid, taskName, errMsg string, createdAt time.Time, headers tasks.Headers) *dashboard.TaskWithSignature {
+	bt, err := json.Marshal(&tasks.Signature{UUID: uuid, Name: taskName, Headers: headers})
+	require.NoError(t, err)
+
+	return &dashboard.TaskWithSignature{
+		TaskUUID:  uuid,
+		State:     tasks.StateFailure,
+		TaskName:  taskName,
+		Error:     errMsg,
+		Signature: string(bt),
+		CreatedAt: createdAt.Format(time.RFC3339Nano),
+	}
+}
+
+func Test_Retrier_Scan(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := NewPolicy("transient", "send_*", "timeout", 3, time.Minute)
+	require.NoError(t, err)
+
+	newRetrierMock := func(d *dashboardStub) *Retrier {
+		d.edits = map[string]*dashboard.RerunEdit{}
+		r := NewRetrier(d, []*Policy{policy}, time.Minute)
+		r.now = func() time.Time { return now }
+		return r
+	}
+
+	t.Run("rerun the due tasks recording the attempt", func(t *testing.T) {
+		d := &dashboardStub{tasks: []*dashboard.TaskWithSignature{
+			newFailedTask(t, "due", "send_email", "i/o timeout", now.Add(-2*time.Minute), tasks.Headers{"trace": "abc"}),
+			newFailedTask(t, "backoff", "send_email", "i/o timeout", now.Add(-30*time.Second), nil),
+			newFailedTask(t, "second", "send_sms", "timeout", now, tasks.Headers{
+				HeaderAutoReruns:  "1",
+				HeaderAutoRerunAt: now.Add(-time.Minute).Format(time.RFC3339),
+			}),
+			newFailedTask(t, "exhausted", "send_email", "timeout", now.Add(-time.Hour), tasks.Headers{HeaderAutoReruns: "3"}),
+			newFailedTask(t, "other error", "send_email", "invalid email", now.Add(-time.Hour), nil),
+			newFailedTask(t, "other name", "resize", "timeout", now.Add(-time.Hour), nil),
+		}}
+		r := newRetrierMock(d)
+
+		report, err := r.Scan()
+		require.NoError(t, err)
+		assert.Equal(t, &Report{Scanned: 6, Rerun: 1, Waiting: 2, Exhausted: 1}, report)
+
+		require.Contains(t, d.edits, "due")
+		assert.Equal(t, tasks.Headers{
+			"trace":               "abc",
+			HeaderAutoReruns:      "1",
+			HeaderAutoRerunAt:     "2020-01-01T12:00:00Z",
+			HeaderAutoRerunPolicy: "transient",
+		}, d.edits["due"].Headers)
+
+		// the second rerun wait double the backoff since the first rerun
+		r.now = func() time.Time { return now.Add(time.Minute) }
+		report, err = r.Scan()
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.Rerun)
+		assert.Equal(t, "2", d.edits["second"].Headers[HeaderAutoReruns])
+	})
+
+	t.Run("max per scan", func(t *testing.T) {
+		d := &dashboardStub{tasks: []*dashboard.TaskWithSignature{
+			newFailedTask(t, "1", "send_email", "timeout", now.Add(-time.Hour), nil),
+			newFailedTask(t, "2", "send_email", "timeout", now.Add(-time.Hour), nil),
+		}}
+		r := newRetrierMock(d)
+		r.MaxPerScan = 1
+
+		report, err := r.Scan()
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Rerun)
+		assert.Contains(t, d.edits, "1")
+	})
+
+	t.Run("handle rerun error", func(t *testing.T) {
+		d := &dashboardStub{
+			tasks:    []*dashboard.TaskWithSignature{newFailedTask(t, "1", "send_email", "timeout", now.Add(-time.Hour), nil)},
+			rerunErr: errors.New("gotcha"),
+		}
+
+		report, err := newRetrierMock(d).Scan()
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Failed)
+	})
+
+	t.Run("handle FindAllTasks error", func(t *testing.T) {
+		_, err := newRetrierMock(&dashboardStub{err: errors.New("gotcha")}).Scan()
+		assert.Error(t, err)
+	})
+}
+
+func Test_Policy(t *testing.T) {
+	t.Run("backoff doubled & capped", func(t *testing.T) {
+		p, err := NewPolicy("p", "", "", 100, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, p.BackoffOf(0))
+		assert.Equal(t, 4*time.Minute, p.BackoffOf(2))
+		assert.Equal(t, maxBackoff, p.BackoffOf(50))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewPolicy("", "", "", 1, 0)
+		assert.Error(t, err)
+		_, err = NewPolicy("p", "", "", 0, 0)
+		assert.Error(t, err)
+		_, err = NewPolicy("p", "[", "", 1, 0)
+		assert.Error(t, err)
+		_, err = NewPolicy("p", "", "(", 1, 0)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/go-utils"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type stateResponse struct {
+	Name string `json:"name"`
+	// Rerunnable whether the tasks of the state can be rerun by the api, the api rerun the tasks of any state
+	// unless the result backend can't rerun, see DisableRerun
+	Rerunnable bool `json:"rerunnable"`
+	Default    bool `json:"default"`
+}
+
+type taskResponse struct {
+	TaskUUID  string          `json:"task_uuid"`
+	State     string          `json:"state"`
+	TaskName  string          `json:"task_name"`
+	Signature json.RawMessage `json:"signature,omitempty"`
+	CreatedAt string          `json:"created_at"`
+	Error     string          `json:"error"`
+}
+
+// rerunRequest is the optional body of the rerun api, the task is resent as it is when empty
+type rerunRequest struct {
+	Args       []tasks.Arg   `json:"args"`
+	RoutingKey *string       `json:"routing_key"`
+	Headers    tasks.Headers `json:"headers"`
+	Priority   *uint8        `json:"priority"`
+	RetryCount *int          `json:"retry_count"`
+	rerunSchedule
+}
+
+type rerunResponse struct {
+	Message string `json:"message"`
+	// NewUUID of the new task when the task is rerun as a new task
+	NewUUID string     `json:"new_uuid,omitempty"`
+	ETA     *time.Time `json:"eta,omitempty"`
+}
+
+type workflowResponse struct {
+	*dashboard.WorkflowGraph
+	StoppedAt *dashboard.WorkflowNode `json:"stopped_at"`
+}
+
+type listTaskResponse struct {
+	Tasks []*taskResponse `json:"tasks"`
+	Next  string          `json:"next"`
+	Size  int64           `json:"size"`
+	Order string          `json:"order"`
+}
+
+func newTaskResponse(t *dashboard.TaskWithSignature) *taskResponse {
+	res := &taskResponse{
+		TaskUUID:  t.TaskUUID,
+		State:     t.State,
+		TaskName:  t.TaskName,
+		CreatedAt: t.CreatedAt,
+		Error:     t.Error,
+	}
+
+	// signature is stored as json string, keep it as json object on the response
+	if json.Valid([]byte(t.Signature)) {
+		res.Signature = json.RawMessage(t.Signature)
+	}
+
+	return res
+}
+
+func (s *Server) handleAPIListStates(ec echo.Context) error {
+	states := make([]stateResponse, 0, len(stateList))
+	for _, st := range stateList {
+		states = append(states, stateResponse{
+			Name:       st,
+			Rerunnable: !s.rerunDisabled,
+			Default:    st == tasks.StateFailure,
+		})
+	}
+
+	return ec.JSON(http.StatusOK, map[string]interface{}{"states": states})
+}
+
+func (s *Server) handleAPICountTasks(ec echo.Context) error {
+	counts, err := s.machineryDash.CountTasks(stateList)
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to count tasks"))
+	}
+
+	return ec.JSON(http.StatusOK, map[string]interface{}{"counts": counts})
+}
+
+func (s *Server) handleAPIListTasksByState(ec echo.Context) error {
+	cursor := ec.QueryParam("cursor")
+	size := utils.StringToInt64(ec.QueryParam("size"))
+	state := strings.ToUpper(ec.QueryParam("state"))
+
+	if strings.TrimSpace(state) == "" {
+		state = tasks.StateFailure
+	}
+
+	if !isValidState(state) {
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid state"))
+	}
+
+	switch {
+	case size < 0:
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid size"))
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	filter, err := s.newTaskFilter(ec, state)
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	order, err := parseOrder(ec.QueryParam("order"))
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	taskStates, next, err := s.machineryDash.FindAllTasks(filter, cursor, order == orderAsc, size)
+	switch {
+	case errors.Is(err, dashboard.ErrInvalidCursor):
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid cursor"))
+	case errors.Is(err, dashboard.ErrSortUnsupported):
+		return ec.JSON(http.StatusBadRequest, fmtErr(errSortUnsupportedMessage))
+	case err != nil:
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find tasks"))
+	}
+
+	res := &listTaskResponse{
+		Tasks: make([]*taskResponse, 0, len(taskStates)),
+		Next:  next,
+		Size:  size,
+		Order: order,
+	}
+	for _, t := range taskStates {
+		res.Tasks = append(res.Tasks, newTaskResponse(t))
+	}
+
+	return ec.JSON(http.StatusOK, res)
+}
+
+func (s *Server) handleAPIFindTaskByUUID(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	task, err := s.machineryDash.FindTaskByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("task not found"))
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find task"))
+	}
+
+	return ec.JSON(http.StatusOK, newTaskResponse(task))
+}
+
+func (s *Server) handleAPIRerunTask(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	req, err := decodeRerunRequest(ec.Request().Body)
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid request"))
+	}
+
+	var edit *dashboard.RerunEdit
+	freshUUID := s.freshUUID
+	if req != nil {
+		edit, err = req.toRerunEdit(time.Now())
+		if err != nil {
+			return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+		}
+		freshUUID = req.freshUUID(freshUUID)
+	}
+
+	newUUID, err := dashboard.RerunTaskWithEdit(s.dashboardOf(ec), uuid, edit, freshUUID)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("task not found"))
+	case errors.Is(err, dashboard.ErrRerunUnsupported):
+		return ec.JSON(http.StatusNotImplemented, fmtErr(err.Error()))
+	case errors.Is(err, dashboard.ErrInvalidEdit):
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to rerun task"))
+	}
+
+	res := rerunResponse{Message: "ok"}
+	if newUUID != uuid {
+		res.NewUUID = newUUID
+	}
+	if edit != nil {
+		res.ETA = edit.ETA
+	}
+	return ec.JSON(http.StatusOK, res)
+}
+
+func (s *Server) handleAPIFindTaskWorkflow(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	graph, err := dashboard.BuildWorkflowGraph(s.machineryDash, uuid, ec.QueryParam("parent"))
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("task not found"))
+	case errors.Is(err, dashboard.ErrNoSignature):
+		return ec.JSON(http.StatusNotImplemented, fmtErr(err.Error()))
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to build workflow"))
+	}
+
+	return ec.JSON(http.StatusOK, workflowResponse{
+		WorkflowGraph: graph,
+		StoppedAt:     graph.StoppedAt(),
+	})
+}
+
+// decodeRerunRequest decode the numbers as json.Number so the arg values keep their precision
+// like machinery does, return nil when the body is empty
+func decodeRerunRequest(body io.Reader) (*rerunRequest, error) {
+	req := &rerunRequest{}
+	dec := json.NewDecoder(body)
+	dec.UseNumber()
+	err := dec.Decode(req)
+	switch {
+	case err == io.EOF:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (r *rerunRequest) toRerunEdit(now time.Time) (*dashboard.RerunEdit, error) {
+	eta, err := r.rerunSchedule.resolve(now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dashboard.RerunEdit{
+		Args:       r.Args,
+		RoutingKey: r.RoutingKey,
+		Headers:    r.Headers,
+		Priority:   r.Priority,
+		RetryCount: r.RetryCount,
+		ETA:        eta,
+	}, nil
+}
+
+func isValidState(state string) bool {
+	for _, st := range stateList {
+		if st == state {
+			return true
+		}
+	}
+	return false
+}
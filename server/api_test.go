@@ -0,0 +1,375 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDashboardMock() *dashboardMock {
+	return &dashboardMock{
+		tasks: []*dashboard.TaskWithSignature{
+			{TaskUUID: "1", State: tasks.StateFailure, TaskName: "Foo", Signature: `{"UUID":"1","Name":"Foo"}`, Error: "gotcha"},
+			{TaskUUID: "2", State: tasks.StateSuccess, TaskName: "Foo", Signature: `{"UUID":"2","Name":"Foo"}`},
+		},
+	}
+}
+
+func Test_handleAPIListStates(t *testing.T) {
+	listStates := func(s *Server) []stateResponse {
+		rec := s.serve(http.MethodGet, "/api/v1/states", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := struct {
+			States []stateResponse `json:"states"`
+		}{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res.States
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		states := listStates(newServerMock(newDashboardMock()))
+		assert.Equal(t, len(stateList), len(states))
+		assert.Equal(t, stateResponse{Name: tasks.StateFailure, Rerunnable: true, Default: true}, states[0])
+		for _, st := range states {
+			assert.True(t, st.Rerunnable, "the api rerun the tasks of any state")
+		}
+	})
+
+	t.Run("rerun disabled", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		s.DisableRerun()
+
+		for _, st := range listStates(s) {
+			assert.False(t, st.Rerunnable)
+		}
+	})
+}
+
+func Test_handleAPICountTasks(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/counts", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := struct {
+			Counts []*dashboard.TaskCount `json:"counts"`
+		}{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Len(t, res.Counts, len(stateList))
+		assert.Equal(t, &dashboard.TaskCount{State: tasks.StateFailure, Total: 1, ByTaskName: map[string]int64{"Foo": 1}}, res.Counts[0])
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		md := newDashboardMock()
+		md.countErr = errors.New("gotcha")
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/counts", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func Test_handleAPIListTasksByState(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		md := newDashboardMock()
+		md.next = "next-cursor"
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?state=failure&size=1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &listTaskResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, "next-cursor", res.Next)
+		assert.Equal(t, int64(1), res.Size)
+		assert.Equal(t, 1, len(res.Tasks))
+		assert.Equal(t, "1", res.Tasks[0].TaskUUID)
+		assert.JSONEq(t, `{"UUID":"1","Name":"Foo"}`, string(res.Tasks[0].Signature))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?state=PENDING", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"tasks":[],"next":"","size":10,"order":"asc"}`, rec.Body.String())
+	})
+
+	t.Run("clamp the size", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?size=1000000", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, int64(maxPageSize), md.size)
+
+		res := &listTaskResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, int64(maxPageSize), res.Size)
+	})
+
+	t.Run("newest first", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?order=desc", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.False(t, md.asc)
+
+		res := &listTaskResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, orderDesc, res.Order)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?order=random", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid order, must be asc or desc"}`, rec.Body.String())
+	})
+
+	t.Run("sort order is not supported", func(t *testing.T) {
+		md := newDashboardMock()
+		md.err = dashboard.ErrSortUnsupported
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?order=desc", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("filter by task name", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks = append(md.tasks, &dashboard.TaskWithSignature{TaskUUID: "3", State: tasks.StateFailure, TaskName: "Bar"})
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?task_name=Bar", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, &dashboard.TaskFilter{State: tasks.StateFailure, TaskName: "Bar"}, md.filter)
+
+		res := &listTaskResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.Equal(t, 1, len(res.Tasks))
+		assert.Equal(t, "3", res.Tasks[0].TaskUUID)
+	})
+
+	t.Run("filter by created at", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?from=2020-12-10T07:00:00Z&to=2020-12-10T08:00", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC), md.filter.CreatedAfter)
+		assert.Equal(t, time.Date(2020, 12, 10, 8, 0, 0, 0, time.UTC), md.filter.CreatedBefore)
+	})
+
+	t.Run("invalid created at range", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?from=yesterday", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid from"}`, rec.Body.String())
+
+		rec = s.serve(http.MethodGet, "/api/v1/tasks?from=2020-12-10T08:00&to=2020-12-10T07:00", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"from must be before to"}`, rec.Body.String())
+	})
+
+	t.Run("invalid match", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?task_name=Bar&match=regex", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid match, must be exact or prefix"}`, rec.Body.String())
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?state=UNKNOWN", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid state"}`, rec.Body.String())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		md := newDashboardMock()
+		md.err = dashboard.ErrInvalidCursor
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks?cursor=abc", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		md := newDashboardMock()
+		md.err = errors.New("gotcha")
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func Test_handleAPIFindTaskByUUID(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &taskResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, "gotcha", res.Error)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/99", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.JSONEq(t, `{"error":"task not found"}`, rec.Body.String())
+	})
+}
+
+func Test_handleAPIRerunTask(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"1"}, md.reruns)
+		assert.Empty(t, md.edits)
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{
+			"args": [{"type": "int64", "value": 1607416299930351698}],
+			"routing_key": "bar-service",
+			"headers": {"trace-id": "abc"},
+			"priority": 2,
+			"retry_count": 3,
+			"eta": "2099-12-11T07:00:00Z"
+		}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.Len(t, md.edits, 1)
+
+		edit := md.edits[0]
+		assert.Equal(t, []tasks.Arg{{Type: "int64", Value: json.Number("1607416299930351698")}}, edit.Args)
+		assert.Equal(t, "bar-service", *edit.RoutingKey)
+		assert.Equal(t, tasks.Headers{"trace-id": "abc"}, edit.Headers)
+		assert.Equal(t, uint8(2), *edit.Priority)
+		assert.Equal(t, 3, *edit.RetryCount)
+		assert.Equal(t, time.Date(2099, 12, 11, 7, 0, 0, 0, time.UTC), *edit.ETA)
+		assert.JSONEq(t, `{"message":"ok","eta":"2099-12-11T07:00:00Z"}`, rec.Body.String())
+	})
+
+	t.Run("invalid edit", func(t *testing.T) {
+		md := newDashboardMock()
+		md.editErr = fmt.Errorf("%w: expected 1 args, got 0", dashboard.ErrInvalidEdit)
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{"args": []}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid edit: expected 1 args, got 0"}`, rec.Body.String())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{"priority": 256}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{"delay": "1h", "eta": "2099-12-11T07:00:00Z"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"either eta or delay is allowed"}`, rec.Body.String())
+	})
+
+	t.Run("delayed", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{"delay": "10m"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &rerunResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.NotNil(t, res.ETA)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), *res.ETA, time.Minute)
+		require.Len(t, md.edits, 1)
+		assert.Nil(t, md.edits[0].Args)
+	})
+
+	t.Run("rerun unsupported", func(t *testing.T) {
+		md := newDashboardMock()
+		md.editErr = dashboard.ErrRerunUnsupported
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", `{}`)
+		assert.Equal(t, http.StatusNotImplemented, rec.Code)
+		assert.JSONEq(t, `{"error":"rerun is not supported by the result backend"}`, rec.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/99/rerun", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		md := newDashboardMock()
+		md.err = errors.New("gotcha")
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func Test_handleAPIFindTaskWorkflow(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].Signature = `{"UUID":"1","Name":"Foo","OnSuccess":[{"UUID":"2","Name":"Foo"}]}`
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/1/workflow", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"root": {
+				"task_uuid": "1",
+				"task_name": "Foo",
+				"state": "FAILURE",
+				"relation": "self",
+				"children": [{"task_uuid": "2", "task_name": "Foo", "state": "SUCCESS", "relation": "on_success", "parent_uuid": "1"}]
+			},
+			"stopped_at": {
+				"task_uuid": "1",
+				"task_name": "Foo",
+				"state": "FAILURE",
+				"relation": "self",
+				"children": [{"task_uuid": "2", "task_name": "Foo", "state": "SUCCESS", "relation": "on_success", "parent_uuid": "1"}]
+			}
+		}`, rec.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/99/workflow", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kumparan/go-utils"
+	"github.com/kumparan/machinerydash/audit"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type auditStore interface {
+	Find(filter *audit.Filter, cursor string, size int64) (entries []*audit.Entry, next string, err error)
+}
+
+var auditActions = []string{audit.ActionRerun, audit.ActionEditRerun, audit.ActionBulkRerun, audit.ActionGroupRerun}
+
+type listAuditData struct {
+	ListStates []string
+	Actions    []string
+	Enabled    bool
+	Entries    []*audit.Entry
+	Filter     *audit.Filter
+	cursorInfo
+}
+
+// FilterQuery keep the filter on the pagination links
+func (d listAuditData) FilterQuery() template.URL {
+	q := url.Values{}
+	if d.Filter.Actor != "" {
+		q.Set("actor", d.Filter.Actor)
+	}
+	if d.Filter.Action != "" {
+		q.Set("action", d.Filter.Action)
+	}
+	if d.Filter.UUID != "" {
+		q.Set("uuid", d.Filter.UUID)
+	}
+	return encodeFilterQuery(q)
+}
+
+type listAuditResponse struct {
+	Entries []*audit.Entry `json:"entries"`
+	Next    string         `json:"next"`
+	Size    int64          `json:"size"`
+}
+
+// EnableAuditLog show the reruns recorded by audit.NewDashboard on the audit page & API,
+// the reruns are attributed to the actorHeader value or the client IP when it is empty or missing.
+// The header is trusted as is, so it must only be set behind a proxy that strips and sets it
+func (s *Server) EnableAuditLog(store auditStore, actorHeader string) {
+	s.auditStore = store
+	s.actorHeader = actorHeader
+}
+
+// dashboardOf attribute the reruns to the requester
+func (s *Server) dashboardOf(ec echo.Context) dashboard.Dashboard {
+	return dashboard.WithActor(s.machineryDash, s.actorOf(ec))
+}
+
+func (s *Server) actorOf(ec echo.Context) string {
+	if s.actorHeader != "" {
+		if actor := strings.TrimSpace(ec.Request().Header.Get(s.actorHeader)); actor != "" {
+			return actor
+		}
+	}
+	return "ip:" + ec.RealIP()
+}
+
+func (s *Server) handleListAudit(ec echo.Context) error {
+	data := listAuditData{
+		ListStates: stateList,
+		Actions:    auditActions,
+		Enabled:    s.auditStore != nil,
+		Filter:     newAuditFilter(ec),
+		cursorInfo: newCursorInfo(ec),
+	}
+	if !data.Enabled {
+		return ec.Render(http.StatusOK, "audit.html", data)
+	}
+
+	entries, next, err := s.auditStore.Find(data.Filter, data.Current, data.Size)
+	switch {
+	case errors.Is(err, audit.ErrInvalidCursor):
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid cursor"))
+	case err != nil:
+		logrus.Error(err)
+		return ec.String(http.StatusInternalServerError, "something wrong")
+	}
+
+	data.Entries = entries
+	data.cursorInfo = data.withNext(next)
+	return ec.Render(http.StatusOK, "audit.html", data)
+}
+
+func (s *Server) handleAPIListAudit(ec echo.Context) error {
+	if s.auditStore == nil {
+		return ec.JSON(http.StatusNotFound, fmtErr("audit log is disabled"))
+	}
+
+	size := utils.StringToInt64(ec.QueryParam("size"))
+	switch {
+	case size < 0:
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid size"))
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	entries, next, err := s.auditStore.Find(newAuditFilter(ec), ec.QueryParam("cursor"), size)
+	switch {
+	case errors.Is(err, audit.ErrInvalidCursor):
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid cursor"))
+	case err != nil:
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find audit entries"))
+	}
+
+	if entries == nil {
+		entries = []*audit.Entry{}
+	}
+	return ec.JSON(http.StatusOK, &listAuditResponse{Entries: entries, Next: next, Size: size})
+}
+
+func newAuditFilter(ec echo.Context) *audit.Filter {
+	return &audit.Filter{
+		Actor:  strings.TrimSpace(ec.QueryParam("actor")),
+		Action: strings.TrimSpace(ec.QueryParam("action")),
+		UUID:   strings.TrimSpace(ec.QueryParam("uuid")),
+	}
+}
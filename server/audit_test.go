@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kumparan/machinerydash/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type auditStoreStub struct {
+	entries []*audit.Entry
+	filter  *audit.Filter
+	err     error
+}
+
+func (s *auditStoreStub) Find(filter *audit.Filter, cursor string, size int64) ([]*audit.Entry, string, error) {
+	s.filter = filter
+	return s.entries, "", s.err
+}
+
+func Test_AuditLog(t *testing.T) {
+	t.Run("rerun attributed to the actor header", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "audit")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		store, err := audit.NewFileStore(filepath.Join(dir, "audit.log"))
+		require.NoError(t, err)
+
+		md := newDashboardMock()
+		s := newServerMock(audit.NewDashboard(md, store))
+		s.EnableAuditLog(store, "X-Forwarded-User")
+		require.NoError(t, s.initRenderer())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/1/rerun", nil)
+		req.Header.Set("X-Forwarded-User", "alice")
+		rec := httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = s.serve(http.MethodPost, "/rerun", `{"uuid":"1"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = s.serve(http.MethodGet, "/api/v1/audit?uuid=1", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := &listAuditResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.Len(t, res.Entries, 2)
+		assert.Equal(t, "ip:192.0.2.1", res.Entries[0].Actor)
+		assert.Equal(t, "alice", res.Entries[1].Actor)
+		assert.Equal(t, audit.ActionRerun, res.Entries[1].Action)
+
+		rec = s.serve(http.MethodGet, "/audit?actor=alice", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "alice")
+	})
+
+	t.Run("actor header is ignored unless enabled", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "audit")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		store, err := audit.NewFileStore(filepath.Join(dir, "audit.log"))
+		require.NoError(t, err)
+
+		s := newServerMock(audit.NewDashboard(newDashboardMock(), store))
+		s.EnableAuditLog(store, "")
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/1/rerun", nil)
+		req.Header.Set("X-Forwarded-User", "alice")
+		rec := httptest.NewRecorder()
+		s.echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		entries, _, err := store.Find(&audit.Filter{}, "", 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "ip:192.0.2.1", entries[0].Actor)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		store := &auditStoreStub{}
+		s := newServerMock(newDashboardMock())
+		s.EnableAuditLog(store, "")
+
+		rec := s.serve(http.MethodGet, "/api/v1/audit?actor=bob&action=bulk_rerun&uuid=2", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"entries":[],"next":"","size":10}`, rec.Body.String())
+		assert.Equal(t, &audit.Filter{Actor: "bob", Action: audit.ActionBulkRerun, UUID: "2"}, store.filter)
+	})
+
+	t.Run("escape the actor", func(t *testing.T) {
+		store := &auditStoreStub{entries: []*audit.Entry{{ID: "1", Actor: "<script>", OriginalUUID: "1", NewUUID: "1"}}}
+		s := newServerMock(newDashboardMock())
+		s.EnableAuditLog(store, "")
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/audit", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.False(t, strings.Contains(rec.Body.String(), "<script>"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/api/v1/audit", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = s.serve(http.MethodGet, "/audit", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "audit log is disabled")
+	})
+
+	t.Run("handle store error", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		s.EnableAuditLog(&auditStoreStub{err: errors.New("gotcha")}, "")
+
+		rec := s.serve(http.MethodGet, "/api/v1/audit", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+		s.EnableAuditLog(&auditStoreStub{err: audit.ErrInvalidCursor}, "")
+		rec = s.serve(http.MethodGet, "/api/v1/audit?cursor=x", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/job"
+	"github.com/labstack/echo/v4"
+)
+
+// dashboardMock keep the tasks in memory, the listing ignores the cursor
+type dashboardMock struct {
+	mu      sync.Mutex
+	tasks   []*dashboard.TaskWithSignature
+	next    string
+	err     error
+	editErr error
+	// countErr is returned by CountTasks
+	countErr error
+	filter   *dashboard.TaskFilter
+	asc      bool
+	size     int64
+	reruns   []string
+	edits    []*dashboard.RerunEdit
+	groups   map[string]*dashboard.Group
+}
+
+func (d *dashboardMock) FindAllTasksByState(state, cursor string, asc bool, size int64) (taskStates []*dashboard.TaskWithSignature, next string, err error) {
+	return d.FindAllTasks(&dashboard.TaskFilter{State: state}, cursor, asc, size)
+}
+
+func (d *dashboardMock) FindAllTasks(filter *dashboard.TaskFilter, cursor string, asc bool, size int64) (taskStates []*dashboard.TaskWithSignature, next string, err error) {
+	if d.err != nil {
+		return nil, "", d.err
+	}
+	d.mu.Lock()
+	d.filter = filter
+	d.asc = asc
+	d.size = size
+	d.mu.Unlock()
+
+	for _, t := range d.tasks {
+		if filter.Match(t) {
+			taskStates = append(taskStates, t)
+		}
+	}
+	return taskStates, d.next, nil
+}
+
+func (d *dashboardMock) FindTaskByUUID(uuid string) (*dashboard.TaskWithSignature, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	for _, t := range d.tasks {
+		if t.TaskUUID == uuid {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("task %s %w", uuid, dashboard.ErrNotFound)
+}
+
+func (d *dashboardMock) RerunTask(uuid string) error {
+	if _, err := d.FindTaskByUUID(uuid); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.reruns = append(d.reruns, uuid)
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *dashboardMock) EditAndRerunTask(uuid string, edit *dashboard.RerunEdit) error {
+	if d.editErr != nil {
+		return d.editErr
+	}
+
+	if err := d.RerunTask(uuid); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.edits = append(d.edits, edit)
+	d.mu.Unlock()
+	return nil
+}
+
+func (d *dashboardMock) BulkRerunTasks(req *dashboard.BulkRerunRequest) (*dashboard.BulkRerunReport, error) {
+	report := &dashboard.BulkRerunReport{Total: len(req.UUIDs), ETA: req.ETA}
+	for _, uuid := range req.UUIDs {
+		res := &dashboard.BulkRerunResult{UUID: uuid, Success: true}
+		if err := d.RerunTask(uuid); err != nil {
+			res.Success = false
+			res.Error = err.Error()
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
+
+func (d *dashboardMock) FindGroupByUUID(groupUUID string) (*dashboard.Group, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	group, ok := d.groups[groupUUID]
+	if !ok {
+		return nil, fmt.Errorf("group %s %w", groupUUID, dashboard.ErrNotFound)
+	}
+	return group, nil
+}
+
+func (d *dashboardMock) RerunGroup(groupUUID string, all bool) (*dashboard.GroupRerunReport, error) {
+	group, err := d.FindGroupByUUID(groupUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dashboard.GroupRerunReport{GroupUUID: groupUUID, ChordReset: group.ChordCallback != nil}
+	for _, gt := range group.Tasks {
+		if !all && gt.Task != nil && gt.Task.State != "FAILURE" {
+			continue
+		}
+
+		res := &dashboard.BulkRerunResult{UUID: gt.TaskUUID, Success: true}
+		if err := d.RerunTask(gt.TaskUUID); err != nil {
+			res.Success = false
+			res.Error = err.Error()
+		}
+		report.Results = append(report.Results, res)
+	}
+	return report, nil
+}
+
+func (d *dashboardMock) CountTasks(states []string) ([]*dashboard.TaskCount, error) {
+	if d.countErr != nil {
+		return nil, d.countErr
+	}
+
+	counts := make([]*dashboard.TaskCount, 0, len(states))
+	for _, state := range states {
+		count := &dashboard.TaskCount{State: state, ByTaskName: map[string]int64{}}
+		for _, t := range d.tasks {
+			if t.State == state {
+				count.Total++
+				count.ByTaskName[t.TaskName]++
+			}
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
+
+func newServerMock(md dashboard.Dashboard) *Server {
+	s := New("", md, job.NewManager(md, 1000, 1000), time.UTC)
+	s.initRoutes()
+	return s
+}
+
+func (s *Server) serve(method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+	return rec
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/go-utils"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type listFailureData struct {
+	ListStates  []string
+	EnableRerun bool
+	Overview    *dashboard.FailureOverview
+	TaskName    string
+	// TaskNamePrefix, From, To & ErrorContains are the filter as they are requested
+	TaskNamePrefix bool
+	From           string
+	To             string
+	ErrorContains  string
+	// CreatedAfter & CreatedBefore are RFC3339 filter range, used by the group rerun
+	CreatedAfter  string
+	CreatedBefore string
+}
+
+func (s *Server) handleListFailures(ec echo.Context) error {
+	filter, limit, err := s.newFailureFilter(ec)
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	overview, err := dashboard.GroupFailures(s.machineryDash, filter, limit)
+	if err != nil {
+		logrus.Error(err)
+		return ec.String(http.StatusInternalServerError, "something wrong")
+	}
+
+	data := listFailureData{
+		ListStates:     stateList,
+		EnableRerun:    !s.rerunDisabled,
+		Overview:       overview,
+		TaskName:       filter.TaskName,
+		TaskNamePrefix: filter.TaskNamePrefix,
+		From:           ec.QueryParam("from"),
+		To:             ec.QueryParam("to"),
+		ErrorContains:  filter.ErrorContains,
+	}
+	if !filter.CreatedAfter.IsZero() {
+		data.CreatedAfter = filter.CreatedAfter.Format(time.RFC3339Nano)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		data.CreatedBefore = filter.CreatedBefore.Format(time.RFC3339Nano)
+	}
+
+	return ec.Render(http.StatusOK, "failures.html", data)
+}
+
+func (s *Server) handleAPIListFailures(ec echo.Context) error {
+	filter, limit, err := s.newFailureFilter(ec)
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	overview, err := dashboard.GroupFailures(s.machineryDash, filter, limit)
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to group failures"))
+	}
+
+	return ec.JSON(http.StatusOK, overview)
+}
+
+// newFailureFilter read the task filter & the scan "limit" from the query params
+func (s *Server) newFailureFilter(ec echo.Context) (*dashboard.TaskFilter, int, error) {
+	limit := utils.StringToInt64(ec.QueryParam("limit"))
+	if limit < 0 {
+		return nil, 0, errors.New("invalid limit")
+	}
+
+	filter, err := s.newTaskFilter(ec, tasks.StateFailure)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return filter, int(limit), nil
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFailureDashboardMock() *dashboardMock {
+	md := newDashboardMock()
+	md.tasks = append(md.tasks,
+		&dashboard.TaskWithSignature{TaskUUID: "3", State: tasks.StateFailure, TaskName: "Bar", Error: "comment 3 not found", CreatedAt: "2020-12-10T07:03:00Z"},
+		&dashboard.TaskWithSignature{TaskUUID: "4", State: tasks.StateFailure, TaskName: "Bar", Error: "comment 4 not found", CreatedAt: "2020-12-10T07:04:00Z"},
+	)
+	return md
+}
+
+func Test_handleAPIListFailures(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newFailureDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/failures", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &dashboard.FailureOverview{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, 3, res.Scanned)
+		require.Len(t, res.Groups, 2)
+		assert.Equal(t, "Bar", res.Groups[0].TaskName)
+		assert.Equal(t, "comment <n> not found", res.Groups[0].ErrorSignature)
+		assert.Equal(t, 2, res.Groups[0].Count)
+		assert.Equal(t, []string{"3", "4"}, res.Groups[0].SampleUUIDs)
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		md := newFailureDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/failures?error_contains=gotcha", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "gotcha", md.filter.ErrorContains)
+
+		res := &dashboard.FailureOverview{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, "Foo", res.Groups[0].TaskName)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		s := newServerMock(newFailureDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/failures?limit=-1", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		md := newFailureDashboardMock()
+		md.err = errors.New("gotcha")
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/failures", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func Test_handleListFailures(t *testing.T) {
+	s := newServerMock(newFailureDashboardMock())
+	assert.NoError(t, s.initRenderer())
+
+	rec := s.serve(http.MethodGet, "/failures?from=2020-12-10T07:00", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, "<pre>comment &lt;n&gt; not found</pre>")
+	assert.Contains(t, body, `data-error-signature="comment &lt;n&gt; not found"`)
+	assert.Contains(t, body, `href="/tasks/3"`)
+	assert.Contains(t, body, `let createdAfter = "2020-12-10T07:00:00Z"`)
+
+	t.Run("escape the failures", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].TaskName = "<img src=x onerror=alert(1)>"
+		md.tasks[0].Error = "</pre><img src=x onerror=alert(document.cookie)>"
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/failures", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.NotContains(t, body, "<img")
+		assert.Contains(t, body, `>&lt;img src=x onerror=alert(1)&gt;</a>`)
+		assert.Contains(t, body, `<pre>&lt;/pre&gt;&lt;img src=x onerror=alert(document.cookie)&gt;</pre>`)
+		assert.Contains(t, body, `e.g. &lt;/pre&gt;&lt;img src=x onerror=alert(document.cookie)&gt;</small>`)
+
+		rec = s.serve(http.MethodGet, `/failures?error_contains=%22%3Balert(3)%2F%2F`, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `let errorContains = "\u0022;alert(3)\/\/"`)
+	})
+}
+
+func Test_rerunFailureGroup(t *testing.T) {
+	md := newFailureDashboardMock()
+	s := newServerMock(md)
+
+	rec := s.serve(http.MethodPost, "/api/v1/jobs", `{"filter":{"task_name":"Bar","error_signature":"comment <n> not found"}}`)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	res := &job.Progress{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+	assert.Eventually(t, func() bool {
+		rec := s.serve(http.MethodGet, "/api/v1/jobs/"+res.ID, "")
+		p := &job.Progress{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), p))
+		return p.Status == job.StatusCompleted && p.Done == 2
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type groupDetailData struct {
+	ListStates  []string
+	Group       *dashboard.Group
+	EnableRerun bool
+}
+
+type groupRerunRequest struct {
+	// All rerun every member instead of only the failed ones
+	All bool `json:"all"`
+}
+
+type groupResponse struct {
+	GroupUUID      string               `json:"group_uuid"`
+	TaskUUIDs      []string             `json:"task_uuids"`
+	ChordTriggered bool                 `json:"chord_triggered"`
+	Lock           bool                 `json:"lock"`
+	CreatedAt      time.Time            `json:"created_at"`
+	Tasks          []*groupTaskResponse `json:"tasks"`
+	ChordCallback  *signatureResponse   `json:"chord_callback,omitempty"`
+}
+
+// groupTaskResponse state is empty when the member state doesn't exist anymore
+type groupTaskResponse struct {
+	TaskUUID string `json:"task_uuid"`
+	State    string `json:"state"`
+	TaskName string `json:"task_name"`
+	Error    string `json:"error"`
+}
+
+type signatureResponse struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+func newGroupResponse(g *dashboard.Group) *groupResponse {
+	res := &groupResponse{
+		GroupUUID:      g.GroupUUID,
+		TaskUUIDs:      g.TaskUUIDs,
+		ChordTriggered: g.ChordTriggered,
+		Lock:           g.Lock,
+		CreatedAt:      g.CreatedAt,
+		Tasks:          make([]*groupTaskResponse, 0, len(g.Tasks)),
+	}
+
+	for _, gt := range g.Tasks {
+		task := &groupTaskResponse{TaskUUID: gt.TaskUUID, Error: gt.Error}
+		if gt.Task != nil {
+			task.State = gt.Task.State
+			task.TaskName = gt.Task.TaskName
+			task.Error = gt.Task.Error
+		}
+		res.Tasks = append(res.Tasks, task)
+	}
+
+	if g.ChordCallback != nil {
+		res.ChordCallback = &signatureResponse{UUID: g.ChordCallback.UUID, Name: g.ChordCallback.Name}
+	}
+
+	return res
+}
+
+func (s *Server) handleGroupDetail(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	group, err := s.machineryDash.FindGroupByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.String(http.StatusNotFound, "group not found")
+	case err != nil:
+		logrus.WithField("group_uuid", uuid).Error(err)
+		return ec.String(http.StatusInternalServerError, "something wrong")
+	}
+
+	return ec.Render(http.StatusOK, "group.html", groupDetailData{
+		ListStates:  stateList,
+		Group:       group,
+		EnableRerun: !s.rerunDisabled,
+	})
+}
+
+func (s *Server) handleAPIFindGroupByUUID(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	group, err := s.machineryDash.FindGroupByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("group not found"))
+	case err != nil:
+		logrus.WithField("group_uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find group"))
+	}
+
+	return ec.JSON(http.StatusOK, newGroupResponse(group))
+}
+
+func (s *Server) handleAPIRerunGroup(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	req := groupRerunRequest{}
+	err := errors.Unwrap(ec.Bind(&req))
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid request"))
+	}
+
+	report, err := s.dashboardOf(ec).RerunGroup(uuid, req.All)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("group not found"))
+	case errors.Is(err, dashboard.ErrRerunUnsupported):
+		return ec.JSON(http.StatusNotImplemented, fmtErr(err.Error()))
+	case err != nil:
+		logrus.WithField("group_uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to rerun group"))
+	}
+
+	return ec.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newGroupDashboardMock() *dashboardMock {
+	md := newDashboardMock()
+	md.groups = map[string]*dashboard.Group{
+		"group_1": {
+			GroupUUID:      "group_1",
+			TaskUUIDs:      []string{"1", "2", "99"},
+			ChordTriggered: false,
+			Tasks: []*dashboard.GroupTask{
+				{TaskUUID: "1", Task: md.tasks[0]},
+				{TaskUUID: "2", Task: md.tasks[1]},
+				{TaskUUID: "99"},
+			},
+			ChordCallback: &tasks.Signature{UUID: "3", Name: "Bar"},
+		},
+	}
+	return md
+}
+
+func Test_handleGroupDetail(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newGroupDashboardMock())
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/groups/group_1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "Chord")
+		assert.Contains(t, body, `href="/tasks/99"`)
+		assert.Contains(t, body, "MISSING: 1")
+		assert.Contains(t, body, `<a href="/tasks/3"><code>3</code></a> Bar`)
+	})
+
+	t.Run("escape the group", func(t *testing.T) {
+		md := newGroupDashboardMock()
+		group := md.groups["group_1"]
+		group.GroupUUID = `g");alert(1);("`
+		group.ChordCallback.Name = "<img src=x onerror=alert(2)>"
+		md.tasks[0].TaskName = "<img src=x onerror=alert(3)>"
+		md.tasks[0].Error = "<img src=x onerror=alert(4)>"
+		md.groups[group.GroupUUID] = group
+		s := newServerMock(md)
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, `/groups/g");alert(1);("`, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.NotContains(t, body, "<img")
+		assert.NotContains(t, body, `g");alert(1)`)
+		assert.Contains(t, body, `</a> &lt;img src=x onerror=alert(2)&gt;</td>`)
+		assert.Contains(t, body, `<td>&lt;img src=x onerror=alert(3)&gt;</td>`)
+		assert.Contains(t, body, `<td><code>&lt;img src=x onerror=alert(4)&gt;</code></td>`)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newGroupDashboardMock())
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/groups/group_99", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func Test_handleAPIFindGroupByUUID(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newGroupDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/groups/group_1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &groupResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.Equal(t, "group_1", res.GroupUUID)
+		assert.False(t, res.ChordTriggered)
+		require.Len(t, res.Tasks, 3)
+		assert.Equal(t, &groupTaskResponse{TaskUUID: "1", State: tasks.StateFailure, TaskName: "Foo", Error: "gotcha"}, res.Tasks[0])
+		assert.Equal(t, &groupTaskResponse{TaskUUID: "99"}, res.Tasks[2])
+		assert.Equal(t, &signatureResponse{UUID: "3", Name: "Bar"}, res.ChordCallback)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newGroupDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/groups/group_99", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("handle error", func(t *testing.T) {
+		md := newGroupDashboardMock()
+		md.err = errors.New("gotcha")
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodGet, "/api/v1/groups/group_1", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func Test_handleAPIRerunGroup(t *testing.T) {
+	t.Run("failed members", func(t *testing.T) {
+		md := newGroupDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/groups/group_1/rerun", `{}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		report := &dashboard.GroupRerunReport{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), report))
+		assert.True(t, report.ChordReset)
+		assert.Len(t, report.Results, 2)
+		assert.Equal(t, []string{"1"}, md.reruns)
+	})
+
+	t.Run("all members", func(t *testing.T) {
+		md := newGroupDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/api/v1/groups/group_1/rerun", `{"all":true}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, []string{"1", "2"}, md.reruns)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newGroupDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/groups/group_99/rerun", `{}`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/job"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+type listJobData struct {
+	ListStates    []string
+	Jobs          []*job.Progress
+	HasActiveJobs bool
+}
+
+func (s *Server) handleListJobs(ec echo.Context) error {
+	data := listJobData{
+		ListStates: stateList,
+		Jobs:       s.jobManager.FindAll(),
+	}
+	for _, j := range data.Jobs {
+		if j.IsActive() {
+			data.HasActiveJobs = true
+			break
+		}
+	}
+
+	return ec.Render(http.StatusOK, "jobs.html", data)
+}
+
+func (s *Server) handleAPIListJobs(ec echo.Context) error {
+	return ec.JSON(http.StatusOK, map[string]interface{}{"jobs": s.jobManager.FindAll()})
+}
+
+func (s *Server) handleAPICreateJob(ec echo.Context) error {
+	req := bulkRerunRequest{}
+	err := errors.Unwrap(ec.Bind(&req))
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid request"))
+	}
+
+	filter, err := req.validate()
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	if req.Rate < 0 {
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid rate"))
+	}
+
+	eta, err := req.resolve(time.Now())
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	if s.rerunDisabled {
+		return ec.JSON(http.StatusNotImplemented, fmtErr(dashboard.ErrRerunUnsupported.Error()))
+	}
+
+	progress, err := s.jobManager.Submit(&job.Request{
+		UUIDs:     req.UUIDs,
+		Filter:    filter,
+		Rate:      req.Rate,
+		ETA:       eta,
+		FreshUUID: req.freshUUID(s.freshUUID),
+		Actor:     s.actorOf(ec),
+	})
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to create job"))
+	}
+
+	return ec.JSON(http.StatusCreated, progress)
+}
+
+func (s *Server) handleAPIFindJobByID(ec echo.Context) error {
+	progress, err := s.jobManager.FindByID(ec.Param("id"))
+	if err != nil {
+		return jobErrorResponse(ec, err)
+	}
+
+	return ec.JSON(http.StatusOK, progress)
+}
+
+func (s *Server) handleAPIPauseJob(ec echo.Context) error {
+	return s.changeJobStatus(ec, s.jobManager.Pause)
+}
+
+func (s *Server) handleAPIResumeJob(ec echo.Context) error {
+	return s.changeJobStatus(ec, s.jobManager.Resume)
+}
+
+func (s *Server) handleAPICancelJob(ec echo.Context) error {
+	return s.changeJobStatus(ec, s.jobManager.Cancel)
+}
+
+func (s *Server) changeJobStatus(ec echo.Context, fn func(id string) error) error {
+	id := ec.Param("id")
+	if err := fn(id); err != nil {
+		return jobErrorResponse(ec, err)
+	}
+
+	progress, err := s.jobManager.FindByID(id)
+	if err != nil {
+		return jobErrorResponse(ec, err)
+	}
+
+	return ec.JSON(http.StatusOK, progress)
+}
+
+func jobErrorResponse(ec echo.Context, err error) error {
+	switch {
+	case errors.Is(err, job.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr(err.Error()))
+	case errors.Is(err, job.ErrInvalidTransition):
+		return ec.JSON(http.StatusConflict, fmtErr(err.Error()))
+	default:
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("something wrong"))
+	}
+}
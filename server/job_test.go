@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kumparan/machinerydash/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handleAPICreateJob(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/jobs", `{"uuids":["1"],"rate":5}`)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		res := &job.Progress{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.NotEmpty(t, res.ID)
+		assert.Equal(t, float64(5), res.Rate)
+
+		assert.Eventually(t, func() bool {
+			rec := s.serve(http.MethodGet, "/api/v1/jobs/"+res.ID, "")
+			p := &job.Progress{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), p))
+			return p.Status == job.StatusCompleted && p.Done == 1
+		}, 2*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/jobs", `{}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/api/v1/jobs", `{"uuids":["1"],"rate":-1}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.JSONEq(t, `{"error":"invalid rate"}`, rec.Body.String())
+	})
+}
+
+func Test_handleAPIJobStatus(t *testing.T) {
+	s := newServerMock(newDashboardMock())
+	progress, err := s.jobManager.Submit(&job.Request{UUIDs: []string{"1"}, Rate: 0.1})
+	require.NoError(t, err)
+
+	rec := s.serve(http.MethodPost, "/api/v1/jobs/"+progress.ID+"/pause", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.serve(http.MethodPost, "/api/v1/jobs/"+progress.ID+"/pause", "")
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	rec = s.serve(http.MethodPost, "/api/v1/jobs/"+progress.ID+"/resume", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = s.serve(http.MethodPost, "/api/v1/jobs/"+progress.ID+"/cancel", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	res := &job.Progress{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+	assert.Equal(t, job.StatusCancelled, res.Status)
+
+	rec = s.serve(http.MethodGet, "/api/v1/jobs/unknown", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = s.serve(http.MethodGet, "/api/v1/jobs", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	list := struct {
+		Jobs []*job.Progress `json:"jobs"`
+	}{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Len(t, list.Jobs, 1)
+}
+
+func Test_handleListJobs(t *testing.T) {
+	s := newServerMock(newDashboardMock())
+	require.NoError(t, s.initRenderer())
+	progress, err := s.jobManager.Submit(&job.Request{UUIDs: []string{"1"}, Rate: 0.1})
+	require.NoError(t, err)
+
+	rec := s.serve(http.MethodGet, "/jobs", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), progress.ID)
+	assert.Contains(t, rec.Body.String(), "Cancel")
+
+	require.NoError(t, s.jobManager.Cancel(progress.ID))
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// maxLineageEntries limit the rerun records looked up for the reruns of a task
+const maxLineageEntries = 100
+
+// lineageTask is either the original of a rerun or a rerun of the task,
+// State is empty when the task isn't found e.g. the result is expired
+type lineageTask struct {
+	UUID      string     `json:"uuid"`
+	State     string     `json:"state"`
+	Actor     string     `json:"actor,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Succeeded whether the rerun task has succeeded
+func (t *lineageTask) Succeeded() bool {
+	return t.State == tasks.StateSuccess
+}
+
+type lineageResponse struct {
+	RerunOf *lineageTask   `json:"rerun_of"`
+	Reruns  []*lineageTask `json:"reruns"`
+}
+
+// findLineage link the task to its original via the HeaderRerunOf header and to its reruns
+// with a new UUID via the rerun log, the reruns are empty when the rerun log is disabled
+func (s *Server) findLineage(task *dashboard.TaskWithSignature) (*lineageResponse, error) {
+	res := &lineageResponse{Reruns: []*lineageTask{}}
+	if original := task.RerunOf(); original != "" {
+		res.RerunOf = s.newLineageTask(original)
+	}
+
+	if s.rerunLog == nil {
+		return res, nil
+	}
+
+	records, err := s.rerunLog.Find(task.TaskUUID, maxLineageEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if record.OriginalUUID != task.TaskUUID || record.NewUUID == task.TaskUUID {
+			continue
+		}
+
+		rerun := s.newLineageTask(record.NewUUID)
+		rerun.Actor = record.Actor
+		createdAt := record.CreatedAt
+		rerun.CreatedAt = &createdAt
+		res.Reruns = append(res.Reruns, rerun)
+	}
+
+	return res, nil
+}
+
+func (s *Server) newLineageTask(uuid string) *lineageTask {
+	lt := &lineageTask{UUID: uuid}
+	task, err := s.machineryDash.FindTaskByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+	default:
+		lt.State = task.State
+	}
+	return lt
+}
+
+func (s *Server) handleAPIFindTaskLineage(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	task, err := s.machineryDash.FindTaskByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("task not found"))
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find task"))
+	}
+
+	res, err := s.findLineage(task)
+	if err != nil {
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to find lineage"))
+	}
+
+	return ec.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/rerunlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lineage(t *testing.T) {
+	t.Run("rerun as a new task", func(t *testing.T) {
+		store := rerunlog.NewMemoryStore(100)
+		md := newDashboardMock()
+		s := newServerMock(rerunlog.NewDashboard(md, store))
+		s.EnableRerunLog(store)
+		s.EnableFreshUUID()
+		require.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodPost, "/api/v1/tasks/1/rerun", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		res := &rerunResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		require.True(t, strings.HasPrefix(res.NewUUID, "task_"))
+		require.Len(t, md.edits, 1)
+		assert.Equal(t, res.NewUUID, md.edits[0].NewUUID)
+
+		// the worker has run the new task
+		md.tasks = append(md.tasks, &dashboard.TaskWithSignature{
+			TaskUUID:  res.NewUUID,
+			State:     tasks.StateSuccess,
+			TaskName:  "Foo",
+			Signature: `{"UUID":"` + res.NewUUID + `","Name":"Foo","Headers":{"machinerydash_rerun_of":"1"}}`,
+		})
+
+		rec = s.serve(http.MethodGet, "/api/v1/tasks/1/lineage", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		lineage := &lineageResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), lineage))
+		assert.Nil(t, lineage.RerunOf)
+		require.Len(t, lineage.Reruns, 1)
+		assert.Equal(t, res.NewUUID, lineage.Reruns[0].UUID)
+		assert.Equal(t, tasks.StateSuccess, lineage.Reruns[0].State)
+		assert.Equal(t, "ip:192.0.2.1", lineage.Reruns[0].Actor)
+		require.NotNil(t, lineage.Reruns[0].CreatedAt)
+
+		rec = s.serve(http.MethodGet, "/api/v1/tasks/"+res.NewUUID+"/lineage", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		lineage = &lineageResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), lineage))
+		assert.Equal(t, &lineageTask{UUID: "1", State: tasks.StateFailure}, lineage.RerunOf)
+		assert.Empty(t, lineage.Reruns)
+
+		rec = s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "/tasks/"+res.NewUUID)
+
+		rec = s.serve(http.MethodGet, "/tasks/"+res.NewUUID, "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "RerunOf")
+	})
+
+	t.Run("keep the uuid on request", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+		s.EnableFreshUUID()
+
+		rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"1","fresh_uuid":false}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"message":"ok"}`, rec.Body.String())
+		assert.Equal(t, []string{"1"}, md.reruns)
+		assert.Empty(t, md.edits)
+	})
+
+	t.Run("rerun log disabled", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/1/lineage", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"rerun_of":null,"reruns":[]}`, rec.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/99/lineage", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("handle store error", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		s.EnableRerunLog(&rerunLogStoreStub{err: errors.New("gotcha")})
+
+		rec := s.serve(http.MethodGet, "/api/v1/tasks/1/lineage", "")
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+type rerunLogStoreStub struct {
+	err error
+}
+
+func (s *rerunLogStoreStub) Find(uuid string, size int64) ([]*rerunlog.Record, error) {
+	return nil, s.err
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"html/template"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kumparan/go-utils"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+	// maxPrevCursors limit the cursor stack kept on the url,
+	// going back past the oldest kept cursor returns to the first page
+	maxPrevCursors = 50
+	// cursorSeparator join the cursor stack, the cursors are either base64 or numbers so they never contain it
+	cursorSeparator = ","
+)
+
+// cursorInfo is the position of the page on the task list. The backends can only page forward,
+// so the cursors of the previous pages are kept as a stack on the "prev" query param
+type cursorInfo struct {
+	// Cursor is the cursor of the next page, empty on the last page
+	Cursor string
+	Size   int64
+	// Current is the cursor of the current page, empty on the first page
+	Current string
+	// PrevCursors are the cursors of the pages before the current one, the first page excluded
+	PrevCursors []string
+}
+
+// newCursorInfo read the current page cursor from "next", the cursor stack from "prev" & the page "size"
+func newCursorInfo(ec echo.Context) cursorInfo {
+	size := utils.StringToInt64(ec.QueryParam("size"))
+	switch {
+	case size <= 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	c := cursorInfo{
+		Current: ec.QueryParam("next"),
+		Size:    size,
+	}
+	if c.Current == "" {
+		return c
+	}
+
+	for _, cursor := range strings.Split(ec.QueryParam("prev"), cursorSeparator) {
+		if cursor != "" {
+			c.PrevCursors = append(c.PrevCursors, cursor)
+		}
+	}
+	if len(c.PrevCursors) > maxPrevCursors {
+		c.PrevCursors = c.PrevCursors[len(c.PrevCursors)-maxPrevCursors:]
+	}
+	return c
+}
+
+func (c cursorInfo) withNext(next string) cursorInfo {
+	c.Cursor = next
+	return c
+}
+
+// HasPrev :nodoc:
+func (c cursorInfo) HasPrev() bool {
+	return c.Current != ""
+}
+
+// PageNumber is only accurate while the cursor stack is not truncated
+func (c cursorInfo) PageNumber() int {
+	if c.Current == "" {
+		return 1
+	}
+	return len(c.PrevCursors) + 2
+}
+
+// FirstQuery :nodoc:
+func (c cursorInfo) FirstQuery() template.URL {
+	return c.query("", nil)
+}
+
+// PrevQuery pop the cursor stack
+func (c cursorInfo) PrevQuery() template.URL {
+	if len(c.PrevCursors) == 0 {
+		return c.FirstQuery()
+	}
+
+	last := len(c.PrevCursors) - 1
+	return c.query(c.PrevCursors[last], c.PrevCursors[:last])
+}
+
+// NextQuery push the current cursor to the cursor stack
+func (c cursorInfo) NextQuery() template.URL {
+	prev := c.PrevCursors
+	if c.Current != "" {
+		prev = append(prev[:len(prev):len(prev)], c.Current)
+	}
+	return c.query(c.Cursor, prev)
+}
+
+// query the values are already encoded, so the query is kept as it is by the templates
+func (c cursorInfo) query(next string, prev []string) template.URL {
+	q := url.Values{}
+	q.Set("size", strconv.FormatInt(c.Size, 10))
+	if next != "" {
+		q.Set("next", next)
+	}
+	if len(prev) > 0 {
+		q.Set("prev", strings.Join(prev, cursorSeparator))
+	}
+	return template.URL("&" + q.Encode())
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newCursorInfo(t *testing.T) {
+	newContext := func(target string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	t.Run("first page", func(t *testing.T) {
+		c := newCursorInfo(newContext("/?prev=a"))
+		assert.Equal(t, cursorInfo{Size: defaultPageSize}, c)
+		assert.False(t, c.HasPrev())
+		assert.Equal(t, 1, c.PageNumber())
+	})
+
+	t.Run("cursor stack", func(t *testing.T) {
+		c := newCursorInfo(newContext("/?next=c&prev=a,b&size=20"))
+		assert.Equal(t, cursorInfo{Current: "c", PrevCursors: []string{"a", "b"}, Size: 20}, c)
+		assert.True(t, c.HasPrev())
+		assert.Equal(t, 4, c.PageNumber())
+	})
+
+	t.Run("limit size", func(t *testing.T) {
+		c := newCursorInfo(newContext("/?size=1000"))
+		assert.EqualValues(t, maxPageSize, c.Size)
+	})
+}
+
+func Test_cursorInfo_Query(t *testing.T) {
+	t.Run("second page", func(t *testing.T) {
+		c := cursorInfo{Current: "a+b=", Cursor: "c", Size: 10}
+		assert.EqualValues(t, "&size=10", c.FirstQuery())
+		assert.EqualValues(t, "&size=10", c.PrevQuery())
+		assert.EqualValues(t, "&next=c&prev=a%2Bb%3D&size=10", c.NextQuery())
+	})
+
+	t.Run("deeper page", func(t *testing.T) {
+		prev := make([]string, 2, 3)
+		prev[0], prev[1] = "a", "b"
+		c := cursorInfo{Current: "c", PrevCursors: prev, Cursor: "d", Size: 10}
+		assert.EqualValues(t, "&next=b&prev=a&size=10", c.PrevQuery())
+		assert.EqualValues(t, "&next=d&prev=a%2Cb%2Cc&size=10", c.NextQuery())
+		assert.EqualValues(t, "&next=b&prev=a&size=10", c.PrevQuery(), "the stack must not be modified")
+	})
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/rerunlog"
+	"github.com/sirupsen/logrus"
+)
+
+// maxScheduleRecords limit the rerun records looked up for the schedule of a task
+const maxScheduleRecords = 10
+
+type rerunLogStore interface {
+	Find(uuid string, size int64) ([]*rerunlog.Record, error)
+}
+
+// EnableRerunLog show the scheduled reruns & the rerun lineage recorded by rerunlog.NewDashboard
+func (s *Server) EnableRerunLog(store rerunLogStore) {
+	s.rerunLog = store
+}
+
+// scheduledAt return when the task is scheduled to rerun. The signature ETA is checked first, but some result backends
+// drop it, e.g. DynamoDB resets the signature on SetStatePending, so the latest rerun of the pending task is looked up
+func (s *Server) scheduledAt(task *dashboard.TaskWithSignature) *time.Time {
+	if eta := task.ScheduledAt(); eta != nil {
+		return eta
+	}
+
+	if s.rerunLog == nil || task.State != tasks.StatePending {
+		return nil
+	}
+
+	records, err := s.rerunLog.Find(task.TaskUUID, maxScheduleRecords)
+	if err != nil {
+		logrus.WithField("uuid", task.TaskUUID).Error(err)
+		return nil
+	}
+
+	for _, rec := range records {
+		if rec.NewUUID == task.TaskUUID {
+			return rec.ScheduledAt(time.Now())
+		}
+	}
+	return nil
+}
+
+// scheduledTasks map the scheduled tasks to their ETA
+func (s *Server) scheduledTasks(taskStates []*dashboard.TaskWithSignature) map[string]*time.Time {
+	res := map[string]*time.Time{}
+	for _, t := range taskStates {
+		if eta := s.scheduledAt(t); eta != nil {
+			res[t.TaskUUID] = eta
+		}
+	}
+	return res
+}
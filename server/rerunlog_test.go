@@ -0,0 +1,43 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/rerunlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScheduledRerun(t *testing.T) {
+	store := rerunlog.NewMemoryStore(100)
+	md := newDashboardMock()
+	s := newServerMock(rerunlog.NewDashboard(md, store))
+	s.EnableRerunLog(store)
+	require.NoError(t, s.initRenderer())
+
+	rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"1","delay":"1h"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the result backend reset the task to pending without its signature, e.g. DynamoDB
+	md.tasks[0].State = tasks.StatePending
+	md.tasks[0].Signature = ""
+
+	rec = s.serve(http.MethodGet, "/?state=PENDING", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<span class="badge badge-info">scheduled at`)
+
+	rec = s.serve(http.MethodGet, "/tasks/1", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `<span class="badge badge-info">scheduled at`)
+
+	t.Run("rerun immediately", func(t *testing.T) {
+		rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"1"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), `<span class="badge badge-info">scheduled at`)
+	})
+}
@@ -1,18 +1,23 @@
 package server
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"html/template"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strings"
-	"text/template"
+	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
-	"github.com/kumparan/go-utils"
 	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/kumparan/machinerydash/job"
+	"github.com/kumparan/machinerydash/metrics"
 	"github.com/labstack/echo/v4"
 	"github.com/markbates/pkger"
 	"github.com/sirupsen/logrus"
@@ -22,36 +27,185 @@ var (
 	stateList = []string{tasks.StateFailure, tasks.StatePending, tasks.StateReceived, tasks.StateStarted, tasks.StateRetry, tasks.StateSuccess}
 )
 
+// StateList the states listed by the dashboard, counting the same states shares the cached counts
+func StateList() []string {
+	return append([]string(nil), stateList...)
+}
+
+const (
+	orderAsc  = "asc"
+	orderDesc = "desc"
+
+	errSortUnsupportedMessage = "newest first is not supported, the result backend must keep the tasks sorted by created at"
+)
+
 // Server :nodoc:
 type Server struct {
 	// viewsPath     string
 	port          string
 	echo          *echo.Echo
 	machineryDash dashboard.Dashboard
-}
-
-type cursorInfo struct {
-	Cursor string
-	Size   int64
+	jobManager    *job.Manager
+	// location is used to show the times & read the time filters without timezone
+	location *time.Location
+	// auditStore is nil when the audit log is disabled
+	auditStore  auditStore
+	actorHeader string
+	// freshUUID rerun the tasks as new tasks unless the request says otherwise
+	freshUUID bool
+	// rerunLog is nil when the scheduled reruns are only read from the signature & the reruns aren't linked
+	rerunLog rerunLogStore
+	// rerunDisabled hide the rerun actions when the result backend can't rerun, see dashboard.ErrRerunUnsupported
+	rerunDisabled bool
 }
 
 type listTaskData struct {
-	CurrentState string
-	EnableRerun  bool
-	ListStates   []string
-	TaskStates   []*dashboard.TaskWithSignature
+	CurrentState   string
+	EnableRerun    bool
+	ListStates     []string
+	TaskStates     []*dashboard.TaskWithSignature
+	TaskName       string
+	TaskNamePrefix bool
+	// TaskNames are the suggestions of the task name filter
+	TaskNames []string
+	// From & To are the created at range as they are requested
+	From          string
+	To            string
+	ErrorContains string
+	// Order is either orderAsc or orderDesc of the created at
+	Order string
+	// StateCounts are the task counts by state, empty when counting failed
+	StateCounts map[string]*dashboard.TaskCount
+	// TaskNameCounts are the task counts of the current state by task name, the most tasks first
+	TaskNameCounts []*dashboard.TaskNameCount
+	// ScheduledAt the ETA of the scheduled tasks by UUID
+	ScheduledAt map[string]*time.Time
 	cursorInfo
 }
 
+// FilterQuery keep the task name, created at & error filter and the order on the state & pagination links
+func (d listTaskData) FilterQuery() template.URL {
+	return encodeFilterQuery(d.filterValues(d.Order))
+}
+
+// ToggleOrderQuery keep the filter reversing the order, the cursors are not kept since they are bound to the order
+func (d listTaskData) ToggleOrderQuery() template.URL {
+	if d.Order == orderDesc {
+		return encodeFilterQuery(d.filterValues(orderAsc))
+	}
+	return encodeFilterQuery(d.filterValues(orderDesc))
+}
+
+func (d listTaskData) filterValues(order string) url.Values {
+	q := url.Values{}
+	if d.TaskName != "" {
+		q.Set("task_name", d.TaskName)
+	}
+	if d.TaskNamePrefix {
+		q.Set("match", "prefix")
+	}
+	if d.From != "" {
+		q.Set("from", d.From)
+	}
+	if d.To != "" {
+		q.Set("to", d.To)
+	}
+	if d.ErrorContains != "" {
+		q.Set("error_contains", d.ErrorContains)
+	}
+	if order == orderDesc {
+		q.Set("order", orderDesc)
+	}
+	return q
+}
+
+// encodeFilterQuery the values are already encoded, so the query is kept as it is by the templates
+func encodeFilterQuery(q url.Values) template.URL {
+	if len(q) == 0 {
+		return ""
+	}
+	return template.URL("&" + q.Encode())
+}
+
+// bulkRerunRequest select tasks either by uuids or filter, used by bulk rerun & rerun jobs
+type bulkRerunRequest struct {
+	UUIDs  []string `json:"uuids"`
+	Filter *struct {
+		State          string    `json:"state"`
+		TaskName       string    `json:"task_name"`
+		CreatedAfter   time.Time `json:"created_after"`
+		CreatedBefore  time.Time `json:"created_before"`
+		ErrorContains  string    `json:"error_contains"`
+		ErrorSignature string    `json:"error_signature"`
+		Limit          int       `json:"limit"`
+	} `json:"filter"`
+	Concurrency int     `json:"concurrency"`
+	Rate        float64 `json:"rate"`
+	rerunSchedule
+}
+
+// rerunSchedule delay the rerun either until eta or by delay, e.g. "15m",
+// FreshUUID choose whether the task is rerun as a new task, nil means the server default
+type rerunSchedule struct {
+	ETA       *time.Time `json:"eta"`
+	Delay     string     `json:"delay"`
+	FreshUUID *bool      `json:"fresh_uuid"`
+}
+
+type taskDetailData struct {
+	EnableRerun    bool
+	ListStates     []string
+	Task           *dashboard.TaskWithSignature
+	Signature      *tasks.Signature
+	SignatureError string
+	Args           []argDetail
+	// HeadersJSON prefill the headers on the edit form
+	HeadersJSON   string
+	Workflow      *dashboard.WorkflowGraph
+	WorkflowError string
+	// Lineage link the task to its original & reruns, nil when it can't be loaded
+	Lineage *lineageResponse
+	// FreshUUID check the rerun as a new task option by default
+	FreshUUID bool
+	// ScheduledAt the ETA when the task is scheduled to rerun
+	ScheduledAt *time.Time
+}
+
+type argDetail struct {
+	Name  string
+	Type  string
+	Value interface{}
+	// RawValue is the stored json value, used to prefill the edit form
+	RawValue string
+	Error    string
+}
+
 // New :nodoc:
-func New(port string, md dashboard.Dashboard) *Server {
+// loc default to UTC when nil
+func New(port string, md dashboard.Dashboard, jm *job.Manager, loc *time.Location) *Server {
+	if loc == nil {
+		loc = time.UTC
+	}
+
 	return &Server{
 		port:          port,
 		echo:          echo.New(),
 		machineryDash: md,
+		jobManager:    jm,
+		location:      loc,
 	}
 }
 
+// DisableRerun hide the rerun actions, for the result backends that don't store the task signatures
+func (s *Server) DisableRerun() {
+	s.rerunDisabled = true
+}
+
+// EnableFreshUUID rerun the tasks as new tasks by default, so the original tasks keep their result
+func (s *Server) EnableFreshUUID() {
+	s.freshUUID = true
+}
+
 // Start :nodoc:
 func (s *Server) Start() {
 	ec := s.echo
@@ -60,16 +214,48 @@ func (s *Server) Start() {
 		logrus.Fatal(err)
 	}
 
+	s.initRoutes()
+
+	ec.Logger.Fatal(ec.Start(":" + s.port))
+}
+
+func (s *Server) initRoutes() {
+	ec := s.echo
+
 	ec.GET("/", s.handleListAllTasksByState)
+	ec.GET("/tasks/:uuid", s.handleTaskDetail)
+	ec.GET("/groups/:uuid", s.handleGroupDetail)
 	ec.GET("/ping", s.handlePing)
+	ec.GET("/metrics", echo.WrapHandler(metrics.Handler()))
 	ec.GET("/static/*", s.handleStatic)
 	ec.POST("/rerun", s.handleRerun)
-
-	ec.Logger.Fatal(ec.Start(":" + s.port))
+	ec.POST("/rerun/bulk", s.handleBulkRerun)
+	ec.GET("/jobs", s.handleListJobs)
+	ec.GET("/failures", s.handleListFailures)
+	ec.GET("/audit", s.handleListAudit)
+
+	api := ec.Group("/api/v1")
+	api.GET("/states", s.handleAPIListStates)
+	api.GET("/counts", s.handleAPICountTasks)
+	api.GET("/tasks", s.handleAPIListTasksByState)
+	api.GET("/tasks/:uuid", s.handleAPIFindTaskByUUID)
+	api.POST("/tasks/:uuid/rerun", s.handleAPIRerunTask)
+	api.GET("/tasks/:uuid/workflow", s.handleAPIFindTaskWorkflow)
+	api.GET("/tasks/:uuid/lineage", s.handleAPIFindTaskLineage)
+	api.GET("/groups/:uuid", s.handleAPIFindGroupByUUID)
+	api.POST("/groups/:uuid/rerun", s.handleAPIRerunGroup)
+	api.GET("/failures", s.handleAPIListFailures)
+	api.GET("/audit", s.handleAPIListAudit)
+	api.GET("/jobs", s.handleAPIListJobs)
+	api.POST("/jobs", s.handleAPICreateJob)
+	api.GET("/jobs/:id", s.handleAPIFindJobByID)
+	api.POST("/jobs/:id/pause", s.handleAPIPauseJob)
+	api.POST("/jobs/:id/resume", s.handleAPIResumeJob)
+	api.POST("/jobs/:id/cancel", s.handleAPICancelJob)
 }
 
 func (s *Server) initRenderer() error {
-	serverTemplate := template.New("")
+	serverTemplate := template.New("").Funcs(s.templateFuncs())
 	err := pkger.Walk("/views", func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -128,45 +314,252 @@ func (s *Server) handleStatic(c echo.Context) error {
 }
 
 func (s *Server) handleListAllTasksByState(ec echo.Context) error {
-	next := ec.QueryParam("next")
-	prev := ec.QueryParam("prev")
-	size := utils.StringToInt64(ec.QueryParam("size"))
 	state := strings.ToUpper(ec.QueryParam("state"))
 
 	if strings.TrimSpace(state) == "" {
 		state = tasks.StateFailure
 	}
 
-	cursor := next
-	if prev != "" {
-		cursor = prev
+	if !isValidState(state) {
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid state"))
 	}
 
-	taskStates, cursor, err := s.machineryDash.FindAllTasksByState(state, cursor, true, size)
+	filter, err := s.newTaskFilter(ec, state)
 	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	order, err := parseOrder(ec.QueryParam("order"))
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	page := newCursorInfo(ec)
+	taskStates, next, err := s.machineryDash.FindAllTasks(filter, page.Current, order == orderAsc, page.Size)
+	switch {
+	case errors.Is(err, dashboard.ErrInvalidCursor):
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid cursor"))
+	case errors.Is(err, dashboard.ErrSortUnsupported):
+		return ec.JSON(http.StatusBadRequest, fmtErr(errSortUnsupportedMessage))
+	case err != nil:
 		logrus.Error(err)
 		return ec.JSON(http.StatusInternalServerError, map[string]string{
 			"error": "something wrong",
 		})
 	}
 
+	stateCounts := s.countTasksByState()
+
+	var taskNameCounts []*dashboard.TaskNameCount
+	if count, ok := stateCounts[state]; ok {
+		taskNameCounts = count.SortedByTaskName()
+	}
+
 	data := listTaskData{
-		ListStates:   stateList,
-		EnableRerun:  state == tasks.StateFailure,
-		CurrentState: state,
-		TaskStates:   taskStates,
-		cursorInfo: cursorInfo{
-			Cursor: cursor,
-			Size:   size,
-		},
+		ListStates:     stateList,
+		EnableRerun:    state == tasks.StateFailure && !s.rerunDisabled,
+		CurrentState:   state,
+		TaskStates:     taskStates,
+		TaskName:       filter.TaskName,
+		TaskNamePrefix: filter.TaskNamePrefix,
+		TaskNames:      collectTaskNames(filter.TaskName, taskStates, taskNameCounts),
+		From:           ec.QueryParam("from"),
+		To:             ec.QueryParam("to"),
+		ErrorContains:  filter.ErrorContains,
+		Order:          order,
+		StateCounts:    stateCounts,
+		TaskNameCounts: taskNameCounts,
+		ScheduledAt:    s.scheduledTasks(taskStates),
+		cursorInfo:     page.withNext(next),
 	}
 
 	return ec.Render(http.StatusOK, "index.html", data)
 }
 
+// countTasksByState count the tasks of every listed state, the counts are only informative
+// so the page is still rendered without them when counting failed
+func (s *Server) countTasksByState() map[string]*dashboard.TaskCount {
+	counts, err := s.machineryDash.CountTasks(stateList)
+	if err != nil {
+		logrus.Error(err)
+		return nil
+	}
+
+	byState := make(map[string]*dashboard.TaskCount, len(counts))
+	for _, count := range counts {
+		byState[count.State] = count
+	}
+	return byState
+}
+
+// newTaskFilter read the task name, created at & error filter from the query params,
+// "match" is either "exact" (default) or "prefix", "from" & "to" are inclusive
+func (s *Server) newTaskFilter(ec echo.Context, state string) (*dashboard.TaskFilter, error) {
+	filter := &dashboard.TaskFilter{
+		State:         state,
+		TaskName:      strings.TrimSpace(ec.QueryParam("task_name")),
+		ErrorContains: ec.QueryParam("error_contains"),
+	}
+
+	switch ec.QueryParam("match") {
+	case "", "exact":
+	case "prefix":
+		filter.TaskNamePrefix = true
+	default:
+		return nil, errors.New("invalid match, must be exact or prefix")
+	}
+
+	var err error
+	filter.CreatedAfter, err = parseTimeParam(ec.QueryParam("from"), s.location)
+	if err != nil {
+		return nil, errors.New("invalid from")
+	}
+
+	filter.CreatedBefore, err = parseTimeParam(ec.QueryParam("to"), s.location)
+	if err != nil {
+		return nil, errors.New("invalid to")
+	}
+
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && filter.CreatedAfter.After(filter.CreatedBefore) {
+		return nil, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
+
+// parseOrder read the created at order, default to orderAsc
+func parseOrder(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", orderAsc:
+		return orderAsc, nil
+	case orderDesc:
+		return orderDesc, nil
+	default:
+		return "", errors.New("invalid order, must be asc or desc")
+	}
+}
+
+// parseTimeParam parse RFC3339 time, or the datetime-local input value on loc e.g. 2020-12-10T07:53,
+// return zero time when empty
+func parseTimeParam(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err == nil {
+		return t, nil
+	}
+
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02T15:04:05", "2006-01-02"} {
+		t, err = time.ParseInLocation(layout, value, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// collectTaskNames list the distinct task names of the page & the counts sorted, starting from the current filter
+func collectTaskNames(current string, taskStates []*dashboard.TaskWithSignature, counts []*dashboard.TaskNameCount) []string {
+	seen := map[string]bool{}
+	var names []string
+	add := func(name string) {
+		if name == "" || name == current || seen[name] {
+			return
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+
+	for _, t := range taskStates {
+		add(t.TaskName)
+	}
+	for _, c := range counts {
+		add(c.TaskName)
+	}
+	sort.Strings(names)
+
+	if current != "" {
+		names = append([]string{current}, names...)
+	}
+	return names
+}
+
+func (s *Server) handleTaskDetail(ec echo.Context) error {
+	uuid := ec.Param("uuid")
+
+	task, err := s.machineryDash.FindTaskByUUID(uuid)
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.String(http.StatusNotFound, "task not found")
+	case err != nil:
+		logrus.WithField("uuid", uuid).Error(err)
+		return ec.String(http.StatusInternalServerError, "something wrong")
+	}
+
+	data := taskDetailData{
+		ListStates:  stateList,
+		EnableRerun: task.State == tasks.StateFailure && !s.rerunDisabled,
+		Task:        task,
+		ScheduledAt: s.scheduledAt(task),
+		FreshUUID:   s.freshUUID,
+	}
+
+	data.Lineage, err = s.findLineage(task)
+	if err != nil {
+		logrus.WithField("uuid", uuid).Error(err)
+	}
+
+	sig := &tasks.Signature{}
+	err = task.UnmarshalSignature(sig)
+	if err != nil {
+		// still show the task, the raw signature is rendered instead
+		data.SignatureError = err.Error()
+		return ec.Render(http.StatusOK, "task.html", data)
+	}
+
+	data.Signature = sig
+	data.Args = decodeArgs(sig.Args)
+	if sig.Headers != nil {
+		bt, _ := json.Marshal(sig.Headers)
+		data.HeadersJSON = string(bt)
+	}
+
+	// the page is still useful without the workflow, e.g. when the group meta can't be loaded
+	data.Workflow, err = dashboard.BuildWorkflowGraph(s.machineryDash, uuid, ec.QueryParam("parent"))
+	if err != nil {
+		logrus.WithField("uuid", uuid).Error(err)
+		data.WorkflowError = err.Error()
+	}
+	return ec.Render(http.StatusOK, "task.html", data)
+}
+
+// decodeArgs convert the arg values into their real type
+func decodeArgs(args []tasks.Arg) []argDetail {
+	res := make([]argDetail, 0, len(args))
+	for _, arg := range args {
+		detail := argDetail{Name: arg.Name, Type: arg.Type, Value: arg.Value}
+		if bt, err := json.Marshal(arg.Value); err == nil {
+			detail.RawValue = string(bt)
+		}
+
+		val, err := tasks.ReflectValue(arg.Type, arg.Value)
+		if err != nil {
+			detail.Error = err.Error()
+		} else {
+			detail.Value = val.Interface()
+		}
+
+		res = append(res, detail)
+	}
+	return res
+}
+
 func (s *Server) handleRerun(ec echo.Context) error {
 	req := struct {
 		UUID string `json:"uuid"`
+		rerunSchedule
 	}{}
 	err := errors.Unwrap(ec.Bind(&req))
 	if err != nil {
@@ -174,13 +567,119 @@ func (s *Server) handleRerun(ec echo.Context) error {
 		return ec.JSON(http.StatusBadRequest, fmtErr("invalid request"))
 	}
 
-	err = s.machineryDash.RerunTask(req.UUID)
+	eta, err := req.resolve(time.Now())
 	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	newUUID, err := dashboard.ScheduleRerunTask(s.dashboardOf(ec), req.UUID, eta, req.freshUUID(s.freshUUID))
+	switch {
+	case errors.Is(err, dashboard.ErrNotFound):
+		return ec.JSON(http.StatusNotFound, fmtErr("task not found"))
+	case errors.Is(err, dashboard.ErrRerunUnsupported):
+		return ec.JSON(http.StatusNotImplemented, fmtErr(err.Error()))
+	case err != nil:
 		logrus.WithField("uuid", req.UUID).Error(err)
 		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to rerun task"))
 	}
 
-	return ec.JSON(http.StatusOK, map[string]string{"message": "ok"})
+	res := rerunResponse{Message: "ok", ETA: eta}
+	if newUUID != req.UUID {
+		res.NewUUID = newUUID
+	}
+	return ec.JSON(http.StatusOK, res)
+}
+
+func (s *Server) handleBulkRerun(ec echo.Context) error {
+	req := bulkRerunRequest{}
+	err := errors.Unwrap(ec.Bind(&req))
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusBadRequest, fmtErr("invalid request"))
+	}
+
+	filter, err := req.validate()
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	eta, err := req.resolve(time.Now())
+	if err != nil {
+		return ec.JSON(http.StatusBadRequest, fmtErr(err.Error()))
+	}
+
+	report, err := s.dashboardOf(ec).BulkRerunTasks(&dashboard.BulkRerunRequest{
+		UUIDs:       req.UUIDs,
+		Filter:      filter,
+		Concurrency: req.Concurrency,
+		ETA:         eta,
+		FreshUUID:   req.freshUUID(s.freshUUID),
+	})
+	if errors.Is(err, dashboard.ErrRerunUnsupported) {
+		return ec.JSON(http.StatusNotImplemented, fmtErr(err.Error()))
+	}
+	if err != nil {
+		logrus.Error(err)
+		return ec.JSON(http.StatusInternalServerError, fmtErr("failed to rerun tasks"))
+	}
+
+	return ec.JSON(http.StatusOK, report)
+}
+
+// validate the request and convert the filter
+func (r *bulkRerunRequest) validate() (*dashboard.BulkRerunFilter, error) {
+	if (len(r.UUIDs) == 0) == (r.Filter == nil) {
+		return nil, errors.New("either uuids or filter is required")
+	}
+
+	if r.Filter == nil {
+		return nil, nil
+	}
+
+	state := strings.ToUpper(r.Filter.State)
+	if state != "" && !isValidState(state) {
+		return nil, errors.New("invalid state")
+	}
+
+	return &dashboard.BulkRerunFilter{
+		State:          state,
+		TaskName:       r.Filter.TaskName,
+		CreatedAfter:   r.Filter.CreatedAfter,
+		CreatedBefore:  r.Filter.CreatedBefore,
+		ErrorContains:  r.Filter.ErrorContains,
+		ErrorSignature: r.Filter.ErrorSignature,
+		Limit:          r.Filter.Limit,
+	}, nil
+}
+
+// resolve the absolute eta, nil means rerun immediately. A past eta is rejected rather than rerun immediately,
+// since it is more likely a mistake e.g. on the timezone
+func (r rerunSchedule) resolve(now time.Time) (*time.Time, error) {
+	switch {
+	case r.ETA != nil && r.Delay != "":
+		return nil, errors.New("either eta or delay is allowed")
+	case r.ETA != nil && !r.ETA.After(now):
+		return nil, errors.New("eta must be in the future")
+	case r.ETA != nil:
+		eta := r.ETA.UTC()
+		return &eta, nil
+	case r.Delay != "":
+		delay, err := time.ParseDuration(r.Delay)
+		if err != nil || delay < 0 {
+			return nil, errors.New("invalid delay")
+		}
+		eta := now.Add(delay).UTC()
+		return &eta, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (r rerunSchedule) freshUUID(def bool) bool {
+	if r.FreshUUID == nil {
+		return def
+	}
+	return *r.FreshUUID
 }
 
 func fmtErr(msg string) map[string]string {
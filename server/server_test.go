@@ -0,0 +1,342 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/kumparan/machinerydash/dashboard"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_handleListAllTasksByState(t *testing.T) {
+	t.Run("invalid state", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, `/?state=%22%3Balert(1)%2F%2F`, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "alert(1)")
+	})
+
+	t.Run("filter by task name", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks = append(md.tasks, &dashboard.TaskWithSignature{TaskUUID: "3", State: tasks.StateFailure, TaskName: "FooBar"})
+		md.next = "a+b="
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?state=FAILURE&task_name=Foo&match=prefix", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, &dashboard.TaskFilter{State: tasks.StateFailure, TaskName: "Foo", TaskNamePrefix: true}, md.filter)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `href="/tasks/1"`)
+		assert.Contains(t, body, `href="/tasks/3"`)
+		assert.Contains(t, body, `<option value="FooBar">`)
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;next=a%2Bb%3D&amp;size=10&amp;match=prefix&amp;task_name=Foo"`)
+		assert.Contains(t, body, `href="/?state=SUCCESS&amp;match=prefix&amp;task_name=Foo"`)
+	})
+
+	t.Run("filter by created at on the configured timezone", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].CreatedAt = "2020-12-10T07:53:14Z"
+		s := newServerMock(md)
+		s.location = time.FixedZone("WIB", 7*60*60)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?from=2020-12-10T14:00&to=2020-12-10T15:00", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, md.filter.CreatedAfter.Equal(time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC)))
+		assert.True(t, md.filter.CreatedBefore.Equal(time.Date(2020, 12, 10, 8, 0, 0, 0, time.UTC)))
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "2020-12-10 14:53:14 WIB")
+		assert.Contains(t, body, "days ago")
+		assert.Contains(t, body, `href="/?state=SUCCESS&amp;from=2020-12-10T14%3A00&amp;to=2020-12-10T15%3A00"`)
+	})
+
+	t.Run("show counts", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks = append(md.tasks, &dashboard.TaskWithSignature{TaskUUID: "3", State: tasks.StateFailure, TaskName: "Bar"})
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?state=FAILURE", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `FAILURE <span class="badge badge-light">2</span>`)
+		assert.Contains(t, body, `SUCCESS <span class="badge badge-light">1</span>`)
+		assert.Contains(t, body, `href="/?state=FAILURE&task_name=Bar">Bar 1</a>`)
+	})
+
+	t.Run("render without counts when counting failed", func(t *testing.T) {
+		md := newDashboardMock()
+		md.countErr = errors.New("gotcha")
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?state=FAILURE", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `href="/tasks/1"`)
+		assert.NotContains(t, rec.Body.String(), "badge-light")
+	})
+
+	t.Run("previous page", func(t *testing.T) {
+		md := newDashboardMock()
+		md.next = "d"
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?state=FAILURE&next=c&prev=a,b&size=5", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;size=5">|&lt; FIRST</a>`)
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;next=b&amp;prev=a&amp;size=5">&lt;&lt; PREV</a>`)
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;next=d&amp;prev=a%2Cb%2Cc&amp;size=5">NEXT &gt;&gt;</a>`)
+		assert.Contains(t, body, "Page 4")
+	})
+
+	t.Run("newest first", func(t *testing.T) {
+		md := newDashboardMock()
+		md.next = "b"
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/?state=FAILURE&order=desc&next=a&task_name=Foo", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.False(t, md.asc)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;next=b&amp;prev=a&amp;size=10&amp;order=desc&amp;task_name=Foo">NEXT &gt;&gt;</a>`)
+		assert.Contains(t, body, `href="/?state=SUCCESS&amp;order=desc&amp;task_name=Foo"`)
+		assert.Contains(t, body, `href="/?state=FAILURE&amp;task_name=Foo" title="Toggle the order">CreatedAt &#9660;</a>`)
+		assert.Contains(t, body, `<input type="hidden" name="order" value="desc">`)
+	})
+
+	t.Run("invalid match", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/?task_name=Foo&match=regex", "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func Test_handleTaskDetail(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].Signature = `{
+			"UUID": "1",
+			"Name": "Foo",
+			"RoutingKey": "foo-service",
+			"Headers": {"trace-id": "abc"},
+			"Args": [
+				{"Name": "userID", "Type": "int64", "Value": 1607416299930351698},
+				{"Name": "ids", "Type": "[]string", "Value": ["a", "b"]}
+			],
+			"GroupUUID": "group_1",
+			"OnSuccess": [{"UUID": "2", "Name": "Bar"}]
+		}`
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.Contains(t, body, "foo-service")
+		assert.Contains(t, body, "trace-id")
+		assert.Contains(t, body, "1607416299930351698")
+		assert.Contains(t, body, `[]string{&#34;a&#34;, &#34;b&#34;}`)
+		assert.Contains(t, body, `href="/tasks/2"`)
+		assert.Contains(t, body, `href="/groups/group_1"`)
+		assert.Contains(t, body, "gotcha")
+		assert.Contains(t, body, "Rerun")
+		assert.Contains(t, body, `data-type="int64" rows="2">1607416299930351698</textarea>`)
+		assert.Contains(t, body, `{&#34;trace-id&#34;:&#34;abc&#34;}`)
+		assert.Contains(t, body, `href="/tasks/2?parent=1"`)
+		assert.Contains(t, body, "Stopped at")
+	})
+
+	t.Run("with parent", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/tasks/1?parent=2", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `<a href="/tasks/2"><code>2</code></a>`)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].Signature = `invalid`
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "failed to decode signature")
+	})
+
+	t.Run("rerun disabled", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].Signature = ""
+		s := newServerMock(md)
+		s.DisableRerun()
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "onclick='rerun(")
+		assert.Contains(t, rec.Body.String(), "signature is not stored")
+
+		rec = s.serve(http.MethodGet, "/", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "Rerun selected")
+
+		rec = s.serve(http.MethodPost, "/api/v1/jobs", `{"uuids":["1"]}`)
+		assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	})
+
+	t.Run("escape the task data", func(t *testing.T) {
+		md := newDashboardMock()
+		md.tasks[0].TaskName = "<img src=x onerror=alert(1)>"
+		md.tasks[0].Error = "</pre><img src=x onerror=alert(1)>"
+		md.tasks[0].Signature = `{
+			"UUID": "1",
+			"Name": "<img src=x onerror=alert(1)>",
+			"RoutingKey": "<img src=x onerror=alert(1)>",
+			"Headers": {"trace-id": "<img src=x onerror=alert(1)>"},
+			"Args": [{"Name": "note", "Type": "string", "Value": "</textarea><img src=x onerror=alert(1)>"}]
+		}`
+		s := newServerMock(md)
+		assert.NoError(t, s.initRenderer())
+
+		rec := s.serve(http.MethodGet, "/tasks/1", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body := rec.Body.String()
+		assert.NotContains(t, body, "<img")
+		assert.Contains(t, body, "<pre>&lt;/pre&gt;&lt;img src=x onerror=alert(1)&gt;</pre>")
+		assert.Contains(t, body, `<div><code>trace-id</code>: &lt;img src=x onerror=alert(1)&gt;</div>`)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodGet, "/tasks/99", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func Test_handleBulkRerun(t *testing.T) {
+	t.Run("by uuids", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/rerun/bulk", `{"uuids":["1","99"]}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		report := &dashboard.BulkRerunReport{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), report))
+		assert.Equal(t, 2, report.Total)
+		assert.Equal(t, 1, report.Succeeded)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, []string{"1"}, md.reruns)
+	})
+
+	t.Run("scheduled", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/rerun/bulk", `{"uuids":["1"],"eta":"2099-12-11T14:00:00+07:00"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		report := &dashboard.BulkRerunReport{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), report))
+		assert.Equal(t, time.Date(2099, 12, 11, 7, 0, 0, 0, time.UTC), *report.ETA)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/rerun/bulk", `{"uuids":["1"],"delay":"soon"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = s.serve(http.MethodPost, "/rerun/bulk", `{}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = s.serve(http.MethodPost, "/rerun/bulk", `{"uuids":["1"],"filter":{}}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = s.serve(http.MethodPost, "/rerun/bulk", `{"filter":{"state":"UNKNOWN"}}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func Test_handleRerun(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"1"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"message":"ok"}`, rec.Body.String())
+		assert.Equal(t, []string{"1"}, md.reruns)
+	})
+
+	t.Run("delayed", func(t *testing.T) {
+		md := newDashboardMock()
+		s := newServerMock(md)
+
+		rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"1","delay":"15m"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		res := &rerunResponse{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), *res.ETA, time.Minute)
+		assert.Len(t, md.edits, 1)
+		assert.Equal(t, res.ETA.Unix(), md.edits[0].ETA.Unix())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		s := newServerMock(newDashboardMock())
+
+		rec := s.serve(http.MethodPost, "/rerun", `{"uuid":"99"}`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func Test_rerunSchedule_resolve(t *testing.T) {
+	now := time.Date(2020, 12, 10, 7, 0, 0, 0, time.UTC)
+	eta := time.Date(2020, 12, 11, 14, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+
+	res, err := rerunSchedule{}.resolve(now)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	res, err = rerunSchedule{ETA: &eta}.resolve(now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 12, 11, 7, 0, 0, 0, time.UTC), *res)
+
+	res, err = rerunSchedule{Delay: "1h30m"}.resolve(now)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(90*time.Minute), *res)
+
+	_, err = rerunSchedule{Delay: "-1h"}.resolve(now)
+	assert.Error(t, err)
+
+	_, err = rerunSchedule{ETA: &eta, Delay: "1h"}.resolve(now)
+	assert.Error(t, err)
+
+	past := now.Add(-time.Minute)
+	_, err = rerunSchedule{ETA: &past}.resolve(now)
+	assert.EqualError(t, err, "eta must be in the future")
+
+	_, err = rerunSchedule{ETA: &now}.resolve(now)
+	assert.Error(t, err)
+}
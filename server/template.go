@@ -1,9 +1,12 @@
 package server
 
 import (
+	"fmt"
+	"html/template"
 	"io"
-	"text/template"
+	"time"
 
+	"github.com/kumparan/machinerydash/dashboard"
 	"github.com/labstack/echo/v4"
 )
 
@@ -14,3 +17,73 @@ type htmlTemplate struct {
 func (t *htmlTemplate) Render(w io.Writer, name string, data interface{}, c echo.Context) error {
 	return t.templates.ExecuteTemplate(w, name, data)
 }
+
+// templateFuncs show the times in the configured location, either from time.Time or
+// TaskWithSignature.CreatedAt string which is kept as it is when it can't be parsed.
+// Zero times are shown as empty string
+func (s *Server) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"localTime": func(v interface{}) string {
+			t, ok := toTime(v)
+			if !ok {
+				str, _ := v.(string)
+				return str
+			}
+			return t.In(s.location).Format("2006-01-02 15:04:05 MST")
+		},
+		"relativeTime": func(v interface{}) string {
+			t, ok := toTime(v)
+			if !ok {
+				return ""
+			}
+			return relativeTime(t, time.Now())
+		},
+	}
+}
+
+func toTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, !t.IsZero()
+	case *time.Time:
+		if t == nil {
+			return time.Time{}, false
+		}
+		return toTime(*t)
+	case string:
+		parsed, err := dashboard.ParseCreatedAt(t)
+		return parsed, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+// relativeTime describe t relative to now, e.g. "3 hours ago" or "5 minutes from now"
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	suffix := "ago"
+	if d < 0 {
+		d = -d
+		suffix = "from now"
+	}
+
+	var (
+		n    int64
+		unit string
+	)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		n, unit = int64(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int64(d/time.Hour), "hour"
+	default:
+		n, unit = int64(d/(24*time.Hour)), "day"
+	}
+
+	if n > 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s %s", n, unit, suffix)
+}
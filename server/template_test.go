@@ -0,0 +1,27 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_relativeTime(t *testing.T) {
+	now := time.Date(2020, 12, 10, 7, 53, 14, 0, time.UTC)
+
+	assert.Equal(t, "just now", relativeTime(now.Add(-30*time.Second), now))
+	assert.Equal(t, "1 minute ago", relativeTime(now.Add(-time.Minute), now))
+	assert.Equal(t, "3 hours ago", relativeTime(now.Add(-3*time.Hour-time.Minute), now))
+	assert.Equal(t, "2 days ago", relativeTime(now.Add(-50*time.Hour), now))
+	assert.Equal(t, "15 minutes from now", relativeTime(now.Add(15*time.Minute), now))
+}
+
+func Test_templateFuncs(t *testing.T) {
+	s := New("", nil, nil, time.FixedZone("WIB", 7*60*60))
+	localTime := s.templateFuncs()["localTime"].(func(interface{}) string)
+
+	assert.Equal(t, "2020-12-10 14:53:14 WIB", localTime("2020-12-10T07:53:14.436882456Z"))
+	assert.Equal(t, "2020-12-10 14:53:14 WIB", localTime(time.Date(2020, 12, 10, 7, 53, 14, 0, time.UTC)))
+	assert.Equal(t, "invalid", localTime("invalid"))
+}